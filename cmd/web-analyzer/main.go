@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/architeacher/svc-web-analyzer/internal/app"
+	"github.com/architeacher/svc-web-analyzer/internal/config"
+)
+
+func main() {
+	var err error
+
+	if len(os.Args) > 1 && os.Args[1] == "token" {
+		err = runToken(os.Args[2:], os.Stdout, os.Stderr)
+	} else {
+		err = run()
+	}
+
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "web-analyzer: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("loading configuration: %w", err)
+	}
+
+	logger := app.NewLogger(cfg.Logging)
+
+	application, err := app.New(cfg, logger)
+	if err != nil {
+		return fmt.Errorf("initializing application: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	return application.Run(ctx)
+}
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/auth"
+	"github.com/architeacher/svc-web-analyzer/internal/paseto"
+)
+
+const (
+	tokenUsage = `Usage: web-analyzer token <command> [flags]
+
+Commands:
+  keygen   generate an Ed25519 signing key pair
+  issue    issue a v4.public token signed with AUTH_SIGNING_KEY
+  list     list the revoked token IDs of a running service
+  revoke   revoke a token ID on a running service
+
+Run "web-analyzer token <command> -h" for the flags of a command.
+`
+
+	adminRequestTimeout = 10 * time.Second
+)
+
+var errUsage = errors.New("invalid usage")
+
+// runToken runs the token subcommand, which mints PASETO tokens, e.g. for CI jobs, and manages
+// the revocation list of a running service through its admin endpoints.
+func runToken(args []string, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		_, _ = fmt.Fprint(stderr, tokenUsage)
+
+		return errUsage
+	}
+
+	switch args[0] {
+	case "keygen":
+		return runKeygen(stdout)
+	case "issue":
+		return runIssue(args[1:], stdout, stderr)
+	case "list":
+		return runList(args[1:], stdout, stderr)
+	case "revoke":
+		return runRevoke(args[1:], stdout, stderr)
+	case "-h", "-help", "--help", "help":
+		_, _ = fmt.Fprint(stdout, tokenUsage)
+
+		return nil
+	default:
+		_, _ = fmt.Fprint(stderr, tokenUsage)
+
+		return fmt.Errorf("%w: unknown token command %q", errUsage, args[0])
+	}
+}
+
+// runKeygen prints a new key pair as environment variables.
+func runKeygen(stdout io.Writer) error {
+	public, secret, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		return fmt.Errorf("generating key: %w", err)
+	}
+
+	kid := paseto.PublicKeyID(public)
+
+	_, err = fmt.Fprintf(stdout, "AUTH_SIGNING_KEY_ID=%s\nAUTH_SIGNING_KEY=%s\nAUTH_PUBLIC_KEYS=%s=%s\n",
+		kid, paseto.FormatSecretKey(secret), kid, paseto.FormatPublicKey(public))
+
+	return err
+}
+
+// runIssue prints a token signed with the given key, and its ID and expiration on stderr.
+func runIssue(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("token issue", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	key := fs.String("key", os.Getenv("AUTH_SIGNING_KEY"), "k4.secret signing key (AUTH_SIGNING_KEY)")
+	keyID := fs.String("key-id", os.Getenv("AUTH_SIGNING_KEY_ID"), "key ID written to the footer, defaults to the PASERK identifier (AUTH_SIGNING_KEY_ID)")
+	issuer := fs.String("issuer", envOr("AUTH_ISSUERS", "web-analyzer-service"), "iss claim (first of AUTH_ISSUERS)")
+	audience := fs.String("audience", envOr("AUTH_AUDIENCE", "web-analyzer-api"), "aud claim")
+	subject := fs.String("subject", "", "sub claim, the client the token is issued to (required)")
+	tenant := fs.String("tenant", "", "tenant the analyses of the client belong to, defaults to the subject")
+	scopes := fs.String("scopes", auth.ScopeAnalysisRead+","+auth.ScopeAnalysisWrite, "comma separated scopes")
+	ttl := fs.Duration("ttl", 24*time.Hour, "lifetime of the token")
+	maxTTL := fs.Duration("max-ttl", 365*24*time.Hour, "longest lifetime accepted for -ttl")
+
+	if err := fs.Parse(args); err != nil {
+		return parseError(err)
+	}
+
+	if *key == "" || *subject == "" {
+		fs.Usage()
+
+		return fmt.Errorf("%w: -key and -subject are required", errUsage)
+	}
+
+	secret, err := paseto.ParseSecretKey(*key)
+	if err != nil {
+		return err
+	}
+
+	issuers := splitList(*issuer)
+	if len(issuers) == 0 {
+		return fmt.Errorf("%w: -issuer is required", errUsage)
+	}
+
+	token, claims, err := auth.NewIssuer(secret,
+		auth.WithKeyID(*keyID),
+		auth.WithIssuer(issuers[0]),
+		auth.WithTokenAudience(*audience),
+		auth.WithMaxTTL(*maxTTL),
+	).Issue(auth.TokenRequest{
+		Subject: *subject,
+		Tenant:  *tenant,
+		Scopes:  splitList(*scopes),
+		TTL:     *ttl,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(stderr, "token_id=%s expires_at=%s\n", claims.TokenID, claims.ExpiresAt.Format(time.RFC3339))
+	_, err = fmt.Fprintln(stdout, token)
+
+	return err
+}
+
+// runList prints the revocations in effect on a running service.
+func runList(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("token list", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	client := adminFlags(fs)
+
+	if err := fs.Parse(args); err != nil {
+		return parseError(err)
+	}
+
+	var list struct {
+		Revocations []auth.Revocation `json:"revocations"`
+	}
+
+	if err := client.do(http.MethodGet, "/v1/admin/revocations", nil, &list); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "TOKEN ID\tREVOKED AT\tEXPIRES AT")
+
+	for _, r := range list.Revocations {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\n", r.TokenID, r.RevokedAt.Format(time.RFC3339), r.ExpiresAt.Format(time.RFC3339))
+	}
+
+	return tw.Flush()
+}
+
+// runRevoke revokes a token ID on a running service.
+func runRevoke(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("token revoke", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	client := adminFlags(fs)
+	expiresAt := fs.String("expires-at", "", "RFC 3339 expiration time of the token, defaults to AUTH_MAX_TOKEN_TTL from now")
+
+	if err := fs.Parse(args); err != nil {
+		return parseError(err)
+	}
+
+	if fs.NArg() != 1 {
+		_, _ = fmt.Fprintln(stderr, "Usage: web-analyzer token revoke [flags] <token-id>")
+		fs.PrintDefaults()
+
+		return fmt.Errorf("%w: expected one token ID", errUsage)
+	}
+
+	body := map[string]any{"token_id": fs.Arg(0)}
+
+	if *expiresAt != "" {
+		t, err := time.Parse(time.RFC3339, *expiresAt)
+		if err != nil {
+			return fmt.Errorf("%w: invalid -expires-at: %w", errUsage, err)
+		}
+
+		body["expires_at"] = t
+	}
+
+	var r auth.Revocation
+	if err := client.do(http.MethodPost, "/v1/admin/revocations", body, &r); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(stdout, "revoked %s until %s\n", r.TokenID, r.ExpiresAt.Format(time.RFC3339))
+
+	return err
+}
+
+// adminClient calls the admin endpoints of a running service.
+type adminClient struct {
+	server   *string
+	username *string
+	password *string
+}
+
+func adminFlags(fs *flag.FlagSet) *adminClient {
+	return &adminClient{
+		server:   fs.String("server", envOr("WEB_ANALYZER_URL", "http://localhost:8080"), "base URL of the service (WEB_ANALYZER_URL)"),
+		username: fs.String("username", os.Getenv("WEB_ANALYZER_USERNAME"), "admin username (WEB_ANALYZER_USERNAME)"),
+		password: fs.String("password", os.Getenv("WEB_ANALYZER_PASSWORD"), "admin password (WEB_ANALYZER_PASSWORD)"),
+	}
+}
+
+func (c *adminClient) do(method, path string, body, out any) error {
+	ctx, cancel := context.WithTimeout(context.Background(), adminRequestTimeout)
+	defer cancel()
+
+	var reqBody io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		reqBody = bytes.NewReader(raw)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(*c.server, "/")+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+
+	req.SetBasicAuth(*c.username, *c.password)
+	req.Header.Set("Accept", "application/json")
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling %s %s: %w", method, path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var apiErr struct {
+			Error   string `json:"error"`
+			Message string `json:"message"`
+			Details string `json:"details"`
+		}
+
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+
+		return fmt.Errorf("%s %s: %s: %s (%s)", method, path, resp.Status, apiErr.Message, apiErr.Details)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+
+	return nil
+}
+
+// parseError ends a command whose flags could not be parsed; asking for help is not an error.
+func parseError(err error) error {
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+
+	return errUsage
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+
+	return fallback
+}
+
+func splitList(s string) []string {
+	var items []string
+
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,224 @@
+package analyzer
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/linkchecker"
+)
+
+// Names of the built-in analyzers, which are also the steps reported while they run.
+const (
+	NameHTML              = "html_analysis"
+	NameHeadings          = "heading_analysis"
+	NameLinks             = "link_analysis"
+	NameLinkAccessibility = "link_accessibility"
+	NameForms             = "form_analysis"
+)
+
+// HTMLSummary is the section produced by the html_analysis analyzer.
+type HTMLSummary struct {
+	HTMLVersion string `json:"html_version"`
+	Title       string `json:"title"`
+}
+
+// LinkSummary is the section produced by the link_analysis analyzer: the link counts, along
+// with the links they were counted from, for the analyzers depending on it.
+type LinkSummary struct {
+	*domain.LinkAnalysis
+
+	Links []Link `json:"-"`
+}
+
+// LinkChecker checks the accessibility of links.
+type LinkChecker interface {
+	Check(ctx context.Context, links []*url.URL) []linkchecker.Result
+}
+
+// Builtin returns the analyzers producing the AnalysisData fields, in dependency order.
+func Builtin(lc LinkChecker) []Analyzer {
+	return []Analyzer{
+		htmlAnalyzer{},
+		headingsAnalyzer{},
+		linksAnalyzer{},
+		linkAccessibilityAnalyzer{checker: lc},
+		formsAnalyzer{},
+	}
+}
+
+// NewAnalysisData assembles the analysis result from the sections of a run. Sections of the
+// built-in analyzers fill the typed fields; every other section, and the Failure of any
+// analyzer, is reported under Extensions.
+func NewAnalysisData(results map[string]any) *domain.AnalysisData {
+	data := &domain.AnalysisData{}
+
+	for name, result := range results {
+		if failure, ok := result.(Failure); ok {
+			// Failed sections are reported under Extensions, whichever analyzer they belong to.
+			if data.Extensions == nil {
+				data.Extensions = make(map[string]any)
+			}
+
+			data.Extensions[name] = failure
+
+			continue
+		}
+
+		switch name {
+		case NameHTML:
+			if summary, ok := result.(HTMLSummary); ok {
+				data.HTMLVersion = summary.HTMLVersion
+				data.Title = summary.Title
+			}
+		case NameHeadings:
+			data.HeadingCounts, _ = result.(*domain.HeadingCounts)
+		case NameLinks:
+			if summary, ok := result.(LinkSummary); ok && summary.LinkAnalysis != nil {
+				// Copied, since the accessibility results are merged into it.
+				merged := *summary.LinkAnalysis
+				data.Links = &merged
+			}
+		case NameForms:
+			data.Forms, _ = result.(*domain.FormAnalysis)
+		case NameLinkAccessibility:
+			// Merged into the link analysis below, once it has been assigned.
+		default:
+			if data.Extensions == nil {
+				data.Extensions = make(map[string]any)
+			}
+
+			data.Extensions[name] = result
+		}
+	}
+
+	if inaccessible, ok := results[NameLinkAccessibility].([]domain.InaccessibleLink); ok && data.Links != nil {
+		data.Links.InaccessibleLinks = inaccessible
+	}
+
+	return data
+}
+
+type htmlAnalyzer struct{}
+
+func (htmlAnalyzer) Name() string           { return NameHTML }
+func (htmlAnalyzer) Dependencies() []string { return nil }
+
+func (htmlAnalyzer) Analyze(_ context.Context, doc *Document) (any, error) {
+	return HTMLSummary{
+		HTMLVersion: DetectHTMLVersion(doc),
+		Title:       Title(doc),
+	}, nil
+}
+
+type headingsAnalyzer struct{}
+
+func (headingsAnalyzer) Name() string           { return NameHeadings }
+func (headingsAnalyzer) Dependencies() []string { return nil }
+
+func (headingsAnalyzer) Analyze(_ context.Context, doc *Document) (any, error) {
+	return CountHeadings(doc), nil
+}
+
+type linksAnalyzer struct{}
+
+func (linksAnalyzer) Name() string           { return NameLinks }
+func (linksAnalyzer) Dependencies() []string { return nil }
+
+func (linksAnalyzer) Analyze(_ context.Context, doc *Document) (any, error) {
+	links := ExtractLinks(doc)
+
+	return LinkSummary{LinkAnalysis: SummarizeLinks(links), Links: links}, nil
+}
+
+// linkAccessibilityAnalyzer completes the link_analysis section with the inaccessible links.
+type linkAccessibilityAnalyzer struct {
+	checker LinkChecker
+}
+
+func (linkAccessibilityAnalyzer) Name() string           { return NameLinkAccessibility }
+func (linkAccessibilityAnalyzer) Dependencies() []string { return []string{NameLinks} }
+
+func (a linkAccessibilityAnalyzer) Analyze(ctx context.Context, doc *Document) (any, error) {
+	result, _ := doc.Result(NameLinks)
+
+	summary, ok := result.(LinkSummary)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingResult, NameLinks)
+	}
+
+	targets := make([]*url.URL, 0, len(summary.Links))
+	for _, link := range summary.Links {
+		targets = append(targets, link.URL)
+	}
+
+	inaccessible := []domain.InaccessibleLink{}
+
+	for _, result := range a.checker.Check(ctx, targets) {
+		if result.Accessible() {
+			continue
+		}
+
+		inaccessible = append(inaccessible, domain.InaccessibleLink{
+			URL:        result.URL,
+			StatusCode: result.StatusCode,
+			Error:      result.Error,
+			ErrorCode:  string(result.ErrorCode),
+		})
+	}
+
+	return inaccessible, nil
+}
+
+type formsAnalyzer struct{}
+
+func (formsAnalyzer) Name() string           { return NameForms }
+func (formsAnalyzer) Dependencies() []string { return nil }
+
+func (formsAnalyzer) Analyze(_ context.Context, doc *Document) (any, error) {
+	return AnalyzeForms(doc), nil
+}
+
+// Title returns the normalised text of the document's <title>.
+func Title(doc *Document) string {
+	title := doc.Find(atom.Title)
+	if title == nil {
+		return ""
+	}
+
+	return textContent(title)
+}
+
+// CountHeadings counts the h1-h6 elements of the document.
+func CountHeadings(doc *Document) *domain.HeadingCounts {
+	counts := &domain.HeadingCounts{}
+
+	doc.Walk(func(n *html.Node) bool {
+		if n.Type != html.ElementNode {
+			return true
+		}
+
+		switch n.DataAtom {
+		case atom.H1:
+			counts.H1++
+		case atom.H2:
+			counts.H2++
+		case atom.H3:
+			counts.H3++
+		case atom.H4:
+			counts.H4++
+		case atom.H5:
+			counts.H5++
+		case atom.H6:
+			counts.H6++
+		}
+
+		return true
+	})
+
+	return counts
+}
@@ -0,0 +1,132 @@
+// Package analyzer extracts structural information from parsed HTML documents.
+package analyzer
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Document is a parsed page ready to be analyzed.
+type Document struct {
+	Root *html.Node
+	// URL is the address the page was retrieved from, after redirects.
+	URL *url.URL
+	// BaseURL is the URL relative references are resolved against, honouring <base href>.
+	BaseURL     *url.URL
+	ContentType string
+
+	// results holds the sections produced so far by the analyzers of the current run.
+	results map[string]any
+}
+
+// NewDocument parses the page body.
+func NewDocument(body []byte, pageURL *url.URL, contentType string) (*Document, error) {
+	root, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("parsing html: %w", err)
+	}
+
+	doc := &Document{
+		Root:        root,
+		URL:         pageURL,
+		BaseURL:     pageURL,
+		ContentType: contentType,
+	}
+
+	if base := doc.Find(atom.Base); base != nil {
+		if href, ok := attr(base, "href"); ok {
+			if baseURL, err := pageURL.Parse(strings.TrimSpace(href)); err == nil {
+				doc.BaseURL = baseURL
+			}
+		}
+	}
+
+	return doc, nil
+}
+
+// Walk visits every node of the document in depth-first order.
+// Returning false from fn skips the children of the visited node.
+func (d *Document) Walk(fn func(*html.Node) bool) {
+	walk(d.Root, fn)
+}
+
+// Find returns the first element with the given tag, or nil.
+func (d *Document) Find(tag atom.Atom) *html.Node {
+	var found *html.Node
+
+	d.Walk(func(n *html.Node) bool {
+		if found != nil {
+			return false
+		}
+
+		if n.Type == html.ElementNode && n.DataAtom == tag {
+			found = n
+
+			return false
+		}
+
+		return true
+	})
+
+	return found
+}
+
+// Resolve resolves a reference found in the document against its base URL.
+func (d *Document) Resolve(ref string) (*url.URL, error) {
+	return d.BaseURL.Parse(strings.TrimSpace(ref))
+}
+
+// Result returns the section produced by the named analyzer earlier in the run.
+// Analyzers should only look up the results of their declared dependencies.
+func (d *Document) Result(name string) (any, bool) {
+	result, ok := d.results[name]
+
+	return result, ok
+}
+
+func (d *Document) setResult(name string, result any) {
+	if d.results == nil {
+		d.results = make(map[string]any)
+	}
+
+	d.results[name] = result
+}
+
+func walk(n *html.Node, fn func(*html.Node) bool) {
+	if !fn(n) {
+		return
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		walk(c, fn)
+	}
+}
+
+func attr(n *html.Node, name string) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Namespace == "" && strings.EqualFold(a.Key, name) {
+			return a.Val, true
+		}
+	}
+
+	return "", false
+}
+
+func textContent(n *html.Node) string {
+	var sb strings.Builder
+
+	walk(n, func(c *html.Node) bool {
+		if c.Type == html.TextNode {
+			sb.WriteString(c.Data)
+		}
+
+		return true
+	})
+
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
@@ -0,0 +1,256 @@
+package analyzer
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+var (
+	// identifierPattern matches the name or id of the account identifier field of a login form.
+	identifierPattern = regexp.MustCompile(`(?i)user|login|e-?mail|account|acct|ident|uid|member|nick|handle`)
+
+	// nonFieldInputTypes lists the input types that are buttons rather than fields.
+	nonFieldInputTypes = map[string]struct{}{
+		"submit": {},
+		"reset":  {},
+		"button": {},
+		"image":  {},
+	}
+
+	// freeTextInputTypes lists the input types a user types an arbitrary value into.
+	freeTextInputTypes = map[string]struct{}{
+		"text":   {},
+		"email":  {},
+		"tel":    {},
+		"number": {},
+		"search": {},
+		"url":    {},
+	}
+)
+
+// control is a form field, as seen by the login heuristics.
+type control struct {
+	name         string
+	id           string
+	inputType    string
+	autocomplete []string
+}
+
+func newControl(n *html.Node) control {
+	c := control{inputType: "text"}
+
+	c.name, _ = attr(n, "name")
+	c.id, _ = attr(n, "id")
+
+	switch n.DataAtom {
+	case atom.Input:
+		if t, ok := attr(n, "type"); ok && strings.TrimSpace(t) != "" {
+			c.inputType = strings.ToLower(strings.TrimSpace(t))
+		}
+	case atom.Select:
+		c.inputType = "select"
+	case atom.Textarea:
+		c.inputType = "textarea"
+	}
+
+	if autocomplete, ok := attr(n, "autocomplete"); ok {
+		c.autocomplete = strings.Fields(strings.ToLower(autocomplete))
+	}
+
+	return c
+}
+
+func (c control) isField() bool {
+	_, button := nonFieldInputTypes[c.inputType]
+
+	return !button
+}
+
+func (c control) isPassword() bool {
+	return c.inputType == "password"
+}
+
+func (c control) isFreeText() bool {
+	_, ok := freeTextInputTypes[c.inputType]
+
+	return ok || c.inputType == "textarea"
+}
+
+// isIdentifier reports whether the field holds the username or email address of an account.
+func (c control) isIdentifier() bool {
+	if c.inputType == "email" || c.hasAutocomplete("username") || c.hasAutocomplete("email") {
+		return true
+	}
+
+	if _, ok := freeTextInputTypes[c.inputType]; !ok {
+		return false
+	}
+
+	return identifierPattern.MatchString(c.name) || identifierPattern.MatchString(c.id)
+}
+
+func (c control) hasAutocomplete(token string) bool {
+	for _, t := range c.autocomplete {
+		if t == token {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AnalyzeForms counts the forms of the document and reports the ones that look like login forms.
+//
+// A form is a login form when it has exactly one password field that is not flagged as a new
+// password, together with either an account identifier (email input, username/email autocomplete,
+// or a name/id such as "user" or "login"), an autocomplete="current-password" hint, or no other
+// free text field at all, as in the password step of a multi-step sign in.
+func AnalyzeForms(doc *Document) *domain.FormAnalysis {
+	result := &domain.FormAnalysis{
+		LoginFormDetails: []domain.LoginForm{},
+	}
+
+	for _, form := range findForms(doc) {
+		result.TotalCount++
+
+		controls := formControls(doc, form)
+		if !isLoginForm(controls) {
+			continue
+		}
+
+		fields := []string{}
+		for _, c := range controls {
+			if c.isField() && c.name != "" {
+				fields = append(fields, c.name)
+			}
+		}
+
+		result.LoginFormsDetected++
+		result.LoginFormDetails = append(result.LoginFormDetails, domain.LoginForm{
+			Method: formMethod(form),
+			Action: formAction(doc, form),
+			Fields: fields,
+		})
+	}
+
+	return result
+}
+
+func isLoginForm(controls []control) bool {
+	var (
+		passwords       int
+		newPassword     bool
+		currentPassword bool
+		identifier      bool
+		freeText        bool
+	)
+
+	for _, c := range controls {
+		switch {
+		case c.isPassword():
+			passwords++
+			newPassword = newPassword || c.hasAutocomplete("new-password")
+			currentPassword = currentPassword || c.hasAutocomplete("current-password")
+		case c.isIdentifier():
+			identifier = true
+		case c.isFreeText():
+			freeText = true
+		}
+	}
+
+	// Several password fields or a new-password hint denote a sign up or password change form.
+	if passwords != 1 || newPassword {
+		return false
+	}
+
+	return identifier || currentPassword || !freeText
+}
+
+func findForms(doc *Document) []*html.Node {
+	var forms []*html.Node
+
+	doc.Walk(func(n *html.Node) bool {
+		if n.Type == html.ElementNode && n.DataAtom == atom.Form {
+			forms = append(forms, n)
+		}
+
+		return true
+	})
+
+	return forms
+}
+
+// formControls returns the fields owned by the form: its descendants without a form attribute,
+// and the elements anywhere in the document whose form attribute references its id.
+func formControls(doc *Document, form *html.Node) []control {
+	formID, _ := attr(form, "id")
+
+	var controls []control
+
+	doc.Walk(func(n *html.Node) bool {
+		if n.Type != html.ElementNode {
+			return true
+		}
+
+		switch n.DataAtom {
+		case atom.Input, atom.Select, atom.Textarea:
+		default:
+			return true
+		}
+
+		owner, hasOwner := attr(n, "form")
+
+		switch {
+		case hasOwner && formID != "" && owner == formID:
+			controls = append(controls, newControl(n))
+		case !hasOwner && isDescendant(n, form):
+			controls = append(controls, newControl(n))
+		}
+
+		return true
+	})
+
+	return controls
+}
+
+func isDescendant(n, ancestor *html.Node) bool {
+	for p := n.Parent; p != nil; p = p.Parent {
+		if p == ancestor {
+			return true
+		}
+	}
+
+	return false
+}
+
+// formMethod returns the submission method, which defaults to GET for missing or invalid values.
+func formMethod(form *html.Node) string {
+	method, _ := attr(form, "method")
+	if strings.EqualFold(strings.TrimSpace(method), http.MethodPost) {
+		return http.MethodPost
+	}
+
+	return http.MethodGet
+}
+
+// formAction returns the absolute submission URL. A missing or empty action submits to the
+// document's own URL.
+func formAction(doc *Document, form *html.Node) string {
+	action, _ := attr(form, "action")
+	if strings.TrimSpace(action) == "" {
+		return doc.URL.String()
+	}
+
+	target, err := doc.Resolve(action)
+	if err != nil {
+		return action
+	}
+
+	return target.String()
+}
@@ -0,0 +1,178 @@
+package analyzer_test
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/analyzer"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+func TestAnalyzeForms(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		document  string
+		wantTotal int
+		wantLogin []domain.LoginForm
+	}{
+		{
+			name:      "no form",
+			document:  `<p>nothing to submit</p>`,
+			wantLogin: []domain.LoginForm{},
+		},
+		{
+			name: "username and password",
+			document: `<form method="post" action="/session">
+				<input type="text" name="username"><input type="password" name="password">
+				<input type="submit" name="go" value="Sign in"></form>`,
+			wantTotal: 1,
+			wantLogin: []domain.LoginForm{
+				{Method: "POST", Action: "https://example.com/session", Fields: []string{"username", "password"}},
+			},
+		},
+		{
+			name: "email input",
+			document: `<form method="POST" action="https://auth.example.com/login">
+				<input type="email" name="e"><input type="password" name="p"></form>`,
+			wantTotal: 1,
+			wantLogin: []domain.LoginForm{
+				{Method: "POST", Action: "https://auth.example.com/login", Fields: []string{"e", "p"}},
+			},
+		},
+		{
+			name: "identifier by autocomplete",
+			document: `<form method="post"><input name="x" autocomplete="section-a username">
+				<input type="password" name="y"></form>`,
+			wantTotal: 1,
+			wantLogin: []domain.LoginForm{
+				{Method: "POST", Action: "https://example.com/account/", Fields: []string{"x", "y"}},
+			},
+		},
+		{
+			name:      "identifier by id",
+			document:  `<form method="post"><input id="user_login" name="a"><input type="password" name="b"></form>`,
+			wantTotal: 1,
+			wantLogin: []domain.LoginForm{
+				{Method: "POST", Action: "https://example.com/account/", Fields: []string{"a", "b"}},
+			},
+		},
+		{
+			name:      "get form",
+			document:  `<form action="login.php"><input name="login"><input type="password" name="pass"></form>`,
+			wantTotal: 1,
+			wantLogin: []domain.LoginForm{
+				{Method: "GET", Action: "https://example.com/account/login.php", Fields: []string{"login", "pass"}},
+			},
+		},
+		{
+			name:      "invalid method defaults to get",
+			document:  `<form method="PUT" action="/login"><input name="user"><input type="password" name="pw"></form>`,
+			wantTotal: 1,
+			wantLogin: []domain.LoginForm{
+				{Method: "GET", Action: "https://example.com/login", Fields: []string{"user", "pw"}},
+			},
+		},
+		{
+			name: "password step of a multi-step sign in",
+			document: `<form method="post" action="/login/password"><input type="hidden" name="token">
+				<input type="password" name="password"></form>`,
+			wantTotal: 1,
+			wantLogin: []domain.LoginForm{
+				{Method: "POST", Action: "https://example.com/login/password", Fields: []string{"token", "password"}},
+			},
+		},
+		{
+			name:      "current password hint",
+			document:  `<form method="post"><input name="q"><input type="password" name="secret" autocomplete="current-password"></form>`,
+			wantTotal: 1,
+			wantLogin: []domain.LoginForm{
+				{Method: "POST", Action: "https://example.com/account/", Fields: []string{"q", "secret"}},
+			},
+		},
+		{
+			name: "controls associated by the form attribute",
+			document: `<form id="signin" method="post" action="/session"></form>
+				<input form="signin" name="email" type="email"><input form="signin" type="password" name="password">
+				<input form="other" name="ignored">`,
+			wantTotal: 1,
+			wantLogin: []domain.LoginForm{
+				{Method: "POST", Action: "https://example.com/session", Fields: []string{"email", "password"}},
+			},
+		},
+		{
+			name: "sign up with a password confirmation",
+			document: `<form method="post"><input name="username"><input type="password" name="password">
+				<input type="password" name="confirm"></form>`,
+			wantTotal: 1,
+			wantLogin: []domain.LoginForm{},
+		},
+		{
+			name: "new password hint",
+			document: `<form method="post"><input name="username">
+				<input type="password" name="password" autocomplete="new-password"></form>`,
+			wantTotal: 1,
+			wantLogin: []domain.LoginForm{},
+		},
+		{
+			name:      "password with unrelated free text",
+			document:  `<form method="post"><input name="coupon"><input type="password" name="pin"></form>`,
+			wantTotal: 1,
+			wantLogin: []domain.LoginForm{},
+		},
+		{
+			name:      "search form",
+			document:  `<form action="/search"><input type="search" name="q"><button>Search</button></form>`,
+			wantTotal: 1,
+			wantLogin: []domain.LoginForm{},
+		},
+		{
+			name: "login form among others",
+			document: `<form action="/search"><input name="q"></form>
+				<form method="post" action="/login"><input name="username"><input type="password" name="password"></form>
+				<form method="post" action="/newsletter"><input type="email" name="email"></form>`,
+			wantTotal: 3,
+			wantLogin: []domain.LoginForm{
+				{Method: "POST", Action: "https://example.com/login", Fields: []string{"username", "password"}},
+			},
+		},
+		{
+			name: "relative action against the base url",
+			document: `<head><base href="https://static.example.com/app/"></head>
+				<form method="post" action="auth"><input name="user"><input type="password" name="pw"></form>`,
+			wantTotal: 1,
+			wantLogin: []domain.LoginForm{
+				{Method: "POST", Action: "https://static.example.com/app/auth", Fields: []string{"user", "pw"}},
+			},
+		},
+	}
+
+	pageURL, _ := url.Parse("https://example.com/account/")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := analyzer.NewDocument([]byte(tt.document), pageURL, "text/html")
+			if err != nil {
+				t.Fatalf("NewDocument() error = %v", err)
+			}
+
+			got := analyzer.AnalyzeForms(doc)
+
+			if got.TotalCount != tt.wantTotal {
+				t.Errorf("TotalCount = %d, want %d", got.TotalCount, tt.wantTotal)
+			}
+
+			if got.LoginFormsDetected != len(tt.wantLogin) {
+				t.Errorf("LoginFormsDetected = %d, want %d", got.LoginFormsDetected, len(tt.wantLogin))
+			}
+
+			if !reflect.DeepEqual(got.LoginFormDetails, tt.wantLogin) {
+				t.Errorf("LoginFormDetails = %+v, want %+v", got.LoginFormDetails, tt.wantLogin)
+			}
+		})
+	}
+}
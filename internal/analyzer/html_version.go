@@ -0,0 +1,162 @@
+package analyzer
+
+import (
+	"mime"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// HTML versions reported in AnalysisData.HtmlVersion.
+const (
+	VersionHTML5               = "HTML5"
+	VersionXHTML5              = "XHTML5"
+	VersionHTML401Strict       = "HTML 4.01 Strict"
+	VersionHTML401Transitional = "HTML 4.01 Transitional"
+	VersionHTML401Frameset     = "HTML 4.01 Frameset"
+	VersionHTML40Strict        = "HTML 4.0 Strict"
+	VersionHTML40Transitional  = "HTML 4.0 Transitional"
+	VersionHTML40Frameset      = "HTML 4.0 Frameset"
+	VersionXHTML10Strict       = "XHTML 1.0 Strict"
+	VersionXHTML10Transitional = "XHTML 1.0 Transitional"
+	VersionXHTML10Frameset     = "XHTML 1.0 Frameset"
+	VersionXHTML11             = "XHTML 1.1"
+	VersionXHTMLBasic10        = "XHTML Basic 1.0"
+	VersionXHTMLBasic11        = "XHTML Basic 1.1"
+	VersionXHTMLMobile         = "XHTML Mobile Profile"
+	VersionHTML32              = "HTML 3.2"
+	VersionHTML20              = "HTML 2.0"
+	VersionQuirks              = "Quirks Mode"
+	VersionUnknown             = "Unknown"
+)
+
+// legacyCompatSystemIdentifier is emitted by XSLT processors in place of "<!DOCTYPE html>".
+const legacyCompatSystemIdentifier = "about:legacy-compat"
+
+// publicIdentifiers maps the formal public identifiers of the known DTDs to their version.
+// Identifiers are compared case-insensitively, as browsers do.
+var publicIdentifiers = []struct {
+	prefix  string
+	version string
+}{
+	{"-//W3C//DTD XHTML 1.0 Strict//", VersionXHTML10Strict},
+	{"-//W3C//DTD XHTML 1.0 Transitional//", VersionXHTML10Transitional},
+	{"-//W3C//DTD XHTML 1.0 Frameset//", VersionXHTML10Frameset},
+	{"-//W3C//DTD XHTML 1.1//", VersionXHTML11},
+	{"-//W3C//DTD XHTML Basic 1.0//", VersionXHTMLBasic10},
+	{"-//W3C//DTD XHTML Basic 1.1//", VersionXHTMLBasic11},
+	{"-//WAPFORUM//DTD XHTML Mobile", VersionXHTMLMobile},
+	{"-//OMA//DTD XHTML Mobile", VersionXHTMLMobile},
+	{"-//W3C//DTD HTML 4.01//", VersionHTML401Strict},
+	{"-//W3C//DTD HTML 4.01 Transitional//", VersionHTML401Transitional},
+	{"-//W3C//DTD HTML 4.01 Frameset//", VersionHTML401Frameset},
+	{"-//W3C//DTD HTML 4.0//", VersionHTML40Strict},
+	{"-//W3C//DTD HTML 4.0 Transitional//", VersionHTML40Transitional},
+	{"-//W3C//DTD HTML 4.0 Frameset//", VersionHTML40Frameset},
+	{"-//W3C//DTD HTML 3.2", VersionHTML32},
+	{"-//IETF//DTD HTML 2.0", VersionHTML20},
+	{"-//IETF//DTD HTML//", VersionHTML20},
+	{"-//IETF//DTD HTML Strict//", VersionHTML20},
+	{"-//IETF//DTD HTML Level 2//", VersionHTML20},
+}
+
+// systemIdentifiers covers documents declaring only a system identifier.
+var systemIdentifiers = []struct {
+	suffix  string
+	version string
+}{
+	{"/xhtml1-strict.dtd", VersionXHTML10Strict},
+	{"/xhtml1-transitional.dtd", VersionXHTML10Transitional},
+	{"/xhtml1-frameset.dtd", VersionXHTML10Frameset},
+	{"/xhtml11.dtd", VersionXHTML11},
+	{"/xhtml-basic10.dtd", VersionXHTMLBasic10},
+	{"/xhtml-basic11.dtd", VersionXHTMLBasic11},
+	{"/html4/strict.dtd", VersionHTML401Strict},
+	{"/html4/loose.dtd", VersionHTML401Transitional},
+	{"/html4/frameset.dtd", VersionHTML401Frameset},
+}
+
+// DetectHTMLVersion derives the HTML version from the document type declaration.
+// Documents without a doctype render in quirks mode, unless they are served as XML,
+// in which case they are XHTML documents following the HTML Living Standard.
+func DetectHTMLVersion(doc *Document) string {
+	doctype := findDoctype(doc.Root)
+	servedAsXML := isXMLContentType(doc.ContentType)
+
+	if doctype == nil {
+		if servedAsXML {
+			return VersionXHTML5
+		}
+
+		return VersionQuirks
+	}
+
+	version := doctypeVersion(doctype)
+	if servedAsXML && version == VersionHTML5 {
+		return VersionXHTML5
+	}
+
+	return version
+}
+
+func doctypeVersion(doctype *html.Node) string {
+	if !strings.EqualFold(doctype.Data, "html") {
+		return VersionUnknown
+	}
+
+	public, hasPublic := attr(doctype, "public")
+	system, hasSystem := attr(doctype, "system")
+	public = strings.TrimSpace(public)
+	system = strings.TrimSpace(system)
+
+	if (!hasPublic || public == "") && (!hasSystem || system == "" || strings.EqualFold(system, legacyCompatSystemIdentifier)) {
+		return VersionHTML5
+	}
+
+	for _, id := range publicIdentifiers {
+		if hasPrefixFold(public, id.prefix) {
+			return id.version
+		}
+	}
+
+	for _, id := range systemIdentifiers {
+		if hasSuffixFold(system, id.suffix) {
+			return id.version
+		}
+	}
+
+	return VersionUnknown
+}
+
+func findDoctype(root *html.Node) *html.Node {
+	for c := root.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.DoctypeNode {
+			return c
+		}
+	}
+
+	return nil
+}
+
+func isXMLContentType(contentType string) bool {
+	if contentType == "" {
+		return false
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/xhtml+xml" ||
+		mediaType == "application/xml" ||
+		mediaType == "text/xml"
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+func hasSuffixFold(s, suffix string) bool {
+	return len(s) >= len(suffix) && strings.EqualFold(s[len(s)-len(suffix):], suffix)
+}
@@ -0,0 +1,185 @@
+package analyzer_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/analyzer"
+)
+
+func TestDetectHTMLVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		document    string
+		contentType string
+		want        string
+	}{
+		{
+			name:     "html5",
+			document: `<!DOCTYPE html><html><head><title>t</title></head><body></body></html>`,
+			want:     analyzer.VersionHTML5,
+		},
+		{
+			name:     "html5 lowercase",
+			document: `<!doctype html><title>t</title>`,
+			want:     analyzer.VersionHTML5,
+		},
+		{
+			name:     "html5 legacy compat",
+			document: `<!DOCTYPE html SYSTEM "about:legacy-compat"><title>t</title>`,
+			want:     analyzer.VersionHTML5,
+		},
+		{
+			name:        "html5 served as xhtml",
+			document:    `<!DOCTYPE html><html xmlns="http://www.w3.org/1999/xhtml"><title>t</title></html>`,
+			contentType: "application/xhtml+xml; charset=utf-8",
+			want:        analyzer.VersionXHTML5,
+		},
+		{
+			name: "html 4.01 strict",
+			document: `<!DOCTYPE HTML PUBLIC "-//W3C//DTD HTML 4.01//EN" "http://www.w3.org/TR/html4/strict.dtd">
+<html><title>t</title></html>`,
+			want: analyzer.VersionHTML401Strict,
+		},
+		{
+			name: "html 4.01 transitional",
+			document: `<!DOCTYPE HTML PUBLIC "-//W3C//DTD HTML 4.01 Transitional//EN" "http://www.w3.org/TR/html4/loose.dtd">
+<html><title>t</title></html>`,
+			want: analyzer.VersionHTML401Transitional,
+		},
+		{
+			name:     "html 4.01 transitional without system identifier",
+			document: `<!DOCTYPE HTML PUBLIC "-//W3C//DTD HTML 4.01 Transitional//EN"><html><title>t</title></html>`,
+			want:     analyzer.VersionHTML401Transitional,
+		},
+		{
+			name: "html 4.01 frameset",
+			document: `<!DOCTYPE HTML PUBLIC "-//W3C//DTD HTML 4.01 Frameset//EN" "http://www.w3.org/TR/html4/frameset.dtd">
+<html><frameset><frame src="a.html"></frameset></html>`,
+			want: analyzer.VersionHTML401Frameset,
+		},
+		{
+			name: "xhtml 1.0 strict",
+			document: `<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.0 Strict//EN" "http://www.w3.org/TR/xhtml1/DTD/xhtml1-strict.dtd">
+<html xmlns="http://www.w3.org/1999/xhtml"><title>t</title></html>`,
+			want: analyzer.VersionXHTML10Strict,
+		},
+		{
+			name: "xhtml 1.0 transitional",
+			document: `<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.0 Transitional//EN" "http://www.w3.org/TR/xhtml1/DTD/xhtml1-transitional.dtd">
+<html xmlns="http://www.w3.org/1999/xhtml"><title>t</title></html>`,
+			want: analyzer.VersionXHTML10Transitional,
+		},
+		{
+			name: "xhtml 1.0 frameset",
+			document: `<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.0 Frameset//EN" "http://www.w3.org/TR/xhtml1/DTD/xhtml1-frameset.dtd">
+<html xmlns="http://www.w3.org/1999/xhtml"><title>t</title></html>`,
+			want: analyzer.VersionXHTML10Frameset,
+		},
+		{
+			name: "xhtml 1.1",
+			document: `<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.1//EN" "http://www.w3.org/TR/xhtml11/DTD/xhtml11.dtd">
+<html xmlns="http://www.w3.org/1999/xhtml"><title>t</title></html>`,
+			want: analyzer.VersionXHTML11,
+		},
+		{
+			name: "xhtml 1.1 served as xml",
+			document: `<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.1//EN" "http://www.w3.org/TR/xhtml11/DTD/xhtml11.dtd">
+<html xmlns="http://www.w3.org/1999/xhtml"><title>t</title></html>`,
+			contentType: "application/xhtml+xml",
+			want:        analyzer.VersionXHTML11,
+		},
+		{
+			name:     "xhtml 1.0 strict by system identifier only",
+			document: `<!DOCTYPE html SYSTEM "http://www.w3.org/TR/xhtml1/DTD/xhtml1-strict.dtd"><title>t</title>`,
+			want:     analyzer.VersionXHTML10Strict,
+		},
+		{
+			name: "xhtml basic 1.1",
+			document: `<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML Basic 1.1//EN" "http://www.w3.org/TR/xhtml-basic/xhtml-basic11.dtd">
+<html><title>t</title></html>`,
+			want: analyzer.VersionXHTMLBasic11,
+		},
+		{
+			name:     "html 3.2",
+			document: `<!DOCTYPE HTML PUBLIC "-//W3C//DTD HTML 3.2 Final//EN"><html><title>t</title></html>`,
+			want:     analyzer.VersionHTML32,
+		},
+		{
+			name:     "html 2.0",
+			document: `<!DOCTYPE HTML PUBLIC "-//IETF//DTD HTML 2.0//EN"><html><title>t</title></html>`,
+			want:     analyzer.VersionHTML20,
+		},
+		{
+			name:     "public identifier case insensitive",
+			document: `<!DOCTYPE html public "-//w3c//dtd xhtml 1.0 strict//en" "http://www.w3.org/TR/xhtml1/DTD/xhtml1-strict.dtd"><title>t</title>`,
+			want:     analyzer.VersionXHTML10Strict,
+		},
+		{
+			name:     "no doctype",
+			document: `<html><head><title>t</title></head><body><p>quirks</p></body></html>`,
+			want:     analyzer.VersionQuirks,
+		},
+		{
+			name:     "empty document",
+			document: ``,
+			want:     analyzer.VersionQuirks,
+		},
+		{
+			name:        "no doctype served as xml",
+			document:    `<html xmlns="http://www.w3.org/1999/xhtml"><title>t</title></html>`,
+			contentType: "application/xml",
+			want:        analyzer.VersionXHTML5,
+		},
+		{
+			name:        "no doctype with malformed content type",
+			document:    `<html><title>t</title></html>`,
+			contentType: "text/html; charset",
+			want:        analyzer.VersionQuirks,
+		},
+		{
+			name:     "doctype after content",
+			document: `<p>text</p><!DOCTYPE html>`,
+			want:     analyzer.VersionQuirks,
+		},
+		{
+			name:     "malformed doctype without name",
+			document: `<!DOCTYPE><html><title>t</title></html>`,
+			want:     analyzer.VersionUnknown,
+		},
+		{
+			name:     "malformed doctype with another root name",
+			document: `<!DOCTYPE svg><html><title>t</title></html>`,
+			want:     analyzer.VersionUnknown,
+		},
+		{
+			name:     "malformed doctype with unknown public identifier",
+			document: `<!DOCTYPE html PUBLIC "-//Example//DTD Nothing//EN"><html><title>t</title></html>`,
+			want:     analyzer.VersionUnknown,
+		},
+		{
+			name:     "malformed doctype with unterminated public identifier",
+			document: `<!DOCTYPE html PUBLIC "-//W3C//DTD HTML 4.01//EN><html><title>t</title></html>`,
+			want:     analyzer.VersionHTML401Strict,
+		},
+	}
+
+	pageURL, _ := url.Parse("https://example.com/")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := analyzer.NewDocument([]byte(tt.document), pageURL, tt.contentType)
+			if err != nil {
+				t.Fatalf("NewDocument() error = %v", err)
+			}
+
+			if got := analyzer.DetectHTMLVersion(doc); got != tt.want {
+				t.Errorf("DetectHTMLVersion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
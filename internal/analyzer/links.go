@@ -0,0 +1,71 @@
+package analyzer
+
+import (
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+// Link is an anchor found in a document, resolved to an absolute URL.
+type Link struct {
+	URL      *url.URL
+	Internal bool
+}
+
+// ExtractLinks returns every http(s) <a href> of the document, in document order.
+func ExtractLinks(doc *Document) []Link {
+	var links []Link
+
+	doc.Walk(func(n *html.Node) bool {
+		if n.Type != html.ElementNode || n.DataAtom != atom.A {
+			return true
+		}
+
+		href, ok := attr(n, "href")
+		if !ok || strings.TrimSpace(href) == "" {
+			return true
+		}
+
+		target, err := doc.Resolve(href)
+		if err != nil || (target.Scheme != "http" && target.Scheme != "https") {
+			return true
+		}
+
+		links = append(links, Link{
+			URL:      target,
+			Internal: sameHost(target, doc.URL),
+		})
+
+		return true
+	})
+
+	return links
+}
+
+// SummarizeLinks counts internal and external links.
+func SummarizeLinks(links []Link) *domain.LinkAnalysis {
+	summary := &domain.LinkAnalysis{
+		TotalCount:        len(links),
+		InaccessibleLinks: []domain.InaccessibleLink{},
+	}
+
+	for _, link := range links {
+		if link.Internal {
+			summary.InternalCount++
+
+			continue
+		}
+
+		summary.ExternalCount++
+	}
+
+	return summary
+}
+
+func sameHost(a, b *url.URL) bool {
+	return strings.EqualFold(a.Hostname(), b.Hostname())
+}
@@ -0,0 +1,188 @@
+package analyzer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	// ErrDuplicateAnalyzer is returned when registering an analyzer under a name already in use.
+	ErrDuplicateAnalyzer = errors.New("analyzer already registered")
+	// ErrMissingDependency is returned when registering an analyzer before one of its dependencies.
+	ErrMissingDependency = errors.New("analyzer dependency not registered")
+	// ErrMissingResult is returned by an analyzer when the result of a dependency is missing
+	// from the document or is not of the expected type.
+	ErrMissingResult = errors.New("analyzer dependency result missing")
+	// ErrDependencyFailed is recorded as the Failure of the analyzers whose dependency failed.
+	ErrDependencyFailed = errors.New("analyzer dependency failed")
+)
+
+// Failure is the result section of an analyzer that failed. The other analyzers of the run go
+// on, except those depending on it, which fail in turn.
+type Failure struct {
+	Error string `json:"error"`
+}
+
+// Analyzer is a page check contributing a named section to the analysis result.
+type Analyzer interface {
+	// Name identifies the analyzer and its result section, e.g. "link_analysis".
+	Name() string
+	// Dependencies lists the analyzers that must run first. Their results are available
+	// through Document.Result.
+	Dependencies() []string
+	// Analyze inspects the document and returns the section to add to the result.
+	Analyze(ctx context.Context, doc *Document) (any, error)
+}
+
+// Filter reports whether the named analyzer should run. The dependencies of a selected
+// analyzer always run.
+type Filter func(name string) bool
+
+// Observer is notified as the analyzers of a run start and complete.
+// index is zero based and total is the number of analyzers of the run.
+type Observer interface {
+	StepStarted(name string, index, total int)
+	StepCompleted(name string, result any, index, total int)
+}
+
+// Registry holds the analyzers run against every page.
+type Registry struct {
+	mu        sync.RWMutex
+	analyzers []Analyzer
+	byName    map[string]Analyzer
+}
+
+// NewRegistry creates a Registry with the given analyzers, registered in order.
+func NewRegistry(analyzers ...Analyzer) (*Registry, error) {
+	r := &Registry{byName: make(map[string]Analyzer)}
+
+	for _, a := range analyzers {
+		if err := r.Register(a); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// Register adds an analyzer. Dependencies must be registered before the analyzers
+// depending on them, which also rules out cycles.
+func (r *Registry) Register(a Analyzer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	name := a.Name()
+	if _, ok := r.byName[name]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicateAnalyzer, name)
+	}
+
+	for _, dep := range a.Dependencies() {
+		if _, ok := r.byName[dep]; !ok {
+			return fmt.Errorf("%w: %s requires %s", ErrMissingDependency, name, dep)
+		}
+	}
+
+	r.analyzers = append(r.analyzers, a)
+	r.byName[name] = a
+
+	return nil
+}
+
+// Names returns the names of the registered analyzers, in registration order.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.analyzers))
+	for _, a := range r.analyzers {
+		names = append(names, a.Name())
+	}
+
+	return names
+}
+
+// Run executes the analyzers selected by filter, and their dependencies, in registration order.
+// It returns the result sections keyed by analyzer name. An analyzer that fails gets a Failure
+// as its section, and the run goes on; the run only stops once ctx is done.
+func (r *Registry) Run(ctx context.Context, doc *Document, filter Filter, observer Observer) (map[string]any, error) {
+	plan := r.plan(filter)
+	results := make(map[string]any, len(plan))
+
+	for i, a := range plan {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		name := a.Name()
+		if observer != nil {
+			observer.StepStarted(name, i, len(plan))
+		}
+
+		result, err := analyze(ctx, doc, a, results)
+		switch {
+		case err != nil && ctx.Err() != nil:
+			return nil, fmt.Errorf("running analyzer %s: %w", name, err)
+		case err != nil:
+			result = Failure{Error: err.Error()}
+		default:
+			doc.setResult(name, result)
+		}
+
+		results[name] = result
+
+		if observer != nil {
+			observer.StepCompleted(name, result, i, len(plan))
+		}
+	}
+
+	return results, nil
+}
+
+// analyze runs a, unless one of its dependencies failed.
+func analyze(ctx context.Context, doc *Document, a Analyzer, results map[string]any) (any, error) {
+	for _, dep := range a.Dependencies() {
+		if _, failed := results[dep].(Failure); failed {
+			return nil, fmt.Errorf("%w: %s", ErrDependencyFailed, dep)
+		}
+	}
+
+	return a.Analyze(ctx, doc)
+}
+
+// plan returns the analyzers to run. Since dependencies are registered first, registration
+// order is a valid execution order.
+func (r *Registry) plan(filter Filter) []Analyzer {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	selected := make(map[string]bool, len(r.analyzers))
+
+	var include func(a Analyzer)
+	include = func(a Analyzer) {
+		if selected[a.Name()] {
+			return
+		}
+
+		selected[a.Name()] = true
+		for _, dep := range a.Dependencies() {
+			include(r.byName[dep])
+		}
+	}
+
+	for _, a := range r.analyzers {
+		if filter == nil || filter(a.Name()) {
+			include(a)
+		}
+	}
+
+	plan := make([]Analyzer, 0, len(selected))
+	for _, a := range r.analyzers {
+		if selected[a.Name()] {
+			plan = append(plan, a)
+		}
+	}
+
+	return plan
+}
@@ -0,0 +1,216 @@
+package analyzer_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/analyzer"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/linkchecker"
+)
+
+// stubAnalyzer returns result, or fails with err.
+type stubAnalyzer struct {
+	name   string
+	deps   []string
+	result any
+	err    error
+	ran    *bool
+}
+
+func (a stubAnalyzer) Name() string           { return a.name }
+func (a stubAnalyzer) Dependencies() []string { return a.deps }
+
+func (a stubAnalyzer) Analyze(context.Context, *analyzer.Document) (any, error) {
+	if a.ran != nil {
+		*a.ran = true
+	}
+
+	return a.result, a.err
+}
+
+// recordingChecker reports every link as inaccessible and records the links it was given.
+type recordingChecker struct {
+	links []string
+}
+
+func (c *recordingChecker) Check(_ context.Context, links []*url.URL) []linkchecker.Result {
+	results := make([]linkchecker.Result, 0, len(links))
+
+	for _, link := range links {
+		c.links = append(c.links, link.String())
+		results = append(results, linkchecker.Result{
+			URL:       link.String(),
+			ErrorCode: linkchecker.ErrCodeDNS,
+			Error:     linkchecker.ErrCodeDNS.Description(),
+		})
+	}
+
+	return results
+}
+
+func newDocument(t *testing.T, body string) *analyzer.Document {
+	t.Helper()
+
+	pageURL, _ := url.Parse("https://example.com/")
+
+	doc, err := analyzer.NewDocument([]byte(body), pageURL, "text/html")
+	if err != nil {
+		t.Fatalf("NewDocument() error = %v", err)
+	}
+
+	return doc
+}
+
+func TestRegistryRegister(t *testing.T) {
+	t.Parallel()
+
+	_, err := analyzer.NewRegistry(stubAnalyzer{name: "a"}, stubAnalyzer{name: "a"})
+	if !errors.Is(err, analyzer.ErrDuplicateAnalyzer) {
+		t.Errorf("NewRegistry() with a duplicate error = %v, want %v", err, analyzer.ErrDuplicateAnalyzer)
+	}
+
+	_, err = analyzer.NewRegistry(stubAnalyzer{name: "b", deps: []string{"a"}}, stubAnalyzer{name: "a"})
+	if !errors.Is(err, analyzer.ErrMissingDependency) {
+		t.Errorf("NewRegistry() with a dependency registered late error = %v, want %v", err, analyzer.ErrMissingDependency)
+	}
+}
+
+func TestRegistryRunFilter(t *testing.T) {
+	t.Parallel()
+
+	registry, err := analyzer.NewRegistry(
+		stubAnalyzer{name: "base", result: 1},
+		stubAnalyzer{name: "dependent", deps: []string{"base"}, result: 2},
+		stubAnalyzer{name: "other", result: 3},
+	)
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+
+	results, err := registry.Run(context.Background(), newDocument(t, ""), func(name string) bool {
+		return name == "dependent"
+	}, nil)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	want := map[string]any{"base": 1, "dependent": 2}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("Run() = %v, want %v, the selected analyzer and its dependency", results, want)
+	}
+}
+
+func TestRegistryRunRecordsFailures(t *testing.T) {
+	t.Parallel()
+
+	var dependentRan, laterRan bool
+
+	registry, err := analyzer.NewRegistry(
+		stubAnalyzer{name: "broken", err: errors.New("plugin crashed")},
+		stubAnalyzer{name: "dependent", deps: []string{"broken"}, result: "unused", ran: &dependentRan},
+		stubAnalyzer{name: "later", result: "ok", ran: &laterRan},
+	)
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+
+	results, err := registry.Run(context.Background(), newDocument(t, ""), nil, nil)
+	if err != nil {
+		t.Fatalf("Run() error = %v, want the failure recorded on its section", err)
+	}
+
+	if got, want := results["broken"], (analyzer.Failure{Error: "plugin crashed"}); got != want {
+		t.Errorf("results[broken] = %v, want %v", got, want)
+	}
+
+	failure, ok := results["dependent"].(analyzer.Failure)
+	if !ok || !strings.Contains(failure.Error, analyzer.ErrDependencyFailed.Error()) {
+		t.Errorf("results[dependent] = %v, want a failure of its dependency", results["dependent"])
+	}
+
+	if dependentRan {
+		t.Error("the analyzer depending on a failed one ran")
+	}
+
+	if !laterRan || results["later"] != "ok" {
+		t.Errorf("results[later] = %v, want the analyzers after a failure to run", results["later"])
+	}
+
+	data := analyzer.NewAnalysisData(results)
+	if _, ok := data.Extensions["broken"].(analyzer.Failure); !ok {
+		t.Errorf("Extensions[broken] = %v, want the failure", data.Extensions["broken"])
+	}
+}
+
+func TestRegistryRunStopsOnceCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	registry, err := analyzer.NewRegistry(stubAnalyzer{name: "a", result: 1})
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+
+	if _, err := registry.Run(ctx, newDocument(t, ""), nil, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("Run() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestBuiltinLinkAccessibilityReadsLinkAnalysis(t *testing.T) {
+	t.Parallel()
+
+	checker := &recordingChecker{}
+
+	registry, err := analyzer.NewRegistry(analyzer.Builtin(checker)...)
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+
+	doc := newDocument(t, `<a href="/about">About</a><a href="https://other.example/">Other</a><a href="mailto:x@example.com">Mail</a>`)
+
+	results, err := registry.Run(context.Background(), doc, nil, nil)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	summary, ok := results[analyzer.NameLinks].(analyzer.LinkSummary)
+	if !ok {
+		t.Fatalf("results[%s] = %T, want analyzer.LinkSummary", analyzer.NameLinks, results[analyzer.NameLinks])
+	}
+
+	wantLinks := []string{"https://example.com/about", "https://other.example/"}
+	if !reflect.DeepEqual(checker.links, wantLinks) {
+		t.Errorf("checked links = %v, want %v", checker.links, wantLinks)
+	}
+
+	if len(summary.Links) != len(wantLinks) {
+		t.Errorf("LinkSummary.Links holds %d links, want %d", len(summary.Links), len(wantLinks))
+	}
+
+	data := analyzer.NewAnalysisData(results)
+
+	want := &domain.LinkAnalysis{
+		InternalCount: 1,
+		ExternalCount: 1,
+		TotalCount:    2,
+		InaccessibleLinks: []domain.InaccessibleLink{
+			{URL: "https://example.com/about", Error: "DNS lookup failed", ErrorCode: "dns_error"},
+			{URL: "https://other.example/", Error: "DNS lookup failed", ErrorCode: "dns_error"},
+		},
+	}
+	if !reflect.DeepEqual(data.Links, want) {
+		t.Errorf("Links = %+v, want %+v", data.Links, want)
+	}
+
+	// The section of the run is not changed by the merge of the accessibility results.
+	if got := len(summary.InaccessibleLinks); got != 0 {
+		t.Errorf("link_analysis section holds %d inaccessible links, want 0", got)
+	}
+}
@@ -0,0 +1,683 @@
+// Package app wires the service components together and runs the HTTP server.
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+
+	"github.com/architeacher/svc-web-analyzer/internal/analyzer"
+	"github.com/architeacher/svc-web-analyzer/internal/auth"
+	"github.com/architeacher/svc-web-analyzer/internal/cache"
+	"github.com/architeacher/svc-web-analyzer/internal/config"
+	"github.com/architeacher/svc-web-analyzer/internal/crawler"
+	"github.com/architeacher/svc-web-analyzer/internal/events"
+	"github.com/architeacher/svc-web-analyzer/internal/fetcher"
+	"github.com/architeacher/svc-web-analyzer/internal/handlers"
+	"github.com/architeacher/svc-web-analyzer/internal/linkchecker"
+	"github.com/architeacher/svc-web-analyzer/internal/middleware"
+	"github.com/architeacher/svc-web-analyzer/internal/netguard"
+	"github.com/architeacher/svc-web-analyzer/internal/paseto"
+	"github.com/architeacher/svc-web-analyzer/internal/queue"
+	"github.com/architeacher/svc-web-analyzer/internal/ratelimit"
+	"github.com/architeacher/svc-web-analyzer/internal/renderer"
+	"github.com/architeacher/svc-web-analyzer/internal/repository"
+	"github.com/architeacher/svc-web-analyzer/internal/service"
+	"github.com/architeacher/svc-web-analyzer/internal/sitemap"
+)
+
+const (
+	// storageSetupTimeout bounds connecting to the storage and migrating its schema on startup.
+	storageSetupTimeout = time.Minute
+	// maxSitemapTimeout bounds reading the sitemap of a batch, which its request waits for.
+	maxSitemapTimeout = 10 * time.Second
+)
+
+// App is the web analyzer service.
+type App struct {
+	cfg     *config.Config
+	logger  *slog.Logger
+	server  *http.Server
+	service *service.AnalysisService
+	sweeper *repository.Sweeper
+	handler *handlers.RequestHandler
+	// closers release the backing services once the server is down.
+	closers []io.Closer
+}
+
+// Option customises the application wiring.
+type Option func(*options)
+
+type options struct {
+	analyzers []analyzer.Analyzer
+	renderer  renderer.Renderer
+}
+
+// WithAnalyzers registers additional analyzers, run after the built-in ones. Each contributes
+// a section to AnalysisData.Extensions under its name.
+func WithAnalyzers(analyzers ...analyzer.Analyzer) Option {
+	return func(o *options) {
+		o.analyzers = append(o.analyzers, analyzers...)
+	}
+}
+
+// WithRenderer renders the pages of the analyses asking for it with r, such as a
+// renderertest.Renderer, rather than with the browser configured by RENDERER_*.
+func WithRenderer(r renderer.Renderer) Option {
+	return func(o *options) {
+		o.renderer = r
+	}
+}
+
+// New wires the application components described by cfg.
+func New(cfg *config.Config, logger *slog.Logger, opts ...Option) (*App, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	guard, err := netguard.New(cfg.Fetcher.AllowedHosts...)
+	if err != nil {
+		return nil, fmt.Errorf("parsing FETCHER_ALLOWED_HOSTS: %w", err)
+	}
+
+	// Parsed before any resource is created, so that none is left open by a failure.
+	realIP, err := middleware.RealIP(cfg.HTTPServer.TrustedProxies...)
+	if err != nil {
+		return nil, fmt.Errorf("parsing HTTP_SERVER_TRUSTED_PROXIES: %w", err)
+	}
+
+	if len(cfg.Fetcher.AllowedHosts) > 0 {
+		logger.Info("non-public hosts allowed for analysis", slog.Any("allowed_hosts", cfg.Fetcher.AllowedHosts))
+	}
+
+	pageFetcher := fetcher.New(
+		fetcher.WithUserAgent(cfg.Fetcher.UserAgent),
+		fetcher.WithMaxBodySize(cfg.Fetcher.MaxBodySize),
+		fetcher.WithMaxResponseSize(cfg.Fetcher.MaxResponseSize),
+		fetcher.WithMaxRedirects(cfg.Fetcher.MaxRedirects),
+		fetcher.WithGuard(guard),
+	)
+
+	linkChecker := linkchecker.New(
+		linkchecker.WithWorkers(cfg.LinkChecker.Workers),
+		linkchecker.WithPerHostLimit(cfg.LinkChecker.MaxPerHost),
+		linkchecker.WithTimeout(cfg.LinkChecker.Timeout),
+		linkchecker.WithUserAgent(cfg.Fetcher.UserAgent),
+		linkchecker.WithGuard(guard),
+	)
+
+	analyzers, err := analyzer.NewRegistry(append(analyzer.Builtin(linkChecker), o.analyzers...)...)
+	if err != nil {
+		return nil, fmt.Errorf("registering analyzers: %w", err)
+	}
+
+	repo, err := newRepository(cfg.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	closers := make([]io.Closer, 0, 2)
+	if c, ok := repo.(io.Closer); ok {
+		closers = append(closers, c)
+	}
+
+	jobs, err := newQueue(cfg.Queue, logger)
+	if err != nil {
+		closeAll(closers)
+
+		return nil, err
+	}
+
+	// The queue is closed first, so that no job is handled once the repository is gone.
+	closers = append([]io.Closer{jobs}, closers...)
+
+	hub := events.NewHub(
+		events.WithBufferSize(cfg.Events.BufferSize),
+		events.WithRetention(cfg.Events.Retention),
+		events.WithIdleTimeout(cfg.Events.IdleTimeout),
+	)
+
+	handlerOpts := []handlers.HandlerOption{
+		handlers.WithEvents(hub),
+		handlers.WithHeartbeatInterval(cfg.Events.HeartbeatInterval),
+		handlers.WithDependency("storage", repo),
+		handlers.WithDependency("queue", jobs),
+	}
+
+	serviceOpts := []service.Option{
+		service.WithEvents(hub),
+		service.WithCrawler(crawler.New(
+			crawler.WithUserAgent(cfg.Fetcher.UserAgent),
+			crawler.WithDelays(cfg.Crawler.MinDelay, cfg.Crawler.MaxDelay),
+			crawler.WithMaxDuration(cfg.Crawler.MaxDuration),
+			crawler.WithGuard(guard),
+		)),
+		service.WithSitemaps(sitemap.New(
+			sitemap.WithUserAgent(cfg.Fetcher.UserAgent),
+			sitemap.WithMaxSize(cfg.Fetcher.MaxBodySize),
+			sitemap.WithTimeout(sitemapTimeout(cfg.HTTPServer.WriteTimeout)),
+			sitemap.WithGuard(guard),
+		)),
+	}
+
+	pageRenderer := o.renderer
+	if pageRenderer == nil && cfg.Renderer.Enabled {
+		browser, err := newRenderer(cfg, guard)
+		if err != nil {
+			closeAll(closers)
+
+			return nil, err
+		}
+
+		closers = append(closers, browser)
+		handlerOpts = append(handlerOpts, handlers.WithDependency("renderer", browser))
+		pageRenderer = browser
+	}
+
+	if pageRenderer != nil {
+		serviceOpts = append(serviceOpts, service.WithRenderer(pageRenderer))
+	}
+
+	if cfg.Cache.Freshness > 0 {
+		resultCache, err := newResultCache(cfg.Cache)
+		if err != nil {
+			closeAll(closers)
+
+			return nil, err
+		}
+
+		if c, ok := resultCache.(io.Closer); ok {
+			closers = append(closers, c)
+		}
+
+		results := cache.NewResultCache(resultCache, cfg.Cache.Freshness)
+		serviceOpts = append(serviceOpts, service.WithResultCache(results))
+		handlerOpts = append(handlerOpts, handlers.WithDependency("cache", results))
+	}
+
+	revocations, err := newRevocationList(cfg.Auth)
+	if err != nil {
+		closeAll(closers)
+
+		return nil, err
+	}
+
+	if r, ok := revocations.(*auth.RedisRevocationList); ok {
+		closers = append(closers, r)
+		handlerOpts = append(handlerOpts, handlers.WithDependency("revocations", r))
+	}
+
+	issuer, err := newIssuer(cfg.Auth)
+	if err != nil {
+		closeAll(closers)
+
+		return nil, err
+	}
+
+	var middlewares []handlers.MiddlewareFunc
+
+	if cfg.Validation.Enabled {
+		spec, err := handlers.GetSwagger()
+		if err != nil {
+			closeAll(closers)
+
+			return nil, fmt.Errorf("loading the embedded specification: %w", err)
+		}
+
+		var validationOpts []handlers.ValidationOption
+		if cfg.Validation.Responses {
+			logger.Warn("response validation enabled, responses are buffered")
+
+			validationOpts = append(validationOpts, handlers.WithResponseValidation())
+		}
+
+		// First, so that only authenticated and unthrottled requests are validated, and every
+		// response of the handlers is.
+		middlewares = append(middlewares, handlers.NewValidationMiddleware(spec, validationOpts...))
+	}
+
+	if cfg.RateLimit.Enabled {
+		limiter, err := newRateLimiter(cfg.RateLimit)
+		if err != nil {
+			closeAll(closers)
+
+			return nil, err
+		}
+
+		if l, ok := limiter.(*ratelimit.RedisLimiter); ok {
+			closers = append(closers, l)
+			handlerOpts = append(handlerOpts, handlers.WithDependency("rate_limiter", l))
+		}
+
+		// Before authentication, so that it runs after it and throttles the token subjects.
+		middlewares = append(middlewares, handlers.NewRateLimitMiddleware(limiter, handlers.RateLimits{
+			Analyze: ratelimit.Limit{Requests: cfg.RateLimit.AnalyzeRequests, Period: cfg.RateLimit.AnalyzePeriod},
+			Batch:   ratelimit.Limit{Requests: cfg.RateLimit.BatchRequests, Period: cfg.RateLimit.BatchPeriod},
+			Read:    ratelimit.Limit{Requests: cfg.RateLimit.ReadRequests, Period: cfg.RateLimit.ReadPeriod},
+		}, logger))
+	} else {
+		logger.Warn("rate limiting disabled")
+	}
+
+	if cfg.Auth.Enabled {
+		verifier, err := newVerifier(cfg.Auth, issuer, revocations)
+		if err != nil {
+			closeAll(closers)
+
+			return nil, err
+		}
+
+		middlewares = append(middlewares, handlers.NewAuthMiddleware(verifier))
+	} else {
+		logger.Warn("authentication disabled, the analysis endpoints are public")
+	}
+
+	if cfg.BasicAuth.Enabled() {
+		credentials, err := newCredentials(cfg.BasicAuth)
+		if err != nil {
+			closeAll(closers)
+
+			return nil, err
+		}
+
+		var limiter handlers.LoginLimiter
+		if cfg.BasicAuth.MaxFailures > 0 {
+			limiter = auth.NewLockout(cfg.BasicAuth.MaxFailures, cfg.BasicAuth.Lockout)
+		}
+
+		middlewares = append(middlewares, handlers.NewBasicAuthMiddleware(credentials, limiter))
+		handlerOpts = append(handlerOpts,
+			handlers.WithTokenAdmin(revocations, cfg.Auth.MaxTokenTTL),
+			handlers.WithTokenIssuer(issuer),
+		)
+	} else {
+		logger.Warn("basic authentication disabled, the health check is public and the admin endpoints are off")
+	}
+
+	analysisService := service.NewAnalysisService(pageFetcher, analyzers, jobs, repo, logger, serviceOpts...)
+	requestHandler := handlers.NewRequestHandler(analysisService, cfg.App.Version, handlerOpts...)
+
+	routerMiddlewares := []func(http.Handler) http.Handler{
+		chimiddleware.RequestID,
+		realIP,
+		middleware.RequestLogger(logger),
+	}
+
+	if cfg.Security.Enabled {
+		// Among the router middlewares, so that unmatched routes, preflight requests and every
+		// rejection, down to recovered panics, carry the headers too.
+		routerMiddlewares = append(routerMiddlewares, handlers.NewSecurityHeadersMiddleware(securityHeaderOptions(cfg.Security)...))
+	} else {
+		logger.Warn("security headers disabled")
+	}
+
+	routerMiddlewares = append(routerMiddlewares, handlers.Recoverer)
+
+	if len(cfg.CORS.AllowedOrigins) > 0 {
+		routerMiddlewares = append(routerMiddlewares, middleware.CORS(middleware.CORSOptions{
+			AllowedOrigins:   cfg.CORS.AllowedOrigins,
+			AllowedMethods:   cfg.CORS.AllowedMethods,
+			AllowedHeaders:   cfg.CORS.AllowedHeaders,
+			ExposedHeaders:   cfg.CORS.ExposedHeaders,
+			AllowCredentials: cfg.CORS.AllowCredentials,
+			MaxAge:           cfg.CORS.MaxAge,
+		}))
+	}
+
+	router := handlers.NewRouter(requestHandler, handlers.RouterOptions{
+		BaseURL:           cfg.HTTPServer.BaseURL,
+		Middlewares:       middlewares,
+		RouterMiddlewares: routerMiddlewares,
+	})
+
+	return &App{
+		cfg:    cfg,
+		logger: logger,
+		server: &http.Server{
+			Addr:              cfg.HTTPServer.Addr(),
+			Handler:           router,
+			ReadHeaderTimeout: cfg.HTTPServer.ReadHeaderTimeout,
+			ReadTimeout:       cfg.HTTPServer.ReadTimeout,
+			WriteTimeout:      cfg.HTTPServer.WriteTimeout,
+			IdleTimeout:       cfg.HTTPServer.IdleTimeout,
+			ErrorLog:          slog.NewLogLogger(logger.Handler(), slog.LevelError),
+		},
+		service: analysisService,
+		sweeper: repository.NewSweeper(repo, cfg.Storage.Retention, jobLifetime(cfg.Queue), cfg.Storage.SweepInterval, logger),
+		handler: requestHandler,
+		closers: closers,
+	}, nil
+}
+
+func newVerifier(cfg config.AuthConfig, issuer *auth.Issuer, revocations auth.RevocationList) (*auth.Verifier, error) {
+	opts := []auth.Option{
+		auth.WithIssuers(cfg.Issuers...),
+		auth.WithAudience(cfg.Audience),
+		auth.WithLeeway(cfg.Leeway),
+		auth.WithRevocationList(revocations),
+	}
+
+	if issuer != nil {
+		opts = append(opts, auth.WithPublicKey(issuer.KeyID(), issuer.PublicKey()))
+	}
+
+	for kid, serialised := range cfg.PublicKeys {
+		key, err := paseto.ParsePublicKey(serialised)
+		if err != nil {
+			return nil, fmt.Errorf("parsing AUTH_PUBLIC_KEYS %q: %w", kid, err)
+		}
+
+		opts = append(opts, auth.WithPublicKey(kid, key))
+	}
+
+	for kid, serialised := range cfg.LocalKeys {
+		key, err := paseto.ParseLocalKey(serialised)
+		if err != nil {
+			return nil, fmt.Errorf("parsing AUTH_LOCAL_KEYS %q: %w", kid, err)
+		}
+
+		opts = append(opts, auth.WithLocalKey(kid, key))
+	}
+
+	return auth.NewVerifier(opts...), nil
+}
+
+// newIssuer returns the issuer of the tokens requested through the admin endpoints, or nil when
+// no signing key is configured.
+func newIssuer(cfg config.AuthConfig) (*auth.Issuer, error) {
+	if cfg.SigningKey == "" {
+		return nil, nil
+	}
+
+	key, err := paseto.ParseSecretKey(cfg.SigningKey)
+	if err != nil {
+		return nil, fmt.Errorf("parsing AUTH_SIGNING_KEY: %w", err)
+	}
+
+	opts := []auth.IssuerOption{
+		auth.WithKeyID(cfg.SigningKeyID),
+		auth.WithTokenAudience(cfg.Audience),
+		auth.WithMaxTTL(cfg.MaxTokenTTL),
+	}
+
+	if len(cfg.Issuers) > 0 {
+		opts = append(opts, auth.WithIssuer(cfg.Issuers[0]))
+	}
+
+	return auth.NewIssuer(key, opts...), nil
+}
+
+func securityHeaderOptions(cfg config.SecurityConfig) []handlers.SecurityHeadersOption {
+	var opts []handlers.SecurityHeadersOption
+
+	value := func(v string) string {
+		if v == config.SecurityHeadersOff {
+			return ""
+		}
+
+		return v
+	}
+
+	for name, v := range map[string]string{
+		handlers.HeaderContentTypeOptions:      cfg.ContentTypeOptions,
+		handlers.HeaderFrameOptions:            cfg.FrameOptions,
+		handlers.HeaderXSSProtection:           cfg.XSSProtection,
+		handlers.HeaderStrictTransportSecurity: cfg.StrictTransportSecurity,
+		handlers.HeaderContentSecurityPolicy:   cfg.ContentSecurityPolicy,
+		handlers.HeaderReferrerPolicy:          cfg.ReferrerPolicy,
+		handlers.HeaderPermissionsPolicy:       cfg.PermissionsPolicy,
+	} {
+		if v != "" {
+			opts = append(opts, handlers.WithSecurityHeader(name, value(v)))
+		}
+	}
+
+	if cfg.EventsCSP != "" {
+		for _, pattern := range []string{handlers.EventsRoutePattern, handlers.BatchEventsRoutePattern} {
+			opts = append(opts, handlers.WithRouteSecurityHeader(pattern,
+				handlers.HeaderContentSecurityPolicy, value(cfg.EventsCSP)))
+		}
+	}
+
+	for pattern, csp := range cfg.RouteCSP {
+		opts = append(opts, handlers.WithRouteSecurityHeader(pattern, handlers.HeaderContentSecurityPolicy, value(csp)))
+	}
+
+	return opts
+}
+
+// newCredentials loads the users of the operations secured with BasicAuth.
+func newCredentials(cfg config.BasicAuthConfig) (*auth.Htpasswd, error) {
+	credentials := auth.NewHtpasswd()
+
+	if err := credentials.Add(strings.NewReader(cfg.Users)); err != nil {
+		return nil, fmt.Errorf("parsing BASIC_AUTH_USERS: %w", err)
+	}
+
+	if cfg.UsersFile != "" {
+		if err := credentials.AddFile(cfg.UsersFile); err != nil {
+			return nil, fmt.Errorf("loading BASIC_AUTH_USERS_FILE: %w", err)
+		}
+	}
+
+	if credentials.Len() == 0 {
+		return nil, errors.New("no users in BASIC_AUTH_USERS nor BASIC_AUTH_USERS_FILE")
+	}
+
+	return credentials, nil
+}
+
+func newRevocationList(cfg config.AuthConfig) (auth.RevocationList, error) {
+	if cfg.RevocationDriver != config.RevocationDriverRedis {
+		return auth.NewMemoryRevocationList(), nil
+	}
+
+	list, err := auth.NewRedisRevocationList(cfg.RevocationRedisURL)
+	if err != nil {
+		return nil, fmt.Errorf("creating redis revocation list: %w", err)
+	}
+
+	return list, nil
+}
+
+func newRepository(cfg config.StorageConfig) (repository.AnalysisRepository, error) {
+	if cfg.Driver != config.StorageDriverPostgres {
+		return repository.NewMemory(), nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), storageSetupTimeout)
+	defer cancel()
+
+	repo, err := repository.NewPostgres(ctx, cfg.PostgresDSN, repository.WithMaxConns(cfg.PostgresMaxConns))
+	if err != nil {
+		return nil, fmt.Errorf("creating postgres repository: %w", err)
+	}
+
+	if cfg.Migrate {
+		if err := repo.Migrate(ctx); err != nil {
+			_ = repo.Close()
+
+			return nil, fmt.Errorf("migrating postgres repository: %w", err)
+		}
+	}
+
+	return repo, nil
+}
+
+func newResultCache(cfg config.CacheConfig) (cache.Cache, error) {
+	if cfg.Driver != config.CacheDriverRedis {
+		return cache.NewMemory(cache.WithMaxEntries(cfg.MaxEntries)), nil
+	}
+
+	c, err := cache.NewRedis(cfg.RedisURL, cache.WithPoolSize(cfg.RedisPoolSize))
+	if err != nil {
+		return nil, fmt.Errorf("creating redis cache: %w", err)
+	}
+
+	return c, nil
+}
+
+func newRateLimiter(cfg config.RateLimitConfig) (handlers.RateLimiter, error) {
+	if cfg.Driver != config.RateLimitDriverRedis {
+		return ratelimit.NewMemory(), nil
+	}
+
+	l, err := ratelimit.NewRedis(cfg.RedisURL)
+	if err != nil {
+		return nil, fmt.Errorf("creating redis rate limiter: %w", err)
+	}
+
+	return l, nil
+}
+
+func newRenderer(cfg *config.Config, guard *netguard.Guard) (*renderer.Chromium, error) {
+	opts := []renderer.ChromiumOption{
+		renderer.WithMaxTabs(cfg.Renderer.MaxTabs),
+		renderer.WithUserAgent(cfg.Fetcher.UserAgent),
+		renderer.WithGuard(guard),
+		renderer.WithProxyAddr(cfg.Renderer.ProxyAddr),
+		renderer.WithProxyURL(cfg.Renderer.ProxyURL),
+	}
+
+	switch {
+	case cfg.Renderer.RemoteURL != "":
+		opts = append(opts, renderer.WithRemoteURL(cfg.Renderer.RemoteURL))
+	case cfg.Renderer.ExecPath != "":
+		opts = append(opts, renderer.WithExecPath(cfg.Renderer.ExecPath))
+	}
+
+	if cfg.Renderer.NoSandbox {
+		opts = append(opts, renderer.WithNoSandbox())
+	}
+
+	browser, err := renderer.NewChromium(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating chromium renderer: %w", err)
+	}
+
+	return browser, nil
+}
+
+// sitemapTimeout returns how long the sitemap of a batch may be read for, well within the
+// write timeout of the server, if any, so that the request can still be answered.
+func sitemapTimeout(writeTimeout time.Duration) time.Duration {
+	if writeTimeout <= 0 {
+		return maxSitemapTimeout
+	}
+
+	return min(maxSitemapTimeout, writeTimeout/2)
+}
+
+// jobLifetime returns the longest the job of an analysis may live in the queue: every attempt
+// running up to the visibility timeout, with the longest backoff between them.
+func jobLifetime(cfg config.QueueConfig) time.Duration {
+	attempts := time.Duration(max(cfg.MaxAttempts, 1))
+
+	return attempts*cfg.VisibilityTimeout + (attempts-1)*cfg.MaxBackoff
+}
+
+func closeAll(closers []io.Closer) {
+	for _, c := range closers {
+		_ = c.Close()
+	}
+}
+
+func newQueue(cfg config.QueueConfig, logger *slog.Logger) (queue.Queue, error) {
+	opts := []queue.Option{
+		queue.WithWorkers(cfg.Workers),
+		queue.WithCapacity(cfg.Capacity),
+		queue.WithVisibilityTimeout(cfg.VisibilityTimeout),
+		queue.WithRetryPolicy(queue.RetryPolicy{
+			MaxAttempts:    cfg.MaxAttempts,
+			InitialBackoff: cfg.InitialBackoff,
+			MaxBackoff:     cfg.MaxBackoff,
+		}),
+		queue.WithLogger(logger),
+	}
+
+	if cfg.Driver == config.QueueDriverAMQP {
+		q, err := queue.DialAMQP(cfg.AMQPURL, cfg.AMQPQueue, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("creating amqp queue: %w", err)
+		}
+
+		return q, nil
+	}
+
+	return queue.NewMemory(opts...), nil
+}
+
+// Run serves HTTP until ctx is cancelled, then shuts down gracefully.
+func (a *App) Run(ctx context.Context) error {
+	a.service.Start()
+
+	go a.sweeper.Run(ctx)
+
+	serveErr := make(chan error, 1)
+
+	go func() {
+		a.logger.Info("http server listening",
+			slog.String("addr", a.server.Addr),
+			slog.String("version", a.cfg.App.Version),
+		)
+
+		if err := a.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- err
+		}
+
+		close(serveErr)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return fmt.Errorf("http server: %w", err)
+	case <-ctx.Done():
+	}
+
+	return a.shutdown()
+}
+
+// shutdown stops accepting connections, drains in-flight analyses so that open event
+// streams can deliver their final event, then closes the remaining streams.
+func (a *App) shutdown() error {
+	a.logger.Info("shutting down", slog.Duration("timeout", a.cfg.HTTPServer.ShutdownTimeout))
+
+	ctx, cancel := context.WithTimeout(context.Background(), a.cfg.HTTPServer.ShutdownTimeout)
+	defer cancel()
+
+	serverDone := make(chan error, 1)
+	go func() {
+		serverDone <- a.server.Shutdown(ctx)
+	}()
+
+	var errs []error
+
+	start := time.Now()
+	if err := a.service.Shutdown(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("draining analyses: %w", err))
+	}
+
+	a.logger.Info("in-flight analyses drained", slog.Duration("elapsed", time.Since(start)))
+
+	a.handler.CloseStreams()
+
+	if err := <-serverDone; err != nil {
+		errs = append(errs, fmt.Errorf("shutting down http server: %w", err))
+	}
+
+	for _, c := range a.closers {
+		if err := c.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,548 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+
+	"github.com/architeacher/svc-web-analyzer/internal/config"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/handlers"
+	"github.com/architeacher/svc-web-analyzer/internal/renderer/renderertest"
+)
+
+const testPage = `<!DOCTYPE html>
+<html>
+<head><title>Integration</title></head>
+<body>
+<h1>Integration</h1>
+<a href="/about">About</a>
+<form><input type="password" name="password"></form>
+</body>
+</html>`
+
+// testEnv serves the analysis endpoints publicly, letting them reach the loopback test sites and
+// submit a few batches.
+var testEnv = map[string]string{
+	"AUTH_ENABLED":              "false",
+	"FETCHER_ALLOWED_HOSTS":     "127.0.0.1",
+	"CRAWLER_MIN_DELAY":         "0s",
+	"CRAWLER_MAX_DELAY":         "0s",
+	"LINK_CHECKER_TIMEOUT":      "2s",
+	"RATE_LIMIT_BATCH_REQUESTS": "10",
+}
+
+// specClient sends requests to the served application, validating every response against the
+// embedded specification.
+type specClient struct {
+	t      *testing.T
+	base   string
+	router routers.Router
+}
+
+func newSpecClient(t *testing.T, base string) *specClient {
+	t.Helper()
+
+	swagger, err := handlers.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger() error = %v", err)
+	}
+
+	// The servers end with the version, which the paths of the served routes already start with.
+	swagger.Servers = nil
+
+	router, err := legacy.NewRouter(swagger)
+	if err != nil {
+		t.Fatalf("legacy.NewRouter() error = %v", err)
+	}
+
+	return &specClient{t: t, base: base, router: router}
+}
+
+// do sends the request and returns the response, its body read and validated.
+func (c *specClient) do(method, path string, body any) (*http.Response, []byte) {
+	c.t.Helper()
+
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			c.t.Fatalf("json.Marshal() error = %v", err)
+		}
+
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequest(method, c.base+path, reader)
+	if err != nil {
+		c.t.Fatalf("http.NewRequest() error = %v", err)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		c.t.Fatalf("%s %s error = %v", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	payload, err := io.ReadAll(resp.Body)
+	if err != nil {
+		c.t.Fatalf("reading the response of %s %s: %v", method, path, err)
+	}
+
+	c.validate(req, resp, payload)
+
+	return resp, payload
+}
+
+func (c *specClient) validate(req *http.Request, resp *http.Response, payload []byte) {
+	c.t.Helper()
+
+	route, params, err := c.router.FindRoute(req)
+	if err != nil {
+		// Only the responses of the operations of the specification are described by it.
+		return
+	}
+
+	mediaType := resp.Header.Get("Content-Type")
+
+	err = openapi3filter.ValidateResponse(context.Background(), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: &openapi3filter.RequestValidationInput{
+			Request:    req,
+			PathParams: params,
+			Route:      route,
+		},
+		Status: resp.StatusCode,
+		Header: resp.Header,
+		Body:   io.NopCloser(bytes.NewReader(payload)),
+		Options: &openapi3filter.Options{
+			IncludeResponseStatus: true,
+			// The events are not described by a schema.
+			ExcludeResponseBody: strings.HasPrefix(mediaType, "text/event-stream"),
+		},
+	})
+	if err != nil {
+		c.t.Errorf("%s %s: response %d %s does not match the specification: %v",
+			req.Method, req.URL.Path, resp.StatusCode, payload, err)
+	}
+}
+
+// expect sends the request, checking the status of its response.
+func (c *specClient) expect(method, path string, body any, wantStatus int) []byte {
+	c.t.Helper()
+
+	resp, payload := c.do(method, path, body)
+	if resp.StatusCode != wantStatus {
+		c.t.Fatalf("%s %s status = %d, want %d: %s", method, path, resp.StatusCode, wantStatus, payload)
+	}
+
+	return payload
+}
+
+func decode[T any](t *testing.T, payload []byte) T {
+	t.Helper()
+
+	var v T
+	if err := json.Unmarshal(payload, &v); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", payload, err)
+	}
+
+	return v
+}
+
+// submit submits the analysis described by body, returning its path.
+func (c *specClient) submit(body map[string]any) string {
+	c.t.Helper()
+
+	accepted := decode[handlers.AnalysisResponse](c.t, c.expect(http.MethodPost, "/v1/analyze", body, http.StatusAccepted))
+	if accepted.AnalysisId == nil {
+		c.t.Fatalf("analyze response %+v has no analysis_id", accepted)
+	}
+
+	return "/v1/analysis/" + accepted.AnalysisId.String()
+}
+
+// await polls the analysis at path until it completes or fails, returning it.
+func (c *specClient) await(path string) map[string]any {
+	c.t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+
+	for {
+		// Unfinished analyses are reported as accepted, failed ones as gone.
+		resp, payload := c.do(http.MethodGet, path, nil)
+		switch resp.StatusCode {
+		case http.StatusOK, http.StatusAccepted, http.StatusGone:
+		default:
+			c.t.Fatalf("GET %s status = %d, want %d, %d or %d: %s", path, resp.StatusCode,
+				http.StatusOK, http.StatusAccepted, http.StatusGone, payload)
+		}
+
+		analysis := decode[map[string]any](c.t, payload)
+
+		switch analysis["status"] {
+		case string(handlers.AnalysisResponseStatusCompleted), string(handlers.AnalysisResponseStatusFailed):
+			return analysis
+		}
+
+		if time.Now().After(deadline) {
+			c.t.Fatalf("the analysis did not finish, status %v", analysis["status"])
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// serve runs the application configured by env on a test server.
+func serve(t *testing.T, env map[string]string, opts ...Option) *specClient {
+	t.Helper()
+
+	for key, value := range env {
+		t.Setenv(key, value)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("config.Load() error = %v", err)
+	}
+
+	a, err := New(cfg, slog.New(slog.DiscardHandler), opts...)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	a.service.Start()
+
+	srv := httptest.NewServer(a.server.Handler)
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() {
+		if err := a.shutdown(); err != nil {
+			t.Errorf("shutdown() error = %v", err)
+		}
+	})
+
+	return newSpecClient(t, srv.URL)
+}
+
+func TestServedResponsesMatchTheSpecification(t *testing.T) {
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = io.WriteString(w, testPage)
+	}))
+	t.Cleanup(site.Close)
+
+	c := serve(t, testEnv)
+
+	for _, path := range []string{"/v1/liveness", "/v1/readiness", "/v1/health"} {
+		c.expect(http.MethodGet, path, nil, http.StatusOK)
+	}
+
+	analysisPath := c.submit(map[string]any{
+		"url":     site.URL,
+		"options": map[string]any{"crawl": map[string]any{"max_depth": 1, "max_pages": 2}},
+	})
+
+	if analysis := c.await(analysisPath); analysis["status"] != string(handlers.AnalysisResponseStatusCompleted) {
+		t.Fatalf("the analysis did not complete: %v", analysis)
+	}
+
+	c.expect(http.MethodGet, analysisPath+"/pages", nil, http.StatusOK)
+
+	if events := c.expect(http.MethodGet, analysisPath+"/events", nil, http.StatusOK); !bytes.Contains(events, []byte("event: completed")) {
+		t.Errorf("event stream %q does not carry the outcome", events)
+	}
+
+	batch := decode[handlers.BatchResponse](t, c.expect(http.MethodPost, "/v1/analyze/batch", map[string]any{
+		"items": []map[string]any{{"url": site.URL + "/a"}, {"url": site.URL + "/b"}},
+	}, http.StatusAccepted))
+
+	if len(batch.Items) != 2 {
+		t.Fatalf("batch response has %d items, want 2", len(batch.Items))
+	}
+
+	c.expect(http.MethodGet, fmt.Sprintf("/v1/batches/%s", batch.BatchId), nil, http.StatusOK)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       any
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "invalid url",
+			method:     http.MethodPost,
+			path:       "/v1/analyze",
+			body:       map[string]any{"url": "not a url"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing body",
+			method:     http.MethodPost,
+			path:       "/v1/analyze",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "sitemap on a blocked host",
+			method:     http.MethodPost,
+			path:       "/v1/analyze/batch",
+			body:       map[string]any{"sitemap_url": "http://10.0.0.1/sitemap.xml"},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "blocked_target",
+		},
+		{
+			name:       "unreadable sitemap",
+			method:     http.MethodPost,
+			path:       "/v1/analyze/batch",
+			body:       map[string]any{"sitemap_url": site.URL + "/sitemap.xml"},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_sitemap",
+		},
+		{
+			name:       "invalid analysis id",
+			method:     http.MethodGet,
+			path:       "/v1/analysis/42",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown analysis",
+			method:     http.MethodGet,
+			path:       "/v1/analysis/7b2a4e8e-6f0c-4d0e-9d0b-5d8f6b1c2a3e",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "unknown batch",
+			method:     http.MethodGet,
+			path:       "/v1/batches/7b2a4e8e-6f0c-4d0e-9d0b-5d8f6b1c2a3e",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "unknown route",
+			method:     http.MethodGet,
+			path:       "/v1/unknown",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "unserved method",
+			method:     http.MethodDelete,
+			path:       "/v1/analyze",
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := *c
+			c.t = t
+
+			payload := c.expect(tt.method, tt.path, tt.body, tt.wantStatus)
+
+			got := decode[handlers.ErrorResponse](t, payload)
+			if got.Error == nil || *got.Error == "" {
+				t.Fatalf("error response %s has no error code", payload)
+			}
+
+			if tt.wantCode != "" && *got.Error != tt.wantCode {
+				t.Errorf("error = %s, want %s", *got.Error, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestServedCrawls(t *testing.T) {
+	// Another port of the host of the site is another site.
+	var otherSiteHits atomic.Int32
+
+	otherSite := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		otherSiteHits.Add(1)
+		_, _ = io.WriteString(w, testPage)
+	}))
+	t.Cleanup(otherSite.Close)
+
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			_, _ = io.WriteString(w, "User-agent: *\nDisallow: /private\n")
+
+			return
+		}
+
+		links := ""
+		switch r.URL.Path {
+		case "/":
+			links = `<a href="/1">1</a><a href="/2">2</a><a href="/private">Private</a><a href="/3">3</a>` +
+				`<a href="` + otherSite.URL + `/">Other site</a>`
+		case "/1":
+			links = `<a href="/deep">Deep</a>`
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = fmt.Fprintf(w, "<!DOCTYPE html><html><head><title>%s</title></head><body><h1>%[1]s</h1>%s</body></html>", r.URL.Path, links)
+	}))
+	t.Cleanup(site.Close)
+
+	c := serve(t, testEnv)
+
+	tests := []struct {
+		name        string
+		crawl       map[string]any
+		wantPaths   []string
+		wantSummary domain.CrawlSummary
+	}{
+		{
+			name:      "depth limit",
+			crawl:     map[string]any{"max_depth": 1, "max_pages": 10},
+			wantPaths: []string{"/", "/1", "/2", "/3"},
+			wantSummary: domain.CrawlSummary{
+				PagesCrawled:    4,
+				PagesDisallowed: 1,
+				MaxDepthReached: 1,
+			},
+		},
+		{
+			name:      "page limit",
+			crawl:     map[string]any{"max_depth": 2, "max_pages": 3},
+			wantPaths: []string{"/", "/1", "/2"},
+			wantSummary: domain.CrawlSummary{
+				PagesCrawled:    3,
+				MaxDepthReached: 1,
+				Truncated:       true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := *c
+			c.t = t
+
+			// The links are not checked, so that the other site is requested by a crawl only.
+			options := map[string]any{"crawl": tt.crawl, "check_links": false}
+
+			path := c.submit(map[string]any{"url": site.URL + "/", "options": options})
+			if analysis := c.await(path); analysis["status"] != string(handlers.AnalysisResponseStatusCompleted) {
+				t.Fatalf("the crawl did not complete: %v", analysis)
+			}
+
+			result := decode[struct {
+				Results struct {
+					Crawl domain.CrawlSummary `json:"crawl"`
+				} `json:"results"`
+			}](t, c.expect(http.MethodGet, path, nil, http.StatusOK))
+
+			if result.Results.Crawl != tt.wantSummary {
+				t.Errorf("crawl = %+v, want %+v", result.Results.Crawl, tt.wantSummary)
+			}
+
+			list := decode[handlers.CrawlPageList](t, c.expect(http.MethodGet, path+"/pages", nil, http.StatusOK))
+
+			paths := make([]string, 0, len(list.Pages))
+			for _, page := range list.Pages {
+				paths = append(paths, strings.TrimPrefix(page.Url, site.URL))
+			}
+
+			if !reflect.DeepEqual(paths, tt.wantPaths) {
+				t.Errorf("pages = %v, want %v", paths, tt.wantPaths)
+			}
+		})
+	}
+
+	if n := otherSiteHits.Load(); n != 0 {
+		t.Errorf("the site on another port was crawled %d times, want 0", n)
+	}
+}
+
+func TestServedRenderedAnalyses(t *testing.T) {
+	// The site serves the shell of a single-page application, built by its scripts.
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = io.WriteString(w, `<!DOCTYPE html><html><head><title>App</title></head><body><div id="root"></div></body></html>`)
+	}))
+	t.Cleanup(site.Close)
+
+	pages := renderertest.New()
+	pages.Redirect(site.URL+"/app", site.URL+"/app#/login", testPage)
+	pages.Fail(site.URL+"/slow", domain.NewAnalysisError(domain.ErrCodeTimeout, "Rendering the page timed out", 0, "context deadline exceeded"))
+
+	env := map[string]string{"QUEUE_MAX_ATTEMPTS": "1"}
+	for key, value := range testEnv {
+		env[key] = value
+	}
+
+	// Not retried, the render timing out fails the analysis at once.
+	c := serve(t, env, WithRenderer(pages))
+
+	render := map[string]any{"render": map[string]any{"wait_for": "selector", "selector": "#root h1"}}
+
+	analysis := c.await(c.submit(map[string]any{"url": site.URL + "/app", "options": render}))
+	if analysis["status"] != string(handlers.AnalysisResponseStatusCompleted) {
+		t.Fatalf("the rendered analysis did not complete: %v", analysis)
+	}
+
+	result := decode[handlers.AnalysisResult](t, c.expect(http.MethodGet,
+		fmt.Sprintf("/v1/analysis/%s", analysis["analysis_id"]), nil, http.StatusOK))
+
+	if result.Results == nil || result.Results.HeadingCounts == nil || result.Results.Forms == nil {
+		t.Fatalf("the rendered analysis has no headings or forms: %+v", result.Results)
+	}
+
+	if h1 := result.Results.HeadingCounts.H1; h1 == nil || *h1 != 1 {
+		t.Errorf("h1 of the rendered page = %v, want 1", h1)
+	}
+
+	if forms := result.Results.Forms.LoginFormsDetected; forms == nil || *forms != 1 {
+		t.Errorf("login forms of the rendered page = %v, want 1", forms)
+	}
+
+	analysis = c.await(c.submit(map[string]any{"url": site.URL + "/slow", "options": render}))
+	if analysis["status"] != string(handlers.AnalysisResponseStatusFailed) {
+		t.Fatalf("the analysis whose render timed out did not fail: %v", analysis)
+	}
+
+	if got := fmt.Sprint(analysis["error"]); !strings.Contains(got, domain.ErrCodeTimeout) {
+		t.Errorf("error of the analysis whose render timed out = %s, want %s", got, domain.ErrCodeTimeout)
+	}
+
+	want := []renderertest.Call{
+		{URL: site.URL + "/app", Options: domain.RenderOptions{WaitFor: domain.WaitSelector, Selector: "#root h1"}},
+		{URL: site.URL + "/slow", Options: domain.RenderOptions{WaitFor: domain.WaitSelector, Selector: "#root h1"}},
+	}
+
+	if got := pages.Calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("renders = %+v, want %+v", got, want)
+	}
+}
+
+func TestNewInvalidTrustedProxies(t *testing.T) {
+	for key, value := range testEnv {
+		t.Setenv(key, value)
+	}
+
+	t.Setenv("HTTP_SERVER_TRUSTED_PROXIES", "10.0.0.0/33")
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("config.Load() error = %v", err)
+	}
+
+	if _, err := New(cfg, slog.New(slog.DiscardHandler)); err == nil || !strings.Contains(err.Error(), "HTTP_SERVER_TRUSTED_PROXIES") {
+		t.Errorf("New() error = %v, want the trusted proxies rejected", err)
+	}
+}
@@ -0,0 +1,33 @@
+package app
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/architeacher/svc-web-analyzer/internal/config"
+)
+
+// NewLogger creates the structured logger described by cfg.
+func NewLogger(cfg config.LoggingConfig) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: parseLevel(cfg.Level)}
+
+	if cfg.Format == "text" {
+		return slog.New(slog.NewTextHandler(os.Stdout, opts))
+	}
+
+	return slog.New(slog.NewJSONHandler(os.Stdout, opts))
+}
+
+func parseLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
@@ -0,0 +1,32 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/auth"
+)
+
+func TestClaimsOwner(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		claims auth.Claims
+		want   string
+	}{
+		{name: "tenant", claims: auth.Claims{Tenant: "acme", Subject: "ci"}, want: "tenant:acme"},
+		{name: "subject", claims: auth.Claims{Subject: "ci"}, want: "sub:ci"},
+		{name: "subject named after a tenant", claims: auth.Claims{Subject: "acme"}, want: "sub:acme"},
+		{name: "none", claims: auth.Claims{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.claims.Owner(); got != tt.want {
+				t.Errorf("Owner() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
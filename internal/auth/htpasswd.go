@@ -0,0 +1,197 @@
+package auth
+
+import (
+	"bufio"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// dummyHash is checked for unknown usernames, so that they take as long to reject as wrong
+// passwords.
+const dummyHash = "$2a$10$Uav1lrcrOxbAt8A1aclrBekufOje.etCPsx9Nww4HUtF8I5LNMtkO"
+
+// ErrInvalidHtpasswd is returned for htpasswd entries that cannot be parsed, or whose password
+// is not hashed with bcrypt or argon2.
+var ErrInvalidHtpasswd = errors.New("invalid htpasswd entry")
+
+// passwordHash is a hashed password.
+type passwordHash interface {
+	matches(password []byte) bool
+}
+
+// Htpasswd holds hashed credentials in the htpasswd format, one "username:hash" entry per line,
+// as produced by "htpasswd -nB" and accepted by Traefik's basicAuth middleware. Passwords are
+// hashed with bcrypt ($2a$, $2b$, $2y$) or argon2 in its PHC string format ($argon2id$,
+// $argon2i$); weaker schemes such as MD5 or SHA-1 are refused.
+type Htpasswd struct {
+	users map[string]passwordHash
+	dummy passwordHash
+}
+
+// NewHtpasswd creates an Htpasswd without users.
+func NewHtpasswd() *Htpasswd {
+	return &Htpasswd{
+		users: make(map[string]passwordHash),
+		dummy: bcryptHash(dummyHash),
+	}
+}
+
+// Add reads the entries of r. Entries are separated by newlines or spaces; blank lines and
+// lines starting with # are ignored. Entries replace the earlier ones of the same user.
+func (h *Htpasswd) Add(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		for _, entry := range strings.Fields(text) {
+			username, hash, err := parseEntry(entry)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", line, err)
+			}
+
+			h.users[username] = hash
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading htpasswd: %w", err)
+	}
+
+	return nil
+}
+
+// AddFile reads the entries of the file at path.
+func (h *Htpasswd) AddFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening htpasswd file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	if err := h.Add(f); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Len returns the number of users.
+func (h *Htpasswd) Len() int {
+	return len(h.users)
+}
+
+// Verify reports whether password is the password of username. Unknown usernames are checked
+// against a dummy hash, so that they cannot be told apart by the response time.
+func (h *Htpasswd) Verify(username, password string) bool {
+	hash, ok := h.users[username]
+	if !ok {
+		hash = h.dummy
+	}
+
+	return hash.matches([]byte(password)) && ok
+}
+
+func parseEntry(entry string) (string, passwordHash, error) {
+	username, hash, ok := strings.Cut(entry, ":")
+	if !ok || username == "" || hash == "" {
+		return "", nil, fmt.Errorf("%w: expected username:hash", ErrInvalidHtpasswd)
+	}
+
+	switch {
+	case strings.HasPrefix(hash, "$2a$"), strings.HasPrefix(hash, "$2b$"), strings.HasPrefix(hash, "$2y$"):
+		if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+			return "", nil, fmt.Errorf("%w: user %q: %w", ErrInvalidHtpasswd, username, err)
+		}
+
+		return username, bcryptHash(hash), nil
+	case strings.HasPrefix(hash, "$argon2id$"), strings.HasPrefix(hash, "$argon2i$"):
+		h, err := parseArgon2(hash)
+		if err != nil {
+			return "", nil, fmt.Errorf("%w: user %q: %w", ErrInvalidHtpasswd, username, err)
+		}
+
+		return username, h, nil
+	default:
+		return "", nil, fmt.Errorf("%w: user %q: unsupported hash, expected bcrypt or argon2", ErrInvalidHtpasswd, username)
+	}
+}
+
+// bcryptHash is a bcrypt hash. htpasswd writes the $2y$ prefix, which is the same algorithm as
+// $2a$ and $2b$.
+type bcryptHash string
+
+func (h bcryptHash) matches(password []byte) bool {
+	hash := []byte(h)
+	if strings.HasPrefix(string(h), "$2y$") {
+		hash = []byte("$2a$" + string(h)[len("$2y$"):])
+	}
+
+	return bcrypt.CompareHashAndPassword(hash, password) == nil
+}
+
+// argon2Hash is an argon2 hash decoded from its PHC string,
+// $argon2id$v=19$m=65536,t=3,p=4$<salt>$<hash>.
+type argon2Hash struct {
+	id      bool
+	memory  uint32
+	time    uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+func parseArgon2(s string) (*argon2Hash, error) {
+	parts := strings.Split(s, "$")
+	if len(parts) != 6 {
+		return nil, errors.New("malformed argon2 hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, fmt.Errorf("unsupported argon2 version %q", parts[2])
+	}
+
+	h := &argon2Hash{id: parts[1] == "argon2id"}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &h.memory, &h.time, &h.threads); err != nil {
+		return nil, fmt.Errorf("malformed argon2 parameters %q", parts[3])
+	}
+
+	if h.time == 0 || h.threads == 0 {
+		return nil, fmt.Errorf("invalid argon2 parameters %q", parts[3])
+	}
+
+	var err error
+	if h.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, fmt.Errorf("malformed argon2 salt: %w", err)
+	}
+
+	if h.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(h.key) == 0 {
+		return nil, errors.New("malformed argon2 key")
+	}
+
+	return h, nil
+}
+
+func (h *argon2Hash) matches(password []byte) bool {
+	keyLen := uint32(len(h.key))
+
+	var key []byte
+	if h.id {
+		key = argon2.IDKey(password, h.salt, h.time, h.memory, h.threads, keyLen)
+	} else {
+		key = argon2.Key(password, h.salt, h.time, h.memory, h.threads, keyLen)
+	}
+
+	return subtle.ConstantTimeCompare(key, h.key) == 1
+}
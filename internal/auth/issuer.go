@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/architeacher/svc-web-analyzer/internal/paseto"
+)
+
+const (
+	defaultIssuer      = "web-analyzer-service"
+	defaultAudience    = "web-analyzer-api"
+	defaultMaxTokenTTL = 30 * 24 * time.Hour
+)
+
+var (
+	// ErrInvalidTokenRequest is returned when a token is requested without a subject, without
+	// scopes or with unknown ones.
+	ErrInvalidTokenRequest = errors.New("invalid token request")
+	// ErrTTLTooLong is returned when a token is requested for longer than the issuer allows.
+	ErrTTLTooLong = errors.New("token lifetime exceeds the maximum")
+)
+
+// TokenRequest describes the token to issue.
+type TokenRequest struct {
+	Subject string
+	Tenant  string
+	Scopes  []string
+	TTL     time.Duration
+}
+
+// Issuer issues v4.public tokens signed with an Ed25519 key. The key ID is written to the
+// footer of the tokens, so that verifiers pick the matching public key.
+type Issuer struct {
+	key      ed25519.PrivateKey
+	keyID    string
+	issuer   string
+	audience string
+	maxTTL   time.Duration
+	now      func() time.Time
+}
+
+// IssuerOption configures an Issuer.
+type IssuerOption func(*Issuer)
+
+// WithKeyID sets the key ID written to the footer of the tokens. It defaults to the PASERK
+// identifier of the public key.
+func WithKeyID(kid string) IssuerOption {
+	return func(i *Issuer) {
+		if kid != "" {
+			i.keyID = kid
+		}
+	}
+}
+
+// WithIssuer sets the iss claim of the tokens.
+func WithIssuer(issuer string) IssuerOption {
+	return func(i *Issuer) {
+		if issuer != "" {
+			i.issuer = issuer
+		}
+	}
+}
+
+// WithTokenAudience sets the aud claim of the tokens.
+func WithTokenAudience(audience string) IssuerOption {
+	return func(i *Issuer) {
+		if audience != "" {
+			i.audience = audience
+		}
+	}
+}
+
+// WithMaxTTL bounds the lifetime of the tokens.
+func WithMaxTTL(ttl time.Duration) IssuerOption {
+	return func(i *Issuer) {
+		if ttl > 0 {
+			i.maxTTL = ttl
+		}
+	}
+}
+
+// NewIssuer creates an Issuer signing with key.
+func NewIssuer(key ed25519.PrivateKey, opts ...IssuerOption) *Issuer {
+	i := &Issuer{
+		key:      key,
+		keyID:    paseto.PublicKeyID(key.Public().(ed25519.PublicKey)),
+		issuer:   defaultIssuer,
+		audience: defaultAudience,
+		maxTTL:   defaultMaxTokenTTL,
+		now:      time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(i)
+	}
+
+	return i
+}
+
+// KeyID returns the ID of the signing key.
+func (i *Issuer) KeyID() string {
+	return i.keyID
+}
+
+// PublicKey returns the key verifying the issued tokens.
+func (i *Issuer) PublicKey() ed25519.PublicKey {
+	return i.key.Public().(ed25519.PublicKey)
+}
+
+// MaxTTL returns the longest lifetime of the issued tokens.
+func (i *Issuer) MaxTTL() time.Duration {
+	return i.maxTTL
+}
+
+// Issue signs a token for req, identified by a random token ID.
+func (i *Issuer) Issue(req TokenRequest) (string, *Claims, error) {
+	if req.Subject == "" || len(req.Scopes) == 0 {
+		return "", nil, fmt.Errorf("%w: a subject and at least one scope are required", ErrInvalidTokenRequest)
+	}
+
+	for _, scope := range req.Scopes {
+		if !KnownScope(scope) {
+			return "", nil, fmt.Errorf("%w: unknown scope %q", ErrInvalidTokenRequest, scope)
+		}
+	}
+
+	if req.TTL <= 0 {
+		return "", nil, fmt.Errorf("%w: the lifetime must be positive", ErrInvalidTokenRequest)
+	}
+
+	if req.TTL > i.maxTTL {
+		return "", nil, fmt.Errorf("%w of %s", ErrTTLTooLong, i.maxTTL)
+	}
+
+	now := i.now().UTC().Truncate(time.Second)
+	claims := &Claims{
+		Issuer:    i.issuer,
+		Subject:   req.Subject,
+		Audience:  i.audience,
+		ExpiresAt: Timestamp{now.Add(req.TTL)},
+		NotBefore: Timestamp{now},
+		IssuedAt:  Timestamp{now},
+		TokenID:   uuid.NewString(),
+		Scopes:    req.Scopes,
+		Tenant:    req.Tenant,
+	}
+
+	message, err := json.Marshal(claims)
+	if err != nil {
+		return "", nil, fmt.Errorf("encoding claims: %w", err)
+	}
+
+	footer, err := json.Marshal(map[string]string{"kid": i.keyID})
+	if err != nil {
+		return "", nil, fmt.Errorf("encoding footer: %w", err)
+	}
+
+	return paseto.Sign(i.key, message, footer, nil), claims, nil
+}
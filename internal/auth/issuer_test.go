@@ -0,0 +1,99 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/auth"
+)
+
+func newIssuer(t *testing.T, opts ...auth.IssuerOption) *auth.Issuer {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("ed25519.GenerateKey() error = %v", err)
+	}
+
+	return auth.NewIssuer(key, opts...)
+}
+
+func TestIssuerIssue(t *testing.T) {
+	t.Parallel()
+
+	issuer := newIssuer(t, auth.WithMaxTTL(24*time.Hour))
+	scopes := []string{auth.ScopeAnalysisRead, auth.ScopeAnalysisWrite}
+
+	tests := []struct {
+		name    string
+		req     auth.TokenRequest
+		wantErr error
+	}{
+		{name: "within the maximum lifetime", req: auth.TokenRequest{Subject: "ci", Scopes: scopes, TTL: time.Hour}},
+		{name: "the maximum lifetime", req: auth.TokenRequest{Subject: "ci", Scopes: scopes, TTL: 24 * time.Hour}},
+		{name: "past the maximum lifetime", req: auth.TokenRequest{Subject: "ci", Scopes: scopes, TTL: 24*time.Hour + time.Second}, wantErr: auth.ErrTTLTooLong},
+		{name: "no lifetime", req: auth.TokenRequest{Subject: "ci", Scopes: scopes}, wantErr: auth.ErrInvalidTokenRequest},
+		{name: "unknown scope", req: auth.TokenRequest{Subject: "ci", Scopes: []string{auth.ScopeAnalysisRead, "admin"}, TTL: time.Hour}, wantErr: auth.ErrInvalidTokenRequest},
+		{name: "no scope", req: auth.TokenRequest{Subject: "ci", TTL: time.Hour}, wantErr: auth.ErrInvalidTokenRequest},
+		{name: "no subject", req: auth.TokenRequest{Scopes: scopes, TTL: time.Hour}, wantErr: auth.ErrInvalidTokenRequest},
+	}
+
+	verifier := auth.NewVerifier(auth.WithPublicKey(issuer.KeyID(), issuer.PublicKey()))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			token, claims, err := issuer.Issue(tt.req)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Issue() error = %v, want %v", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Issue() error = %v", err)
+			}
+
+			if got := claims.ExpiresAt.Sub(claims.IssuedAt.Time); got != tt.req.TTL {
+				t.Errorf("Issue() lifetime = %s, want %s", got, tt.req.TTL)
+			}
+
+			verified, err := verifier.Verify(context.Background(), token)
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(verified, claims) {
+				t.Errorf("Verify() = %+v, want %+v", verified, claims)
+			}
+		})
+	}
+}
+
+func TestIssuerTokenIDs(t *testing.T) {
+	t.Parallel()
+
+	issuer := newIssuer(t)
+	req := auth.TokenRequest{Subject: "ci", Scopes: []string{auth.ScopeAnalysisRead}, TTL: time.Hour}
+
+	_, first, err := issuer.Issue(req)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+
+	_, second, err := issuer.Issue(req)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+
+	if first.TokenID == "" || first.TokenID == second.TokenID {
+		t.Errorf("Issue() token IDs = %q and %q, want distinct ones", first.TokenID, second.TokenID)
+	}
+}
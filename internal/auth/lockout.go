@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+// Lockout counts the failed authentication attempts per client, and locks clients out once
+// they failed maxFailures times within a window, for the length of that window. It guards the
+// password checks against guessing, in process: each instance keeps its own counts.
+type Lockout struct {
+	mu          sync.Mutex
+	maxFailures int
+	window      time.Duration
+	clients     map[string]*attempts
+	lastPrune   time.Time
+	now         func() time.Time
+}
+
+type attempts struct {
+	failures    int
+	since       time.Time
+	lockedUntil time.Time
+}
+
+// NewLockout creates a Lockout allowing maxFailures failed attempts per window.
+func NewLockout(maxFailures int, window time.Duration) *Lockout {
+	return &Lockout{
+		maxFailures: maxFailures,
+		window:      window,
+		clients:     make(map[string]*attempts),
+		now:         time.Now,
+	}
+}
+
+// Blocked returns how long client remains locked out, zero if it is not.
+func (l *Lockout) Blocked(client string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	a, ok := l.clients[client]
+	if !ok {
+		return 0
+	}
+
+	return max(a.lockedUntil.Sub(l.now()), 0)
+}
+
+// Fail records a failed attempt of client.
+func (l *Lockout) Fail(client string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.prune(now)
+
+	a, ok := l.clients[client]
+	if !ok || now.Sub(a.since) >= l.window {
+		a = &attempts{since: now}
+		l.clients[client] = a
+	}
+
+	a.failures++
+	if a.failures >= l.maxFailures {
+		a.lockedUntil = now.Add(l.window)
+	}
+}
+
+// Reset forgets the failed attempts of client, after it authenticated.
+func (l *Lockout) Reset(client string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.clients, client)
+}
+
+// prune drops the clients whose attempts are over, at most once per window. l.mu must be held.
+func (l *Lockout) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < l.window {
+		return
+	}
+
+	l.lastPrune = now
+
+	for client, a := range l.clients {
+		if now.Sub(a.since) >= l.window && !now.Before(a.lockedUntil) {
+			delete(l.clients, client)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Revocation is a token ID that is no longer accepted. It is kept until ExpiresAt, past which
+// the token would be rejected as expired anyway.
+type Revocation struct {
+	TokenID   string    `json:"token_id"`
+	RevokedAt time.Time `json:"revoked_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// RevocationList holds the revoked token IDs consulted by the Verifier.
+type RevocationList interface {
+	// Revoke adds r to the list, replacing any revocation of the same token ID.
+	Revoke(ctx context.Context, r Revocation) error
+	// IsRevoked reports whether the token ID is revoked.
+	IsRevoked(ctx context.Context, tokenID string) (bool, error)
+	// List returns the revocations in effect, most recent first.
+	List(ctx context.Context) ([]Revocation, error)
+}
+
+// MemoryRevocationList is a RevocationList held in process.
+type MemoryRevocationList struct {
+	mu          sync.Mutex
+	revocations map[string]Revocation
+	now         func() time.Time
+}
+
+var _ RevocationList = (*MemoryRevocationList)(nil)
+
+// NewMemoryRevocationList creates an empty MemoryRevocationList.
+func NewMemoryRevocationList() *MemoryRevocationList {
+	return &MemoryRevocationList{
+		revocations: make(map[string]Revocation),
+		now:         time.Now,
+	}
+}
+
+// Revoke adds r to the list.
+func (l *MemoryRevocationList) Revoke(_ context.Context, r Revocation) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.prune()
+	l.revocations[r.TokenID] = r
+
+	return nil
+}
+
+// IsRevoked reports whether the token ID is revoked.
+func (l *MemoryRevocationList) IsRevoked(_ context.Context, tokenID string) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	r, ok := l.revocations[tokenID]
+
+	return ok && l.now().Before(r.ExpiresAt), nil
+}
+
+// List returns the revocations in effect, most recent first.
+func (l *MemoryRevocationList) List(_ context.Context) ([]Revocation, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.prune()
+
+	revocations := make([]Revocation, 0, len(l.revocations))
+	for _, r := range l.revocations {
+		revocations = append(revocations, r)
+	}
+
+	sortRevocations(revocations)
+
+	return revocations, nil
+}
+
+// prune drops the expired revocations. l.mu must be held.
+func (l *MemoryRevocationList) prune() {
+	now := l.now()
+
+	for id, r := range l.revocations {
+		if !now.Before(r.ExpiresAt) {
+			delete(l.revocations, id)
+		}
+	}
+}
+
+func sortRevocations(revocations []Revocation) {
+	slices.SortFunc(revocations, func(a, b Revocation) int {
+		return b.RevokedAt.Compare(a.RevokedAt)
+	})
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	revocationKeyPrefix = "web-analyzer:revoked:"
+	revocationScanCount = 256
+)
+
+// RedisRevocationList is a RevocationList shared by the instances through Redis. Each
+// revocation is a key expiring along with the token it revokes.
+type RedisRevocationList struct {
+	client *redis.Client
+}
+
+var _ RevocationList = (*RedisRevocationList)(nil)
+
+// NewRedisRevocationList creates a RedisRevocationList for the server at url, e.g.
+// redis://localhost:6379/0. Connections are established lazily.
+func NewRedisRevocationList(url string) (*RedisRevocationList, error) {
+	options, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("parsing redis url: %w", err)
+	}
+
+	return &RedisRevocationList{client: redis.NewClient(options)}, nil
+}
+
+// Revoke adds r to the list.
+func (l *RedisRevocationList) Revoke(ctx context.Context, r Revocation) error {
+	ttl := time.Until(r.ExpiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+
+	value, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("encoding revocation: %w", err)
+	}
+
+	if err := l.client.Set(ctx, revocationKeyPrefix+r.TokenID, value, ttl).Err(); err != nil {
+		return fmt.Errorf("revoking %s: %w", r.TokenID, err)
+	}
+
+	return nil
+}
+
+// IsRevoked reports whether the token ID is revoked.
+func (l *RedisRevocationList) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	n, err := l.client.Exists(ctx, revocationKeyPrefix+tokenID).Result()
+	if err != nil {
+		return false, fmt.Errorf("checking revocation of %s: %w", tokenID, err)
+	}
+
+	return n > 0, nil
+}
+
+// List returns the revocations in effect, most recent first.
+func (l *RedisRevocationList) List(ctx context.Context) ([]Revocation, error) {
+	var revocations []Revocation
+
+	iter := l.client.Scan(ctx, 0, revocationKeyPrefix+"*", revocationScanCount).Iterator()
+	for iter.Next(ctx) {
+		value, err := l.client.Get(ctx, iter.Val()).Bytes()
+		if errors.Is(err, redis.Nil) {
+			// Expired since the scan returned it.
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", iter.Val(), err)
+		}
+
+		var r Revocation
+		if err := json.Unmarshal(value, &r); err != nil {
+			return nil, fmt.Errorf("decoding %s: %w", iter.Val(), err)
+		}
+
+		revocations = append(revocations, r)
+	}
+
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("listing revocations: %w", err)
+	}
+
+	sortRevocations(revocations)
+
+	return revocations, nil
+}
+
+// Ping checks the connection to the server.
+func (l *RedisRevocationList) Ping(ctx context.Context) error {
+	return l.client.Ping(ctx).Err()
+}
+
+// Close closes the connections to the server.
+func (l *RedisRevocationList) Close() error {
+	return l.client.Close()
+}
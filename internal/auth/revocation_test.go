@@ -0,0 +1,160 @@
+package auth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+func TestMemoryRevocationListExpiry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	l := NewMemoryRevocationList()
+	l.now = func() time.Time { return now }
+
+	first := Revocation{TokenID: "first", RevokedAt: now, ExpiresAt: now.Add(time.Hour)}
+	second := Revocation{TokenID: "second", RevokedAt: now.Add(time.Minute), ExpiresAt: now.Add(2 * time.Hour)}
+
+	for _, r := range []Revocation{first, second} {
+		if err := l.Revoke(ctx, r); err != nil {
+			t.Fatalf("Revoke(%s) error = %v", r.TokenID, err)
+		}
+	}
+
+	if got, _ := l.List(ctx); !reflect.DeepEqual(got, []Revocation{second, first}) {
+		t.Errorf("List() = %+v, want the most recent first", got)
+	}
+
+	// The revocation of the first token expires along with it.
+	now = now.Add(time.Hour)
+
+	if revoked, _ := l.IsRevoked(ctx, "first"); revoked {
+		t.Error("IsRevoked(first) = true once the token expired, want false")
+	}
+
+	if revoked, _ := l.IsRevoked(ctx, "second"); !revoked {
+		t.Error("IsRevoked(second) = false, want true")
+	}
+
+	if got, _ := l.List(ctx); !reflect.DeepEqual(got, []Revocation{second}) {
+		t.Errorf("List() = %+v, want %+v", got, []Revocation{second})
+	}
+}
+
+func TestRedisRevocationListExpiry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+
+	l, err := NewRedisRevocationList("redis://" + server.Addr())
+	if err != nil {
+		t.Fatalf("NewRedisRevocationList() error = %v", err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+
+	now := time.Now().UTC().Truncate(time.Second)
+	revocation := Revocation{TokenID: "token", RevokedAt: now, ExpiresAt: now.Add(time.Hour)}
+
+	if err := l.Revoke(ctx, revocation); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+
+	// Tokens expired already are not revoked.
+	if err := l.Revoke(ctx, Revocation{TokenID: "expired", RevokedAt: now, ExpiresAt: now.Add(-time.Second)}); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+
+	if ttl := server.TTL(revocationKeyPrefix + "token"); ttl <= 59*time.Minute || ttl > time.Hour {
+		t.Errorf("TTL of the revocation = %s, want the remaining lifetime of the token", ttl)
+	}
+
+	if got, err := l.List(ctx); err != nil || !reflect.DeepEqual(got, []Revocation{revocation}) {
+		t.Errorf("List() = %+v, %v, want %+v", got, err, []Revocation{revocation})
+	}
+
+	if revoked, _ := l.IsRevoked(ctx, "expired"); revoked {
+		t.Error("IsRevoked(expired) = true, want false")
+	}
+
+	server.FastForward(time.Hour)
+
+	if revoked, err := l.IsRevoked(ctx, "token"); err != nil || revoked {
+		t.Errorf("IsRevoked(token) once expired = %t, %v, want false", revoked, err)
+	}
+}
+
+// failingRevocationList fails every check.
+type failingRevocationList struct {
+	RevocationList
+}
+
+func (failingRevocationList) IsRevoked(context.Context, string) (bool, error) {
+	return false, errors.New("connection refused")
+}
+
+func TestVerifierRevocations(t *testing.T) {
+	t.Parallel()
+
+	_, key, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("ed25519.GenerateKey() error = %v", err)
+	}
+
+	issuer := NewIssuer(key)
+	req := TokenRequest{Subject: "ci", Scopes: []string{ScopeAnalysisRead}, TTL: time.Hour}
+
+	revokedToken, revokedClaims, err := issuer.Issue(req)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+
+	token, _, err := issuer.Issue(req)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+
+	revocations := NewMemoryRevocationList()
+	if err := revocations.Revoke(context.Background(), Revocation{
+		TokenID:   revokedClaims.TokenID,
+		RevokedAt: time.Now(),
+		ExpiresAt: revokedClaims.ExpiresAt.Time,
+	}); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		revocations RevocationList
+		token       string
+		wantErr     error
+	}{
+		{name: "not revoked", revocations: revocations, token: token},
+		{name: "revoked", revocations: revocations, token: revokedToken, wantErr: ErrTokenRevoked},
+		{name: "unchecked", revocations: failingRevocationList{}, token: token, wantErr: ErrRevocationCheck},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			verifier := NewVerifier(
+				WithPublicKey(issuer.KeyID(), issuer.PublicKey()),
+				WithRevocationList(tt.revocations),
+			)
+
+			_, err := verifier.Verify(context.Background(), tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/paseto"
+)
+
+var (
+	// ErrMalformedToken is returned for tokens that are not well-formed PASETO tokens.
+	ErrMalformedToken = errors.New("malformed token")
+	// ErrUnsupportedToken is returned for tokens of a version or purpose that is not accepted.
+	ErrUnsupportedToken = errors.New("unsupported token version or purpose")
+	// ErrInvalidSignature is returned for tokens that could not be verified or decrypted with
+	// any of the configured keys.
+	ErrInvalidSignature = errors.New("invalid token signature")
+	// ErrTokenExpired is returned for tokens past their expiration time.
+	ErrTokenExpired = errors.New("token expired")
+	// ErrTokenNotYetValid is returned for tokens used before their not-before time.
+	ErrTokenNotYetValid = errors.New("token not yet valid")
+	// ErrInvalidClaims is returned for tokens whose claims cannot be decoded, lack the
+	// expiration time or identify no subject nor tenant, or which were issued in the future.
+	ErrInvalidClaims = errors.New("invalid token claims")
+	// ErrInvalidIssuer is returned for tokens issued by an untrusted issuer.
+	ErrInvalidIssuer = errors.New("untrusted token issuer")
+	// ErrInvalidAudience is returned for tokens intended for another audience.
+	ErrInvalidAudience = errors.New("invalid token audience")
+	// ErrTokenRevoked is returned for tokens whose ID was revoked.
+	ErrTokenRevoked = errors.New("token revoked")
+	// ErrRevocationCheck is returned when the revocation list cannot be consulted. Tokens are
+	// rejected rather than accepted unchecked.
+	ErrRevocationCheck = errors.New("checking token revocation")
+)
+
+// Verifier authenticates v4.public and v4.local tokens and validates their claims.
+//
+// Keys are registered under a key ID. Tokens naming a key ID in their footer, as
+// {"kid":"..."}, are checked against that key only; the others are checked against every key
+// of their purpose. Several keys can thus be accepted side by side while they are rotated.
+type Verifier struct {
+	publicKeys  map[string]ed25519.PublicKey
+	localKeys   map[string]paseto.LocalKey
+	issuers     []string
+	audience    string
+	leeway      time.Duration
+	revocations RevocationList
+	now         func() time.Time
+}
+
+// Option configures a Verifier.
+type Option func(*Verifier)
+
+// WithPublicKey accepts the v4.public tokens signed by the key pair of key under kid.
+func WithPublicKey(kid string, key ed25519.PublicKey) Option {
+	return func(v *Verifier) {
+		v.publicKeys[kid] = key
+	}
+}
+
+// WithLocalKey accepts the v4.local tokens encrypted with key under kid.
+func WithLocalKey(kid string, key paseto.LocalKey) Option {
+	return func(v *Verifier) {
+		v.localKeys[kid] = key
+	}
+}
+
+// WithIssuers restricts the accepted tokens to those issued by one of issuers.
+func WithIssuers(issuers ...string) Option {
+	return func(v *Verifier) {
+		v.issuers = issuers
+	}
+}
+
+// WithAudience restricts the accepted tokens to those intended for audience.
+func WithAudience(audience string) Option {
+	return func(v *Verifier) {
+		v.audience = audience
+	}
+}
+
+// WithLeeway sets the clock skew tolerated when checking the time claims.
+func WithLeeway(leeway time.Duration) Option {
+	return func(v *Verifier) {
+		if leeway >= 0 {
+			v.leeway = leeway
+		}
+	}
+}
+
+// WithRevocationList rejects the tokens whose ID is in revocations.
+func WithRevocationList(revocations RevocationList) Option {
+	return func(v *Verifier) {
+		v.revocations = revocations
+	}
+}
+
+// NewVerifier creates a Verifier. Without keys, every token is rejected.
+func NewVerifier(opts ...Option) *Verifier {
+	v := &Verifier{
+		publicKeys: make(map[string]ed25519.PublicKey),
+		localKeys:  make(map[string]paseto.LocalKey),
+		now:        time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// Verify authenticates token and returns its validated claims.
+func (v *Verifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	message, err := v.open(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims Claims
+	if err := json.Unmarshal(message, &claims); err != nil {
+		return nil, ErrInvalidClaims
+	}
+
+	if err := v.validate(&claims); err != nil {
+		return nil, err
+	}
+
+	if v.revocations != nil && claims.TokenID != "" {
+		revoked, err := v.revocations.IsRevoked(ctx, claims.TokenID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrRevocationCheck, err)
+		}
+
+		if revoked {
+			return nil, ErrTokenRevoked
+		}
+	}
+
+	return &claims, nil
+}
+
+// open verifies or decrypts token, depending on its purpose, and returns its message.
+func (v *Verifier) open(token string) ([]byte, error) {
+	footer, err := paseto.Footer(token)
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+
+	kid := keyID(footer)
+
+	var message []byte
+
+	switch {
+	case strings.HasPrefix(token, paseto.HeaderPublic):
+		message, err = tryKeys(v.publicKeys, kid, func(key ed25519.PublicKey) ([]byte, error) {
+			return paseto.Verify(token, key, nil)
+		})
+	case strings.HasPrefix(token, paseto.HeaderLocal):
+		message, err = tryKeys(v.localKeys, kid, func(key paseto.LocalKey) ([]byte, error) {
+			return paseto.Decrypt(token, key, nil)
+		})
+	case strings.HasPrefix(token, "v") && strings.Count(token, ".") >= 2:
+		return nil, ErrUnsupportedToken
+	default:
+		return nil, ErrMalformedToken
+	}
+
+	switch {
+	case err == nil:
+		return message, nil
+	case errors.Is(err, paseto.ErrMalformed):
+		return nil, ErrMalformedToken
+	default:
+		return nil, ErrInvalidSignature
+	}
+}
+
+// tryKeys opens a token with the key registered under kid, or with each key when the token
+// names none.
+func tryKeys[K any](keys map[string]K, kid string, open func(K) ([]byte, error)) ([]byte, error) {
+	if kid != "" {
+		key, ok := keys[kid]
+		if !ok {
+			return nil, ErrInvalidSignature
+		}
+
+		return open(key)
+	}
+
+	err := ErrInvalidSignature
+
+	for _, key := range keys {
+		var message []byte
+		if message, err = open(key); err == nil {
+			return message, nil
+		}
+
+		if errors.Is(err, paseto.ErrMalformed) {
+			return nil, err
+		}
+	}
+
+	return nil, err
+}
+
+func (v *Verifier) validate(claims *Claims) error {
+	now := v.now()
+
+	if claims.ExpiresAt.IsZero() || claims.Owner() == "" {
+		return ErrInvalidClaims
+	}
+
+	if now.After(claims.ExpiresAt.Add(v.leeway)) {
+		return ErrTokenExpired
+	}
+
+	if !claims.NotBefore.IsZero() && now.Add(v.leeway).Before(claims.NotBefore.Time) {
+		return ErrTokenNotYetValid
+	}
+
+	if !claims.IssuedAt.IsZero() && now.Add(v.leeway).Before(claims.IssuedAt.Time) {
+		return ErrInvalidClaims
+	}
+
+	if len(v.issuers) > 0 && !slices.Contains(v.issuers, claims.Issuer) {
+		return ErrInvalidIssuer
+	}
+
+	if v.audience != "" && claims.Audience != v.audience {
+		return ErrInvalidAudience
+	}
+
+	return nil
+}
+
+// keyID returns the key ID named by a JSON footer, if any.
+func keyID(footer []byte) string {
+	if !bytes.HasPrefix(bytes.TrimSpace(footer), []byte("{")) {
+		return ""
+	}
+
+	var f struct {
+		KeyID string `json:"kid"`
+	}
+
+	if err := json.Unmarshal(footer, &f); err != nil {
+		return ""
+	}
+
+	return f.KeyID
+}
@@ -0,0 +1,43 @@
+// Package cache keeps the results of recent analyses, so that repeated requests for the same
+// page are answered without analysing it again.
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrMiss is returned by Get when the key is absent or expired.
+var ErrMiss = errors.New("cache miss")
+
+// Cache is a key-value store with per-entry expiration.
+type Cache interface {
+	// Get returns the value stored under key, or ErrMiss.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Set stores value under key until ttl elapsed.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Ping reports whether the cache is reachable.
+	Ping(ctx context.Context) error
+	// Stats returns the current usage of the cache.
+	Stats(ctx context.Context) (Stats, error)
+}
+
+// Stats describes the usage of a cache, as reported by the health check.
+type Stats struct {
+	TotalKeys int64 `json:"total_keys"`
+	// PoolStats is only reported by caches reached over a connection pool.
+	PoolStats *PoolStats `json:"pool_stats,omitempty"`
+}
+
+// PoolStats describes the connection pool of a cache client.
+type PoolStats struct {
+	Hits           uint32 `json:"hits"`
+	Misses         uint32 `json:"misses"`
+	Timeouts       uint32 `json:"timeouts"`
+	WaitCount      uint32 `json:"wait_count"`
+	WaitDurationNs int64  `json:"wait_duration_ns"`
+	TotalConns     uint32 `json:"total_connections"`
+	IdleConns      uint32 `json:"idle_connections"`
+	StaleConns     uint32 `json:"stale_connections"`
+}
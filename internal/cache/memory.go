@@ -0,0 +1,167 @@
+package cache
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+const defaultMaxEntries = 10_000
+
+// MemoryCache is an in-process Cache. Entries are not shared between instances.
+type MemoryCache struct {
+	maxEntries int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*entry
+	// expiries orders the entries by expiry, the entry closest to expiring first, so that
+	// expired entries are purged and full caches evict in logarithmic time.
+	expiries expiryHeap
+}
+
+type entry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+	// index is the position of the entry in the expiry heap.
+	index int
+}
+
+var _ Cache = (*MemoryCache)(nil)
+
+// MemoryOption configures a MemoryCache.
+type MemoryOption func(*MemoryCache)
+
+// WithMaxEntries bounds the number of entries. Once full, the entry closest to expiring is
+// evicted to make room.
+func WithMaxEntries(n int) MemoryOption {
+	return func(c *MemoryCache) {
+		if n > 0 {
+			c.maxEntries = n
+		}
+	}
+}
+
+// NewMemory creates an empty MemoryCache.
+func NewMemory(opts ...MemoryOption) *MemoryCache {
+	c := &MemoryCache{
+		maxEntries: defaultMaxEntries,
+		now:        time.Now,
+		entries:    make(map[string]*entry),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Get returns the value stored under key.
+func (c *MemoryCache) Get(_ context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, ErrMiss
+	}
+
+	if !c.now().Before(e.expiresAt) {
+		c.remove(e)
+
+		return nil, ErrMiss
+	}
+
+	return e.value, nil
+}
+
+// Set stores value under key until ttl elapsed.
+func (c *MemoryCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+
+	if e, ok := c.entries[key]; ok {
+		e.value, e.expiresAt = value, now.Add(ttl)
+		heap.Fix(&c.expiries, e.index)
+
+		return nil
+	}
+
+	if len(c.entries) >= c.maxEntries {
+		c.purge(now)
+
+		if len(c.entries) >= c.maxEntries {
+			c.remove(c.expiries[0])
+		}
+	}
+
+	e := &entry{key: key, value: value, expiresAt: now.Add(ttl)}
+	c.entries[key] = e
+	heap.Push(&c.expiries, e)
+
+	return nil
+}
+
+// Ping always succeeds.
+func (c *MemoryCache) Ping(_ context.Context) error {
+	return nil
+}
+
+// Stats returns the number of live entries.
+func (c *MemoryCache) Stats(_ context.Context) (Stats, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.purge(c.now())
+
+	return Stats{TotalKeys: int64(len(c.entries))}, nil
+}
+
+// purge drops the expired entries. c.mu must be held.
+func (c *MemoryCache) purge(now time.Time) {
+	for len(c.expiries) > 0 && !now.Before(c.expiries[0].expiresAt) {
+		c.remove(c.expiries[0])
+	}
+}
+
+// remove drops e. c.mu must be held.
+func (c *MemoryCache) remove(e *entry) {
+	heap.Remove(&c.expiries, e.index)
+	delete(c.entries, e.key)
+}
+
+// expiryHeap is a heap.Interface of entries, the entry closest to expiring first.
+type expiryHeap []*entry
+
+func (h expiryHeap) Len() int { return len(h) }
+
+func (h expiryHeap) Less(i, j int) bool { return h[i].expiresAt.Before(h[j].expiresAt) }
+
+func (h expiryHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index, h[j].index = i, j
+}
+
+func (h *expiryHeap) Push(x any) {
+	e := x.(*entry)
+	e.index = len(*h)
+	*h = append(*h, e)
+}
+
+func (h *expiryHeap) Pop() any {
+	old := *h
+	e := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+
+	return e
+}
@@ -0,0 +1,181 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// clock is a time source moved forward by the tests.
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func newTestMemory(t *testing.T, opts ...MemoryOption) (*MemoryCache, *clock) {
+	t.Helper()
+
+	c := NewMemory(opts...)
+	clk := &clock{now: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	c.now = clk.Now
+
+	return c, clk
+}
+
+func TestMemoryCacheExpiry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c, clk := newTestMemory(t)
+
+	if err := c.Set(ctx, "page", []byte("result"), time.Minute); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	// Entries without a lifetime are not stored.
+	if err := c.Set(ctx, "stale", []byte("result"), 0); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	if got, err := c.Get(ctx, "page"); err != nil || string(got) != "result" {
+		t.Errorf("Get(page) = %q, %v, want result", got, err)
+	}
+
+	if _, err := c.Get(ctx, "stale"); !errors.Is(err, ErrMiss) {
+		t.Errorf("Get(stale) error = %v, want %v", err, ErrMiss)
+	}
+
+	clk.now = clk.now.Add(time.Minute)
+
+	if _, err := c.Get(ctx, "page"); !errors.Is(err, ErrMiss) {
+		t.Errorf("Get(page) once expired error = %v, want %v", err, ErrMiss)
+	}
+
+	if stats, _ := c.Stats(ctx); stats.TotalKeys != 0 {
+		t.Errorf("Stats() keys = %d, want 0", stats.TotalKeys)
+	}
+}
+
+func TestMemoryCacheOverwrite(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c, clk := newTestMemory(t)
+
+	_ = c.Set(ctx, "page", []byte("first"), time.Minute)
+	_ = c.Set(ctx, "page", []byte("second"), time.Hour)
+
+	clk.now = clk.now.Add(30 * time.Minute)
+
+	if got, err := c.Get(ctx, "page"); err != nil || string(got) != "second" {
+		t.Errorf("Get(page) = %q, %v, want second", got, err)
+	}
+
+	if stats, _ := c.Stats(ctx); stats.TotalKeys != 1 {
+		t.Errorf("Stats() keys = %d, want 1", stats.TotalKeys)
+	}
+}
+
+func TestMemoryCacheEviction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		advance     time.Duration
+		wantEvicted []string
+		wantKept    []string
+	}{
+		{
+			// The entry closest to expiring makes room.
+			name:        "full",
+			wantEvicted: []string{"soon"},
+			wantKept:    []string{"later", "latest", "new"},
+		},
+		{
+			// The expired entries make room, the others are kept.
+			name:        "full of expired entries",
+			advance:     2 * time.Minute,
+			wantEvicted: []string{"soon"},
+			wantKept:    []string{"later", "latest", "new"},
+		},
+		{
+			name:        "every entry expired",
+			advance:     time.Hour,
+			wantEvicted: []string{"soon", "later", "latest"},
+			wantKept:    []string{"new"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, clk := newTestMemory(t, WithMaxEntries(3))
+
+			_ = c.Set(ctx, "later", []byte("later"), 10*time.Minute)
+			_ = c.Set(ctx, "soon", []byte("soon"), time.Minute)
+			_ = c.Set(ctx, "latest", []byte("latest"), 20*time.Minute)
+
+			clk.now = clk.now.Add(tt.advance)
+
+			_ = c.Set(ctx, "new", []byte("new"), time.Hour)
+
+			for _, key := range tt.wantEvicted {
+				if _, err := c.Get(ctx, key); !errors.Is(err, ErrMiss) {
+					t.Errorf("Get(%s) error = %v, want %v", key, err, ErrMiss)
+				}
+			}
+
+			for _, key := range tt.wantKept {
+				if got, err := c.Get(ctx, key); err != nil || string(got) != key {
+					t.Errorf("Get(%s) = %q, %v, want %s", key, got, err, key)
+				}
+			}
+		})
+	}
+}
+
+func TestMemoryCacheHeapOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c, clk := newTestMemory(t, WithMaxEntries(100))
+
+	// Entries are set out of order of expiry, some of them refreshed with a later one.
+	ttls := make(map[string]time.Duration)
+
+	for i := range 100 {
+		key := fmt.Sprint(i)
+		ttls[key] = time.Duration((i*37)%100+1) * time.Second
+		_ = c.Set(ctx, key, []byte(key), ttls[key])
+	}
+
+	for i := 0; i < 100; i += 10 {
+		key := fmt.Sprint(i)
+		ttls[key] = 150 * time.Second
+		_ = c.Set(ctx, key, []byte(key), ttls[key])
+	}
+
+	for elapsed := 10 * time.Second; elapsed <= 160*time.Second; elapsed += 10 * time.Second {
+		clk.now = clk.now.Add(10 * time.Second)
+
+		var want int64
+
+		for _, ttl := range ttls {
+			if ttl > elapsed {
+				want++
+			}
+		}
+
+		if stats, _ := c.Stats(ctx); stats.TotalKeys != want {
+			t.Fatalf("after %s, Stats() keys = %d, want %d", elapsed, stats.TotalKeys, want)
+		}
+	}
+}
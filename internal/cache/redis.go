@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisCache is a Cache backed by Redis or a compatible server such as KeyDB. Since TotalKeys
+// is the size of the selected database, the cache is best given a database of its own.
+type RedisCache struct {
+	client *redis.Client
+}
+
+var _ Cache = (*RedisCache)(nil)
+
+// RedisOption configures the client of a RedisCache.
+type RedisOption func(*redis.Options)
+
+// WithPoolSize sets the maximum number of connections to the server.
+func WithPoolSize(size int) RedisOption {
+	return func(o *redis.Options) {
+		if size > 0 {
+			o.PoolSize = size
+		}
+	}
+}
+
+// NewRedis creates a RedisCache for the server at url, e.g. redis://localhost:6379/0.
+// Connections are established lazily.
+func NewRedis(url string, opts ...RedisOption) (*RedisCache, error) {
+	options, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("parsing redis url: %w", err)
+	}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return &RedisCache{client: redis.NewClient(options)}, nil
+}
+
+// Get returns the value stored under key.
+func (c *RedisCache) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := c.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrMiss
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", key, err)
+	}
+
+	return value, nil
+}
+
+// Set stores value under key until ttl elapsed.
+func (c *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+
+	if err := c.client.Set(ctx, key, value, ttl).Err(); err != nil {
+		return fmt.Errorf("writing %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// Ping checks the connection to the server.
+func (c *RedisCache) Ping(ctx context.Context) error {
+	return c.client.Ping(ctx).Err()
+}
+
+// Stats returns the size of the database and the statistics of the connection pool.
+func (c *RedisCache) Stats(ctx context.Context) (Stats, error) {
+	keys, err := c.client.DBSize(ctx).Result()
+	if err != nil {
+		return Stats{}, fmt.Errorf("counting keys: %w", err)
+	}
+
+	pool := c.client.PoolStats()
+
+	return Stats{
+		TotalKeys: keys,
+		PoolStats: &PoolStats{
+			Hits:           pool.Hits,
+			Misses:         pool.Misses,
+			Timeouts:       pool.Timeouts,
+			WaitCount:      pool.WaitCount,
+			WaitDurationNs: pool.WaitDurationNs,
+			TotalConns:     pool.TotalConns,
+			IdleConns:      pool.IdleConns,
+			StaleConns:     pool.StaleConns,
+		},
+	}, nil
+}
+
+// Close closes the connection pool.
+func (c *RedisCache) Close() error {
+	return c.client.Close()
+}
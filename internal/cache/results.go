@@ -0,0 +1,154 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+const resultKeyPrefix = "web-analyzer:result:"
+
+// ResultCache caches completed analyses by normalised URL and options, for a freshness window
+// counted from their completion.
+type ResultCache struct {
+	cache     Cache
+	freshness time.Duration
+}
+
+// NewResultCache creates a ResultCache on top of c.
+func NewResultCache(c Cache, freshness time.Duration) *ResultCache {
+	return &ResultCache{
+		cache:     c,
+		freshness: freshness,
+	}
+}
+
+// Lookup returns the fresh completed analysis of rawURL with the same options submitted by
+// owner, or ErrMiss.
+func (c *ResultCache) Lookup(ctx context.Context, owner, rawURL string, opts domain.Options) (*domain.Analysis, error) {
+	key, err := Key(owner, rawURL, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := c.cache.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var analysis domain.Analysis
+	if err := json.Unmarshal(value, &analysis); err != nil {
+		return nil, fmt.Errorf("decoding cached analysis: %w", err)
+	}
+
+	return &analysis, nil
+}
+
+// Store caches a completed analysis for the remainder of its freshness window. Other analyses
+// are ignored.
+func (c *ResultCache) Store(ctx context.Context, analysis *domain.Analysis) error {
+	if analysis.Status != domain.StatusCompleted || analysis.CompletedAt == nil {
+		return nil
+	}
+
+	ttl := c.freshness - time.Since(*analysis.CompletedAt)
+	if ttl <= 0 {
+		return nil
+	}
+
+	key, err := Key(analysis.Owner, analysis.URL, analysis.Options)
+	if err != nil {
+		return err
+	}
+
+	value, err := json.Marshal(analysis)
+	if err != nil {
+		return fmt.Errorf("encoding analysis: %w", err)
+	}
+
+	return c.cache.Set(ctx, key, value, ttl)
+}
+
+// Ping reports whether the underlying cache is reachable.
+func (c *ResultCache) Ping(ctx context.Context) error {
+	return c.cache.Ping(ctx)
+}
+
+// Details returns the statistics reported by the health check.
+func (c *ResultCache) Details(ctx context.Context) (map[string]any, error) {
+	stats, err := c.cache.Stats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	details := map[string]any{"total_keys": stats.TotalKeys}
+	if stats.PoolStats != nil {
+		details["pool_stats"] = stats.PoolStats
+	}
+
+	return details, nil
+}
+
+// Key returns the cache key of an analysis of rawURL with opts submitted by owner. URLs
+// differing only in the case of the scheme and host, a default port, the order of the query
+// parameters or the fragment share a key. The timeout does not affect the result and is left
+// out; rendered pages are keyed apart from fetched ones, by their wait condition, and crawls by
+// their limits. Tenants never share keys, since the cached analysis may only be read by its
+// owner.
+func Key(owner, rawURL string, opts domain.Options) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", domain.ErrInvalidURL, err)
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = normalizeHost(u.Scheme, u.Host)
+	u.Fragment, u.RawFragment = "", ""
+	u.RawQuery = u.Query().Encode()
+
+	if u.Path == "" {
+		u.Path = "/"
+	}
+
+	key := fmt.Appendf(nil, "%q|%s|headings=%t|links=%t|forms=%t",
+		owner, u.String(), opts.IncludeHeadings, opts.CheckLinks, opts.DetectForms)
+
+	if r := opts.Render; r != nil {
+		key = fmt.Appendf(key, "|render=%s|selector=%q|delay=%s", r.WaitFor, r.Selector, r.Delay)
+	}
+
+	if c := opts.Crawl; c != nil {
+		key = fmt.Appendf(key, "|crawl=%d,%d", c.MaxDepth, c.MaxPages)
+	}
+
+	sum := sha256.Sum256(key)
+
+	return resultKeyPrefix + hex.EncodeToString(sum[:]), nil
+}
+
+func normalizeHost(scheme, host string) string {
+	host = strings.ToLower(host)
+
+	hostname, port, err := net.SplitHostPort(host)
+	if err != nil {
+		return host
+	}
+
+	if (scheme == "http" && port == "80") || (scheme == "https" && port == "443") {
+		if strings.Contains(hostname, ":") {
+			return "[" + hostname + "]"
+		}
+
+		return hostname
+	}
+
+	return host
+}
@@ -0,0 +1,148 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/cache"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+func TestKey(t *testing.T) {
+	t.Parallel()
+
+	opts := domain.DefaultOptions()
+
+	withoutLinks := opts
+	withoutLinks.CheckLinks = false
+
+	otherTimeout := opts
+	otherTimeout.Timeout = time.Minute
+
+	rendered := opts
+	rendered.Render = &domain.RenderOptions{WaitFor: domain.WaitLoad}
+
+	renderedOnSelector := opts
+	renderedOnSelector.Render = &domain.RenderOptions{WaitFor: domain.WaitSelector, Selector: "#root"}
+
+	crawled := opts
+	crawled.Crawl = &domain.CrawlOptions{MaxDepth: 2, MaxPages: 50}
+
+	crawledFurther := opts
+	crawledFurther.Crawl = &domain.CrawlOptions{MaxDepth: 3, MaxPages: 50}
+
+	const base = "https://example.com/page?a=1&b=2"
+
+	tests := []struct {
+		name     string
+		owner    string
+		url      string
+		opts     domain.Options
+		wantSame bool
+	}{
+		{name: "same request", owner: "tenant", url: base, opts: opts, wantSame: true},
+		{name: "case of the scheme and host", owner: "tenant", url: "HTTPS://Example.COM/page?a=1&b=2", opts: opts, wantSame: true},
+		{name: "default port", owner: "tenant", url: "https://example.com:443/page?a=1&b=2", opts: opts, wantSame: true},
+		{name: "order of the query", owner: "tenant", url: "https://example.com/page?b=2&a=1", opts: opts, wantSame: true},
+		{name: "fragment", owner: "tenant", url: base + "#section", opts: opts, wantSame: true},
+		{name: "surrounding spaces", owner: "tenant", url: "  " + base + " ", opts: opts, wantSame: true},
+		{name: "timeout", owner: "tenant", url: base, opts: otherTimeout, wantSame: true},
+		{name: "other owner", owner: "other", url: base, opts: opts},
+		{name: "other port", owner: "tenant", url: "https://example.com:8443/page?a=1&b=2", opts: opts},
+		{name: "other scheme", owner: "tenant", url: "http://example.com/page?a=1&b=2", opts: opts},
+		{name: "case of the path", owner: "tenant", url: "https://example.com/Page?a=1&b=2", opts: opts},
+		{name: "other query", owner: "tenant", url: "https://example.com/page?a=1", opts: opts},
+		{name: "other checks", owner: "tenant", url: base, opts: withoutLinks},
+		{name: "rendered", owner: "tenant", url: base, opts: rendered},
+		{name: "crawled", owner: "tenant", url: base, opts: crawled},
+	}
+
+	want, err := cache.Key("tenant", base, opts)
+	if err != nil {
+		t.Fatalf("Key() error = %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := cache.Key(tt.owner, tt.url, tt.opts)
+			if err != nil {
+				t.Fatalf("Key(%s) error = %v", tt.url, err)
+			}
+
+			if (got == want) != tt.wantSame {
+				t.Errorf("Key(%q, %s, %+v) shared with %s: %t, want %t", tt.owner, tt.url, tt.opts, base, got == want, tt.wantSame)
+			}
+		})
+	}
+
+	pairs := [][2]domain.Options{{rendered, renderedOnSelector}, {crawled, crawledFurther}}
+	for _, pair := range pairs {
+		first, _ := cache.Key("tenant", base, pair[0])
+		second, _ := cache.Key("tenant", base, pair[1])
+
+		if first == second {
+			t.Errorf("Key() of %+v and %+v are the same, want them apart", pair[0], pair[1])
+		}
+	}
+
+	if _, err := cache.Key("tenant", "http://[::1", opts); !errors.Is(err, domain.ErrInvalidURL) {
+		t.Errorf("Key() error = %v, want %v", err, domain.ErrInvalidURL)
+	}
+}
+
+func TestResultCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	results := cache.NewResultCache(cache.NewMemory(), time.Hour)
+
+	completedAt := time.Now().UTC()
+
+	completed := domain.NewAnalysis("tenant", "https://example.com/", domain.DefaultOptions())
+	completed.Status = domain.StatusCompleted
+	completed.CompletedAt = &completedAt
+
+	stale := domain.NewAnalysis("tenant", "https://example.com/stale", domain.DefaultOptions())
+	stale.Status = domain.StatusCompleted
+	longAgo := completedAt.Add(-2 * time.Hour)
+	stale.CompletedAt = &longAgo
+
+	failed := domain.NewAnalysis("tenant", "https://example.com/failed", domain.DefaultOptions())
+	failed.Status = domain.StatusFailed
+	failed.CompletedAt = &completedAt
+
+	for _, analysis := range []*domain.Analysis{completed, stale, failed} {
+		if err := results.Store(ctx, analysis); err != nil {
+			t.Fatalf("Store(%s) error = %v", analysis.URL, err)
+		}
+	}
+
+	got, err := results.Lookup(ctx, "tenant", "https://EXAMPLE.com:443/#top", domain.DefaultOptions())
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+
+	if got.ID != completed.ID {
+		t.Errorf("Lookup() = %s, want %s", got.ID, completed.ID)
+	}
+
+	misses := []struct {
+		name  string
+		owner string
+		url   string
+	}{
+		{name: "other owner", owner: "other", url: completed.URL},
+		{name: "past the freshness window", owner: "tenant", url: stale.URL},
+		{name: "failed", owner: "tenant", url: failed.URL},
+	}
+
+	for _, miss := range misses {
+		if _, err := results.Lookup(ctx, miss.owner, miss.url, domain.DefaultOptions()); !errors.Is(err, cache.ErrMiss) {
+			t.Errorf("%s: Lookup() error = %v, want %v", miss.name, err, cache.ErrMiss)
+		}
+	}
+}
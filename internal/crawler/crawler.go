@@ -0,0 +1,180 @@
+// Package crawler holds what the site crawls follow: the robots.txt policies of the sites, the
+// delays between their requests, and the frontier of the pages left to crawl.
+package crawler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/netguard"
+)
+
+const (
+	defaultUserAgent    = "web-analyzer/1.0 (+https://github.com/architeacher/svc-web-analyzer)"
+	defaultMinDelay     = 250 * time.Millisecond
+	defaultMaxDelay     = 10 * time.Second
+	defaultMaxDuration  = 5 * time.Minute
+	defaultMaxRedirects = 5
+
+	// robotsTimeout bounds the retrieval of a robots.txt file.
+	robotsTimeout = 10 * time.Second
+	// maxRobotsSize is how much of a robots.txt file is parsed, the minimum of RFC 9309.
+	maxRobotsSize = 500 << 10
+)
+
+// Option configures a Crawler.
+type Option func(*Crawler)
+
+// WithClient overrides the HTTP client retrieving the robots.txt files, along with its
+// protection of the internal network.
+func WithClient(client *http.Client) Option {
+	return func(c *Crawler) {
+		c.client = client
+	}
+}
+
+// WithGuard sets the guard checking the addresses the robots.txt files are retrieved from. By
+// default, only public addresses are reachable.
+func WithGuard(guard *netguard.Guard) Option {
+	return func(c *Crawler) {
+		c.guard = guard
+	}
+}
+
+// WithUserAgent sets the User-Agent the robots.txt files are requested with, whose product
+// token selects the rules that apply.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Crawler) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithDelays bounds the delay between two requests to a site: minDelay when its robots.txt asks
+// for none or for less, maxDelay when it asks for more.
+func WithDelays(minDelay, maxDelay time.Duration) Option {
+	return func(c *Crawler) {
+		if minDelay >= 0 {
+			c.minDelay = minDelay
+		}
+
+		if maxDelay >= 0 {
+			c.maxDelay = maxDelay
+		}
+	}
+}
+
+// WithMaxDuration sets how long a crawl may run before it stops with the pages crawled so far.
+func WithMaxDuration(duration time.Duration) Option {
+	return func(c *Crawler) {
+		if duration > 0 {
+			c.maxDuration = duration
+		}
+	}
+}
+
+// Crawler retrieves the robots.txt policies of the sites crawled and paces the requests to them.
+type Crawler struct {
+	client      *http.Client
+	guard       *netguard.Guard
+	userAgent   string
+	minDelay    time.Duration
+	maxDelay    time.Duration
+	maxDuration time.Duration
+}
+
+// New creates a Crawler.
+func New(opts ...Option) *Crawler {
+	c := &Crawler{
+		userAgent:   defaultUserAgent,
+		minDelay:    defaultMinDelay,
+		maxDelay:    defaultMaxDelay,
+		maxDuration: defaultMaxDuration,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.client == nil {
+		c.client = netguard.NewClient(c.guard, defaultMaxRedirects)
+	}
+
+	return c
+}
+
+// MaxDuration returns how long a crawl may run.
+func (c *Crawler) MaxDuration() time.Duration {
+	return c.maxDuration
+}
+
+// Delay returns how long to wait between two requests to a site with the given policy.
+func (c *Crawler) Delay(robots *Robots) time.Duration {
+	return min(max(robots.CrawlDelay, c.minDelay), max(c.maxDelay, c.minDelay))
+}
+
+// Robots retrieves the robots.txt policy of the site of u. As RFC 9309 prescribes, sites
+// answering 4xx have none, so that everything is allowed, and sites that cannot be reached or
+// answer 5xx disallow everything. The error tells why the file could not be retrieved.
+func (c *Crawler) Robots(ctx context.Context, u *url.URL) (*Robots, error) {
+	ctx, cancel := context.WithTimeout(ctx, robotsTimeout)
+	defer cancel()
+
+	robotsURL := url.URL{Scheme: u.Scheme, Host: u.Host, Path: "/robots.txt"}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, robotsURL.String(), nil)
+	if err != nil {
+		return DisallowAll, err
+	}
+
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.client.Do(req)
+	if errors.Is(err, netguard.ErrTooManyRedirects) {
+		// Files behind more redirects than followed are deemed unavailable.
+		return AllowAll, nil
+	}
+
+	if err != nil {
+		return DisallowAll, fmt.Errorf("retrieving %s: %w", robotsURL.String(), err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return DisallowAll, fmt.Errorf("retrieving %s: %s", robotsURL.String(), resp.Status)
+	case resp.StatusCode >= http.StatusBadRequest:
+		return AllowAll, nil
+	case resp.StatusCode >= http.StatusMultipleChoices:
+		// Redirects without a location are not followed.
+		return AllowAll, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxRobotsSize))
+	if err != nil {
+		return DisallowAll, fmt.Errorf("reading %s: %w", robotsURL.String(), err)
+	}
+
+	return ParseRobots(body, c.userAgent), nil
+}
+
+// Wait blocks for d, or until ctx is done.
+func Wait(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,135 @@
+package crawler
+
+import (
+	"net"
+	"net/url"
+	"strings"
+)
+
+// Target is a page to crawl, found Depth links away from the start page.
+type Target struct {
+	URL   *url.URL
+	Depth int
+}
+
+// Frontier is the queue of the pages of a crawl, visited breadth first: the pages of the origin
+// of the start page, its scheme, host and port, not visited yet, up to a maximum depth.
+type Frontier struct {
+	origin   string
+	maxDepth int
+	queue    []Target
+	seen     map[string]struct{}
+}
+
+// NewFrontier creates a Frontier crawling the origin of start, visited already, at most
+// maxDepth links away from it.
+func NewFrontier(start *url.URL, maxDepth int) *Frontier {
+	f := &Frontier{
+		origin:   origin(start),
+		maxDepth: maxDepth,
+		seen:     make(map[string]struct{}),
+	}
+
+	f.Visit(start)
+
+	return f
+}
+
+// Origin returns the origin crawled, such as https://example.com:443.
+func (f *Frontier) Origin() string {
+	return f.origin
+}
+
+// Visit records u as visited, such as the page a crawled URL redirected to, so that it is not
+// queued again.
+func (f *Frontier) Visit(u *url.URL) {
+	f.seen[key(u)] = struct{}{}
+}
+
+// Add queues the links of a page found at depth, but for those of other origins, other ports
+// of the host included, visited or queued already, or beyond the maximum depth.
+func (f *Frontier) Add(links []*url.URL, depth int) {
+	if depth >= f.maxDepth {
+		return
+	}
+
+	for _, link := range links {
+		if origin(link) != f.origin {
+			continue
+		}
+
+		k := key(link)
+		if _, ok := f.seen[k]; ok {
+			continue
+		}
+
+		f.seen[k] = struct{}{}
+
+		target := *link
+		target.Fragment, target.RawFragment = "", ""
+		f.queue = append(f.queue, Target{URL: &target, Depth: depth + 1})
+	}
+}
+
+// Next returns the next page to crawl, or false when none is left.
+func (f *Frontier) Next() (Target, bool) {
+	if len(f.queue) == 0 {
+		return Target{}, false
+	}
+
+	next := f.queue[0]
+	f.queue = f.queue[1:]
+
+	return next, true
+}
+
+// Len returns the number of pages left to crawl.
+func (f *Frontier) Len() int {
+	return len(f.queue)
+}
+
+// origin returns the normalised scheme://host:port of u, its port made explicit, or an empty
+// string for URLs other than http and https ones.
+func origin(u *url.URL) string {
+	scheme := strings.ToLower(u.Scheme)
+
+	var port string
+	switch scheme {
+	case "http":
+		port = "80"
+	case "https":
+		port = "443"
+	default:
+		return ""
+	}
+
+	if p := u.Port(); p != "" {
+		port = p
+	}
+
+	return scheme + "://" + net.JoinHostPort(strings.ToLower(u.Hostname()), port)
+}
+
+// key identifies the page of u: URLs differing only in the case of the scheme and host, a
+// default port or the fragment are the same page.
+func key(u *url.URL) string {
+	normalized := *u
+	normalized.Scheme = strings.ToLower(u.Scheme)
+	normalized.Host = strings.ToLower(u.Host)
+	normalized.Fragment, normalized.RawFragment = "", ""
+	normalized.User = nil
+
+	if host, port, err := net.SplitHostPort(normalized.Host); err == nil &&
+		((normalized.Scheme == "http" && port == "80") || (normalized.Scheme == "https" && port == "443")) {
+		normalized.Host = host
+		if strings.Contains(host, ":") {
+			normalized.Host = "[" + host + "]"
+		}
+	}
+
+	if normalized.Path == "" && normalized.RawPath == "" {
+		normalized.Path = "/"
+	}
+
+	return normalized.String()
+}
@@ -0,0 +1,113 @@
+package crawler
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func mustParse(t *testing.T, rawURL string) *url.URL {
+	t.Helper()
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatalf("url.Parse(%s) error = %v", rawURL, err)
+	}
+
+	return u
+}
+
+func drain(f *Frontier) []Target {
+	var targets []Target
+
+	for {
+		target, ok := f.Next()
+		if !ok {
+			return targets
+		}
+
+		targets = append(targets, target)
+	}
+}
+
+func TestFrontierAdd(t *testing.T) {
+	t.Parallel()
+
+	f := NewFrontier(mustParse(t, "https://example.com/"), 2)
+
+	if got, want := f.Origin(), "https://example.com:443"; got != want {
+		t.Errorf("Origin() = %s, want %s", got, want)
+	}
+
+	var links []*url.URL
+	for _, rawURL := range []string{
+		"https://example.com/about",
+		"https://EXAMPLE.com:443/about#team",
+		"https://example.com",
+		"https://example.com/",
+		"https://example.com/contact#form",
+		"https://example.com:8443/admin",
+		"http://example.com/about",
+		"https://other.example/",
+		"https://sub.example.com/",
+		"mailto:team@example.com",
+	} {
+		links = append(links, mustParse(t, rawURL))
+	}
+
+	f.Add(links, 0)
+
+	want := []Target{
+		{URL: mustParse(t, "https://example.com/about"), Depth: 1},
+		{URL: mustParse(t, "https://example.com/contact"), Depth: 1},
+	}
+
+	if got := drain(f); !reflect.DeepEqual(got, want) {
+		t.Errorf("Next() = %+v, want %+v", got, want)
+	}
+}
+
+func TestFrontierMaxDepth(t *testing.T) {
+	t.Parallel()
+
+	f := NewFrontier(mustParse(t, "http://example.com:8080/"), 2)
+
+	f.Add([]*url.URL{mustParse(t, "http://example.com:8080/1")}, 0)
+	f.Add([]*url.URL{mustParse(t, "http://example.com:8080/2")}, 1)
+	// Pages at the maximum depth have their links dropped.
+	f.Add([]*url.URL{mustParse(t, "http://example.com:8080/3")}, 2)
+
+	want := []Target{
+		{URL: mustParse(t, "http://example.com:8080/1"), Depth: 1},
+		{URL: mustParse(t, "http://example.com:8080/2"), Depth: 2},
+	}
+
+	if got := drain(f); !reflect.DeepEqual(got, want) {
+		t.Errorf("Next() = %+v, want %+v", got, want)
+	}
+
+	if f.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", f.Len())
+	}
+}
+
+func TestFrontierVisit(t *testing.T) {
+	t.Parallel()
+
+	f := NewFrontier(mustParse(t, "https://example.com/"), 1)
+	f.Visit(mustParse(t, "https://example.com/home"))
+
+	f.Add([]*url.URL{
+		mustParse(t, "https://example.com/home"),
+		mustParse(t, "https://example.com:443/"),
+		mustParse(t, "https://example.com/news"),
+	}, 0)
+
+	if got := f.Len(); got != 1 {
+		t.Fatalf("Len() = %d, want 1", got)
+	}
+
+	if target, _ := f.Next(); target.URL.String() != "https://example.com/news" {
+		t.Errorf("Next() = %s, want https://example.com/news", target.URL)
+	}
+}
@@ -0,0 +1,187 @@
+package crawler
+
+import (
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Robots is the robots.txt policy of a site, as it applies to a crawler.
+type Robots struct {
+	rules []rule
+	// CrawlDelay is the delay asked for between two requests to the site, zero when none is.
+	CrawlDelay time.Duration
+}
+
+type rule struct {
+	pattern string
+	allow   bool
+}
+
+var (
+	// AllowAll is the policy of the sites without a robots.txt file.
+	AllowAll = &Robots{}
+	// DisallowAll is the policy of the sites whose robots.txt file cannot be retrieved, since
+	// the site may be unavailable or unwilling to be crawled.
+	DisallowAll = &Robots{rules: []rule{{pattern: "/", allow: false}}}
+)
+
+// ParseRobots parses a robots.txt file, following RFC 9309. Only the groups naming the product
+// token of userAgent, such as "web-analyzer" for "web-analyzer/1.0 (+https://...)", apply or,
+// when none does, those of "*".
+func ParseRobots(body []byte, userAgent string) *Robots {
+	type group struct {
+		agents []string
+		rules  []rule
+		delay  time.Duration
+	}
+
+	var (
+		groups []*group
+		// current is the group the rules read belong to; a User-agent line following rules
+		// starts a new group.
+		current    *group
+		readAgents bool
+	)
+
+	for line := range strings.Lines(string(body)) {
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+
+		key, value = strings.ToLower(strings.TrimSpace(key)), strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			if current == nil || !readAgents {
+				current = &group{}
+				groups = append(groups, current)
+			}
+
+			current.agents = append(current.agents, strings.ToLower(value))
+			readAgents = true
+		case "allow", "disallow":
+			readAgents = false
+
+			// An empty rule matches nothing.
+			if current != nil && value != "" {
+				current.rules = append(current.rules, rule{pattern: value, allow: key == "allow"})
+			}
+		case "crawl-delay":
+			readAgents = false
+
+			seconds, err := strconv.ParseFloat(value, 64)
+			if current != nil && err == nil && seconds >= 0 && !math.IsInf(seconds, 1) {
+				current.delay = time.Duration(seconds * float64(time.Second))
+			}
+		}
+	}
+
+	token := productToken(userAgent)
+
+	matching := func(agent string) []*group {
+		var matched []*group
+
+		for _, g := range groups {
+			for _, a := range g.agents {
+				if a == agent {
+					matched = append(matched, g)
+
+					break
+				}
+			}
+		}
+
+		return matched
+	}
+
+	matched := matching(token)
+	if len(matched) == 0 {
+		matched = matching("*")
+	}
+
+	robots := &Robots{}
+
+	for _, g := range matched {
+		robots.rules = append(robots.rules, g.rules...)
+		robots.CrawlDelay = max(robots.CrawlDelay, g.delay)
+	}
+
+	return robots
+}
+
+// Allowed reports whether u may be crawled: the longest rule matching its path and query
+// applies, Allow winning ties, and paths matched by no rule are allowed.
+func (r *Robots) Allowed(u *url.URL) bool {
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	if path == "/robots.txt" {
+		return true
+	}
+
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+
+	longest, allowed := -1, true
+
+	for _, rule := range r.rules {
+		if !match(rule.pattern, path) {
+			continue
+		}
+
+		if n := len(rule.pattern); n > longest || (n == longest && rule.allow) {
+			longest, allowed = n, rule.allow
+		}
+	}
+
+	return allowed
+}
+
+// match reports whether path matches pattern, in which "*" matches any sequence of characters
+// and a trailing "$" anchors the end of the path.
+func match(pattern, path string) bool {
+	pattern, anchored := strings.CutSuffix(pattern, "$")
+	parts := strings.Split(pattern, "*")
+
+	rest, ok := strings.CutPrefix(path, parts[0])
+	if !ok {
+		return false
+	}
+
+	for i, part := range parts[1:] {
+		// Earlier parts matched as early as possible, the last one of an anchored pattern must
+		// end the path.
+		if anchored && i == len(parts)-2 {
+			return strings.HasSuffix(rest, part)
+		}
+
+		j := strings.Index(rest, part)
+		if j < 0 {
+			return false
+		}
+
+		rest = rest[j+len(part):]
+	}
+
+	return !anchored || rest == ""
+}
+
+// productToken returns the lowercase product token of a User-Agent, its name before the
+// version.
+func productToken(userAgent string) string {
+	token, _, _ := strings.Cut(strings.TrimSpace(userAgent), "/")
+	token, _, _ = strings.Cut(token, " ")
+
+	return strings.ToLower(token)
+}
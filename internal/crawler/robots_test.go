@@ -0,0 +1,210 @@
+package crawler
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{pattern: "/", path: "/anything", want: true},
+		{pattern: "/fish", path: "/fish.html", want: true},
+		{pattern: "/fish", path: "/Fish", want: false},
+		{pattern: "/fish/", path: "/fish", want: false},
+		{pattern: "/*.php", path: "/index.php", want: true},
+		{pattern: "/*.php", path: "/folder/index.php?x=1", want: true},
+		{pattern: "/*.php", path: "/index.html", want: false},
+		{pattern: "/*.php$", path: "/index.php", want: true},
+		{pattern: "/*.php$", path: "/index.php?x=1", want: false},
+		{pattern: "/*.php$", path: "/a.php/b.php", want: true},
+		{pattern: "/fish*", path: "/fishheads", want: true},
+		{pattern: "/fish*.php", path: "/fishheads/catfish.php?parameters", want: true},
+		{pattern: "/a*b*c", path: "/a-c-b", want: false},
+		{pattern: "/$", path: "/", want: true},
+		{pattern: "/$", path: "/page", want: false},
+		{pattern: "*", path: "/", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			t.Parallel()
+
+			if got := match(tt.pattern, tt.path); got != tt.want {
+				t.Errorf("match(%q, %q) = %t, want %t", tt.pattern, tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRobotsAllowed(t *testing.T) {
+	t.Parallel()
+
+	const robotsTxt = `
+# The rules of every crawler.
+User-agent: *
+Disallow: /
+
+User-agent: other-bot
+User-agent: web-analyzer
+Disallow: /private
+Allow: /private/public
+Disallow: /*.pdf$
+Allow: /page
+Disallow: /page
+Disallow: /search?
+Allow: /search?q=
+Disallow:
+Crawl-delay: 2.5
+
+User-agent: web-analyzer
+Disallow: /drafts/
+`
+
+	robots := ParseRobots([]byte(robotsTxt), "Web-Analyzer/1.0 (+https://example.com)")
+
+	if robots.CrawlDelay != 2500*time.Millisecond {
+		t.Errorf("CrawlDelay = %s, want 2.5s", robots.CrawlDelay)
+	}
+
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "/", want: true},
+		{path: "", want: true},
+		{path: "/about", want: true},
+		{path: "/private", want: false},
+		{path: "/private/keys", want: false},
+		// The longest match applies.
+		{path: "/private/public/index.html", want: true},
+		{path: "/report.pdf", want: false},
+		{path: "/report.pdf?download=1", want: true},
+		// Allow wins ties.
+		{path: "/page", want: true},
+		{path: "/search?page=2", want: false},
+		{path: "/search?q=go", want: true},
+		// The groups of a user agent are merged.
+		{path: "/drafts/post", want: false},
+		{path: "/robots.txt", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			t.Parallel()
+
+			u, err := url.Parse("https://example.com" + tt.path)
+			if err != nil {
+				t.Fatalf("url.Parse(%s) error = %v", tt.path, err)
+			}
+
+			if got := robots.Allowed(u); got != tt.want {
+				t.Errorf("Allowed(%s) = %t, want %t", u, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRobotsGroups(t *testing.T) {
+	t.Parallel()
+
+	const robotsTxt = `
+User-agent: web-analyzer-extended
+Disallow: /
+
+User-agent: *
+Disallow: /admin
+Crawl-delay: 1
+`
+
+	tests := []struct {
+		name      string
+		body      string
+		userAgent string
+		path      string
+		want      bool
+		wantDelay time.Duration
+	}{
+		{
+			name:      "the * group of an unnamed crawler",
+			body:      robotsTxt,
+			userAgent: "web-analyzer/1.0",
+			path:      "/admin",
+			want:      false,
+			wantDelay: time.Second,
+		},
+		{
+			name:      "the group of a longer product token not applying",
+			body:      robotsTxt,
+			userAgent: "web-analyzer/1.0",
+			path:      "/about",
+			want:      true,
+			wantDelay: time.Second,
+		},
+		{
+			name:      "the group named by the product token",
+			body:      robotsTxt,
+			userAgent: "Web-Analyzer-Extended/2.0",
+			path:      "/about",
+			want:      false,
+		},
+		{
+			name:      "rules before any user agent ignored",
+			body:      "Disallow: /\nUser-agent: *\nDisallow: /admin\n",
+			userAgent: "web-analyzer/1.0",
+			path:      "/about",
+			want:      true,
+		},
+		{
+			name:      "no matching group",
+			body:      "User-agent: other-bot\nDisallow: /\n",
+			userAgent: "web-analyzer/1.0",
+			path:      "/about",
+			want:      true,
+		},
+		{
+			name:      "invalid crawl delay ignored",
+			body:      "User-agent: *\nCrawl-delay: soon\nCrawl-delay: -1\n",
+			userAgent: "web-analyzer/1.0",
+			path:      "/",
+			want:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			robots := ParseRobots([]byte(tt.body), tt.userAgent)
+
+			u := &url.URL{Scheme: "https", Host: "example.com", Path: tt.path}
+			if got := robots.Allowed(u); got != tt.want {
+				t.Errorf("Allowed(%s) = %t, want %t", u, got, tt.want)
+			}
+
+			if robots.CrawlDelay != tt.wantDelay {
+				t.Errorf("CrawlDelay = %s, want %s", robots.CrawlDelay, tt.wantDelay)
+			}
+		})
+	}
+}
+
+func TestRobotsPolicies(t *testing.T) {
+	t.Parallel()
+
+	u := &url.URL{Scheme: "https", Host: "example.com", Path: "/page"}
+
+	if !AllowAll.Allowed(u) {
+		t.Errorf("AllowAll.Allowed(%s) = false, want true", u)
+	}
+
+	if DisallowAll.Allowed(u) {
+		t.Errorf("DisallowAll.Allowed(%s) = true, want false", u)
+	}
+}
@@ -0,0 +1,263 @@
+// Package domain holds the core analysis entities shared by the service,
+// its adapters and the HTTP handlers.
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status represents the lifecycle state of an analysis.
+type Status string
+
+const (
+	StatusRequested  Status = "requested"
+	StatusInProgress Status = "in_progress"
+	StatusCompleted  Status = "completed"
+	StatusFailed     Status = "failed"
+)
+
+// IsTerminal reports whether no further transitions are expected.
+func (s Status) IsTerminal() bool {
+	return s == StatusCompleted || s == StatusFailed
+}
+
+// CanTransitionTo reports whether an analysis in status s may move to next. Requested and
+// in-progress analyses may stay where they are, e.g. to report progress; terminal ones are final.
+func (s Status) CanTransitionTo(next Status) bool {
+	switch s {
+	case StatusRequested:
+		return next == StatusRequested || next == StatusInProgress || next == StatusFailed
+	case StatusInProgress:
+		return next == StatusInProgress || next == StatusCompleted || next == StatusFailed
+	default:
+		return false
+	}
+}
+
+const (
+	DefaultTimeout = 30 * time.Second
+	MinTimeout     = 5 * time.Second
+	MaxTimeout     = 300 * time.Second
+)
+
+// Options controls which parts of the analysis are executed.
+type Options struct {
+	IncludeHeadings bool          `json:"include_headings"`
+	CheckLinks      bool          `json:"check_links"`
+	DetectForms     bool          `json:"detect_forms"`
+	Timeout         time.Duration `json:"timeout"`
+	// Render, when set, has the page rendered in a headless browser before it is analyzed.
+	Render *RenderOptions `json:"render,omitempty"`
+	// Crawl, when set, has the internal pages linked from the page analyzed along with it.
+	Crawl *CrawlOptions `json:"crawl,omitempty"`
+}
+
+// Conditions a rendered page is awaited on before it is analyzed.
+const (
+	WaitLoad        = "load"
+	WaitNetworkIdle = "network_idle"
+	WaitSelector    = "selector"
+	WaitDelay       = "delay"
+)
+
+// The fixed delay a rendered page is awaited for by default, and at most.
+const (
+	DefaultRenderDelay = time.Second
+	MaxRenderDelay     = 30 * time.Second
+)
+
+// RenderOptions controls how a page is rendered, running its scripts, before being analyzed.
+type RenderOptions struct {
+	// WaitFor is what the page is awaited on once navigated to: its load event, the network
+	// being idle, Selector matching an element, or Delay elapsing after the load event.
+	WaitFor  string        `json:"wait_for"`
+	Selector string        `json:"selector,omitempty"`
+	Delay    time.Duration `json:"delay,omitempty"`
+}
+
+// The depth and the number of pages of a crawl, by default and at most.
+const (
+	DefaultCrawlDepth = 2
+	MaxCrawlDepth     = 5
+	DefaultCrawlPages = 50
+	MaxCrawlPages     = 500
+)
+
+// CrawlOptions bounds a crawl. The timeout of the analysis applies to each of its pages.
+type CrawlOptions struct {
+	// MaxDepth is how many links away from the start page the pages crawled may be.
+	MaxDepth int `json:"max_depth"`
+	// MaxPages is how many pages are analyzed, the start page included.
+	MaxPages int `json:"max_pages"`
+}
+
+// DefaultOptions returns the options documented as defaults in the API specification.
+func DefaultOptions() Options {
+	return Options{
+		IncludeHeadings: true,
+		CheckLinks:      true,
+		DetectForms:     true,
+		Timeout:         DefaultTimeout,
+	}
+}
+
+// Analysis is a single request to analyze a web page, together with its progress and outcome.
+type Analysis struct {
+	ID          uuid.UUID      `json:"analysis_id"`
+	Owner       string         `json:"owner,omitempty"`
+	URL         string         `json:"url"`
+	Options     Options        `json:"options"`
+	Status      Status         `json:"status"`
+	Progress    int            `json:"progress"`
+	CurrentStep string         `json:"current_step,omitempty"`
+	Steps       []Step         `json:"steps,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+	StartedAt   *time.Time     `json:"started_at,omitempty"`
+	CompletedAt *time.Time     `json:"completed_at,omitempty"`
+	Results     *AnalysisData  `json:"results,omitempty"`
+	Error       *AnalysisError `json:"error,omitempty"`
+}
+
+// Step is an analyzer that completed, together with the result section it contributed.
+type Step struct {
+	Name        string    `json:"step"`
+	Progress    int       `json:"progress"`
+	Results     any       `json:"results,omitempty"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+// NewAnalysis creates an analysis in the requested state, owned by the tenant that submitted it.
+func NewAnalysis(owner, url string, opts Options) *Analysis {
+	return &Analysis{
+		ID:        uuid.New(),
+		Owner:     owner,
+		URL:       url,
+		Options:   opts,
+		Status:    StatusRequested,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// Duration returns how long the analysis took, or zero while it is still running.
+func (a *Analysis) Duration() time.Duration {
+	if a.CompletedAt == nil {
+		return 0
+	}
+
+	return a.CompletedAt.Sub(a.CreatedAt)
+}
+
+// Clone returns a copy that can be handed out without sharing mutable state.
+func (a *Analysis) Clone() *Analysis {
+	clone := *a
+	clone.Steps = append([]Step(nil), a.Steps...)
+
+	return &clone
+}
+
+// AnalysisData is the result of a completed analysis.
+type AnalysisData struct {
+	HTMLVersion   string         `json:"html_version"`
+	Title         string         `json:"title"`
+	HeadingCounts *HeadingCounts `json:"heading_counts,omitempty"`
+	Links         *LinkAnalysis  `json:"links,omitempty"`
+	Forms         *FormAnalysis  `json:"forms,omitempty"`
+	Encoding      *Encoding      `json:"encoding,omitempty"`
+	// Crawl summarises the pages of a crawl, whose start page the other fields describe.
+	Crawl *CrawlSummary `json:"crawl,omitempty"`
+	// Extensions holds the sections contributed by analyzers beyond the built-in ones, keyed by analyzer name.
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+// CrawlSummary aggregates the pages of a crawl.
+type CrawlSummary struct {
+	// PagesCrawled counts the pages retrieved, PagesFailed those of them that could not be
+	// analyzed, and PagesDisallowed the pages robots.txt kept from being crawled.
+	PagesCrawled    int `json:"pages_crawled"`
+	PagesFailed     int `json:"pages_failed"`
+	PagesDisallowed int `json:"pages_disallowed"`
+	MaxDepthReached int `json:"max_depth_reached"`
+	// BrokenLinks counts the distinct inaccessible links of the pages, when links are checked.
+	BrokenLinks int `json:"broken_links"`
+	// PagesWithoutH1 counts the pages without a h1 heading, when headings are included, and
+	// PagesWithLoginForms those with a login form, when forms are detected.
+	PagesWithoutH1      int `json:"pages_without_h1"`
+	PagesWithLoginForms int `json:"pages_with_login_forms"`
+	// Truncated reports that pages were left uncrawled, once the page limit or the time limit
+	// of the crawl was reached.
+	Truncated bool `json:"truncated"`
+}
+
+// CrawledPage is a page of a crawl, with its results or why it could not be analyzed.
+type CrawledPage struct {
+	// URL is the address of the page, after redirects.
+	URL string `json:"url"`
+	// Depth is how many links away from the start page the page was found.
+	Depth     int            `json:"depth"`
+	CrawledAt time.Time      `json:"crawled_at"`
+	Results   *AnalysisData  `json:"results,omitempty"`
+	Error     *AnalysisError `json:"error,omitempty"`
+}
+
+// Where the encoding of a page was detected from, from the most to the least authoritative.
+const (
+	EncodingSourceBOM     = "bom"
+	EncodingSourceHeader  = "header"
+	EncodingSourceMeta    = "meta"
+	EncodingSourceDefault = "default"
+)
+
+// Encoding describes the character encoding a page was transcoded to UTF-8 from.
+type Encoding struct {
+	// Charset is the canonical name of the encoding, such as utf-8, shift_jis or windows-1251.
+	Charset string `json:"charset"`
+	Source  string `json:"source"`
+	// HeaderCharset and MetaCharset are the charsets declared by the Content-Type header and by
+	// the <meta> elements of the page, as written.
+	HeaderCharset string `json:"header_charset,omitempty"`
+	MetaCharset   string `json:"meta_charset,omitempty"`
+	// Mismatch reports that the header and the page declare different encodings.
+	Mismatch bool `json:"mismatch"`
+}
+
+// HeadingCounts holds the number of headings per level.
+type HeadingCounts struct {
+	H1 int `json:"h1"`
+	H2 int `json:"h2"`
+	H3 int `json:"h3"`
+	H4 int `json:"h4"`
+	H5 int `json:"h5"`
+	H6 int `json:"h6"`
+}
+
+// LinkAnalysis summarises the links found on a page.
+type LinkAnalysis struct {
+	InternalCount     int                `json:"internal_count"`
+	ExternalCount     int                `json:"external_count"`
+	TotalCount        int                `json:"total_count"`
+	InaccessibleLinks []InaccessibleLink `json:"inaccessible_links"`
+}
+
+// InaccessibleLink describes a link that could not be reached.
+type InaccessibleLink struct {
+	URL        string `json:"url"`
+	StatusCode int    `json:"status_code"`
+	Error      string `json:"error"`
+	ErrorCode  string `json:"error_code,omitempty"`
+}
+
+// FormAnalysis summarises the forms found on a page.
+type FormAnalysis struct {
+	TotalCount         int         `json:"total_count"`
+	LoginFormsDetected int         `json:"login_forms_detected"`
+	LoginFormDetails   []LoginForm `json:"login_form_details"`
+}
+
+// LoginForm describes a detected login form.
+type LoginForm struct {
+	Method string   `json:"method"`
+	Action string   `json:"action"`
+	Fields []string `json:"fields"`
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxBatchItems is how many analyses a batch may hold.
+const MaxBatchItems = 500
+
+// Batch is a set of analyses submitted at once, such as those of the pages listed by a sitemap.
+type Batch struct {
+	ID    uuid.UUID `json:"batch_id"`
+	Owner string    `json:"owner,omitempty"`
+	// SitemapURL is the sitemap the pages of the batch were listed by, empty when they were
+	// submitted one by one.
+	SitemapURL string    `json:"sitemap_url,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	// Progress counts the analyses of the batch by status, as of when the batch was read.
+	Progress BatchProgress `json:"progress"`
+}
+
+// BatchItem is a page submitted in a batch, with the options it is analyzed with.
+type BatchItem struct {
+	URL     string
+	Options Options
+}
+
+// BatchProgress aggregates the analyses of a batch. Analyses removed once their retention
+// period is over are not counted.
+type BatchProgress struct {
+	Total      int `json:"total"`
+	Requested  int `json:"requested"`
+	InProgress int `json:"in_progress"`
+	Completed  int `json:"completed"`
+	Failed     int `json:"failed"`
+	// Percentage is the mean progress of the analyses, the finished ones counting as done.
+	Percentage int `json:"percentage"`
+}
+
+// NewBatch creates an empty batch owned by the tenant that submitted it.
+func NewBatch(owner, sitemapURL string) *Batch {
+	return &Batch{
+		ID:         uuid.New(),
+		Owner:      owner,
+		SitemapURL: sitemapURL,
+		CreatedAt:  time.Now().UTC(),
+	}
+}
+
+// Status returns the status of the batch: completed once all its analyses finished, whether
+// they completed or failed, and in progress until then.
+func (b *Batch) Status() Status {
+	if b.Progress.Finished() {
+		return StatusCompleted
+	}
+
+	return StatusInProgress
+}
+
+// Finished reports whether all the analyses finished.
+func (p BatchProgress) Finished() bool {
+	return p.Completed+p.Failed == p.Total
+}
+
+// NewBatchProgress aggregates analyses.
+func NewBatchProgress(analyses []*Analysis) BatchProgress {
+	var (
+		p    BatchProgress
+		done int
+	)
+
+	for _, a := range analyses {
+		switch a.Status {
+		case StatusRequested:
+			p.Requested++
+		case StatusInProgress:
+			p.InProgress++
+		case StatusCompleted:
+			p.Completed++
+		case StatusFailed:
+			p.Failed++
+		}
+
+		if a.Status.IsTerminal() {
+			done += 100
+		} else {
+			done += a.Progress
+		}
+	}
+
+	p.Total = len(analyses)
+	p.Percentage = 100
+	if p.Total > 0 {
+		p.Percentage = done / p.Total
+	}
+
+	return p
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrAnalysisNotFound = errors.New("analysis not found")
+	ErrInvalidURL       = errors.New("invalid url")
+	ErrInvalidOptions   = errors.New("invalid options")
+	// ErrServiceUnavailable is returned when an analysis cannot be accepted right now.
+	ErrServiceUnavailable = errors.New("service unavailable")
+	// ErrInvalidTransition is returned when an update would move an analysis to a status
+	// that cannot follow its current one, e.g. restarting a completed analysis.
+	ErrInvalidTransition = errors.New("invalid status transition")
+	// ErrNotCrawl is returned when listing the pages of an analysis that is not a crawl.
+	ErrNotCrawl = errors.New("analysis is not a crawl")
+	// ErrBatchNotFound is returned when no batch of the tenant has the given ID.
+	ErrBatchNotFound = errors.New("batch not found")
+	// ErrInvalidSitemap is returned when the sitemap of a batch cannot be read, or lists no page.
+	ErrInvalidSitemap = errors.New("invalid sitemap")
+	// ErrBlockedTarget is returned when a URL read on submission, such as the sitemap of a
+	// batch, targets a host the analyzer must not fetch.
+	ErrBlockedTarget = errors.New("blocked target")
+)
+
+// Error codes reported in AnalysisError.Error.
+const (
+	ErrCodePageUnreachable = "page_unreachable"
+	ErrCodeForbiddenAccess = "forbidden_access"
+	ErrCodePageNotFound    = "page_not_found"
+	ErrCodeUpstreamError   = "upstream_error"
+	ErrCodeInvalidContent  = "invalid_content"
+	ErrCodeTimeout         = "timeout"
+	ErrCodeInternal        = "internal_error"
+	// ErrCodeBlockedTarget is reported for URLs the analyzer must not fetch: non-HTTP schemes
+	// and hosts resolving to private, loopback, link-local or otherwise non-public addresses.
+	ErrCodeBlockedTarget    = "blocked_target"
+	ErrCodeTooManyRedirects = "too_many_redirects"
+	ErrCodePageTooLarge     = "page_too_large"
+	// ErrCodeRenderFailed is reported when the headless browser could not render the page.
+	ErrCodeRenderFailed = "render_failed"
+)
+
+// AnalysisError describes why an analysis failed.
+type AnalysisError struct {
+	Code       string `json:"error"`
+	Message    string `json:"error_message"`
+	StatusCode int    `json:"http_status_code"`
+	Details    string `json:"details,omitempty"`
+}
+
+func (e *AnalysisError) Error() string {
+	if e.Details != "" {
+		return fmt.Sprintf("%s: %s (%s)", e.Code, e.Message, e.Details)
+	}
+
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// NewAnalysisError creates an AnalysisError with the given code and message.
+func NewAnalysisError(code, message string, statusCode int, details string) *AnalysisError {
+	return &AnalysisError{
+		Code:       code,
+		Message:    message,
+		StatusCode: statusCode,
+		Details:    details,
+	}
+}
+
+// AsAnalysisError converts any error into an AnalysisError, falling back to an internal error.
+func AsAnalysisError(err error) *AnalysisError {
+	var analysisErr *AnalysisError
+	if errors.As(err, &analysisErr) {
+		return analysisErr
+	}
+
+	return NewAnalysisError(ErrCodeInternal, "The analysis could not be completed", 0, err.Error())
+}
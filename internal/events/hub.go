@@ -0,0 +1,331 @@
+// Package events distributes the progress events of analyses, and of the batches they were
+// submitted in, from the workers running them to the Server-Sent Events streams following them.
+// Batches have streams of their own, identified by the batch ID.
+package events
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultBufferSize  = 64
+	defaultRetention   = 5 * time.Minute
+	defaultIdleTimeout = 30 * time.Minute
+)
+
+// Event is a published message, numbered per analysis from 1.
+type Event struct {
+	ID   uint64
+	Type string
+	Data json.RawMessage
+}
+
+// Terminal reports whether e ends the stream of its analysis.
+func (e Event) Terminal() bool {
+	return e.Type == TypeCompleted || e.Type == TypeError
+}
+
+// Hub fans the events of each analysis out to its subscribers. The latest events of every
+// analysis are buffered, so that a subscriber reconnecting with the ID of the last event it
+// received can catch up, and finished analyses are kept for a while so that late subscribers
+// receive their outcome at once.
+//
+// The hub lives in process: subscribers only see the events of the analyses run by the same
+// instance. Streams left without events for the idle timeout, such as those of analyses
+// finished by another instance, are dropped and their subscribers disconnected.
+type Hub struct {
+	bufferSize  int
+	retention   time.Duration
+	idleTimeout time.Duration
+	now         func() time.Time
+
+	mu      sync.Mutex
+	streams map[uuid.UUID]*stream
+	// waiting holds the subscribers of the analyses without events yet.
+	waiting   map[uuid.UUID]map[*Subscription]struct{}
+	lastSweep time.Time
+}
+
+type stream struct {
+	events      []Event
+	lastID      uint64
+	finished    bool
+	lastActive  time.Time
+	subscribers map[*Subscription]struct{}
+}
+
+// expired reports whether the stream is to be dropped at now.
+func (s *stream) expired(now time.Time, retention, idleTimeout time.Duration) bool {
+	if s.finished {
+		return now.Sub(s.lastActive) >= retention
+	}
+
+	return now.Sub(s.lastActive) >= idleTimeout
+}
+
+// Option configures a Hub.
+type Option func(*Hub)
+
+// WithBufferSize sets the number of events kept per analysis for replay. It also bounds how
+// far a subscriber may fall behind before it is disconnected.
+func WithBufferSize(size int) Option {
+	return func(h *Hub) {
+		if size > 0 {
+			h.bufferSize = size
+		}
+	}
+}
+
+// WithRetention sets how long the events of a finished analysis are kept.
+func WithRetention(retention time.Duration) Option {
+	return func(h *Hub) {
+		if retention > 0 {
+			h.retention = retention
+		}
+	}
+}
+
+// WithIdleTimeout sets how long the stream of an unfinished analysis is kept without events.
+func WithIdleTimeout(timeout time.Duration) Option {
+	return func(h *Hub) {
+		if timeout > 0 {
+			h.idleTimeout = timeout
+		}
+	}
+}
+
+// NewHub creates an empty Hub.
+func NewHub(opts ...Option) *Hub {
+	h := &Hub{
+		bufferSize:  defaultBufferSize,
+		retention:   defaultRetention,
+		idleTimeout: defaultIdleTimeout,
+		now:         time.Now,
+		streams:     make(map[uuid.UUID]*stream),
+		waiting:     make(map[uuid.UUID]map[*Subscription]struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// Publish records msg as the next event of the analysis and delivers it to the subscribers.
+// Completed and error events finish the stream: the subscriptions end once they are delivered
+// and later events are dropped.
+func (h *Hub) Publish(id uuid.UUID, msg Message) {
+	data, err := json.Marshal(msg.Data)
+	if err != nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	h.sweep(now)
+
+	s, ok := h.lookup(id, now)
+	if !ok {
+		s = &stream{subscribers: make(map[*Subscription]struct{})}
+		for sub := range h.waiting[id] {
+			s.subscribers[sub] = struct{}{}
+		}
+
+		delete(h.waiting, id)
+		h.streams[id] = s
+	}
+
+	if s.finished {
+		return
+	}
+
+	s.lastActive = now
+	s.lastID++
+	e := Event{ID: s.lastID, Type: msg.Type, Data: data}
+
+	s.events = append(s.events, e)
+	if len(s.events) > h.bufferSize {
+		s.events = s.events[len(s.events)-h.bufferSize:]
+	}
+
+	for sub := range s.subscribers {
+		select {
+		case sub.ch <- e:
+		default:
+			// The subscriber fell behind the buffer; it has to reconnect and replay.
+			sub.lagged = true
+			h.unsubscribe(sub)
+		}
+	}
+
+	if e.Terminal() {
+		s.finished = true
+
+		for sub := range s.subscribers {
+			h.unsubscribe(sub)
+		}
+	}
+}
+
+// Subscribe returns the buffered events of the analysis published after lastEventID, zero
+// meaning all of them, and a subscription delivering the following ones. The subscription is
+// nil when the analysis finished, in which case the replay ends with its outcome.
+func (h *Hub) Subscribe(id uuid.UUID, lastEventID uint64) ([]Event, *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	h.sweep(now)
+
+	sub := &Subscription{
+		hub: h,
+		id:  id,
+		ch:  make(chan Event, h.bufferSize),
+	}
+
+	s, ok := h.lookup(id, now)
+	if !ok {
+		// The stream is created by the first event; until then the subscription waits aside, so
+		// that subscribing to unknown analyses leaves nothing behind once it is closed.
+		if h.waiting[id] == nil {
+			h.waiting[id] = make(map[*Subscription]struct{})
+		}
+
+		h.waiting[id][sub] = struct{}{}
+
+		return nil, sub
+	}
+
+	var replay []Event
+	for _, e := range s.events {
+		if e.ID > lastEventID {
+			replay = append(replay, e)
+		}
+	}
+
+	if s.finished {
+		return replay, nil
+	}
+
+	s.subscribers[sub] = struct{}{}
+
+	return replay, sub
+}
+
+// Finished reports whether the hub holds the outcome of the analysis.
+func (h *Hub) Finished(id uuid.UUID) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s, ok := h.lookup(id, h.now())
+
+	return ok && s.finished
+}
+
+// lookup returns the stream of the analysis, dropping it if it expired. h.mu must be held.
+func (h *Hub) lookup(id uuid.UUID, now time.Time) (*stream, bool) {
+	s, ok := h.streams[id]
+	if !ok {
+		return nil, false
+	}
+
+	if s.expired(now, h.retention, h.idleTimeout) {
+		h.drop(id, s)
+
+		return nil, false
+	}
+
+	return s, true
+}
+
+// sweep drops the expired streams, at most once per retention or idle timeout, whichever is
+// shorter. h.mu must be held.
+func (h *Hub) sweep(now time.Time) {
+	if now.Sub(h.lastSweep) < min(h.retention, h.idleTimeout) {
+		return
+	}
+
+	h.lastSweep = now
+
+	for id, s := range h.streams {
+		if s.expired(now, h.retention, h.idleTimeout) {
+			h.drop(id, s)
+		}
+	}
+}
+
+// drop removes the stream and disconnects its subscribers. h.mu must be held.
+func (h *Hub) drop(id uuid.UUID, s *stream) {
+	for sub := range s.subscribers {
+		h.unsubscribe(sub)
+	}
+
+	delete(h.streams, id)
+}
+
+// unsubscribe ends sub, whether it follows a stream or waits for its first event. h.mu must
+// be held.
+func (h *Hub) unsubscribe(sub *Subscription) {
+	if s, ok := h.streams[sub.id]; ok {
+		if _, ok := s.subscribers[sub]; ok {
+			delete(s.subscribers, sub)
+			close(sub.ch)
+
+			return
+		}
+	}
+
+	waiting, ok := h.waiting[sub.id]
+	if !ok {
+		return
+	}
+
+	if _, ok := waiting[sub]; !ok {
+		return
+	}
+
+	delete(waiting, sub)
+	close(sub.ch)
+
+	if len(waiting) == 0 {
+		delete(h.waiting, sub.id)
+	}
+}
+
+// Subscription delivers the events of an analysis.
+type Subscription struct {
+	hub    *Hub
+	id     uuid.UUID
+	ch     chan Event
+	lagged bool
+}
+
+// Events returns the channel the events are delivered on. It is closed once the analysis
+// finished, the subscriber fell behind, the stream expired or Close was called.
+func (s *Subscription) Events() <-chan Event {
+	return s.ch
+}
+
+// Lagged reports whether the subscription ended because the subscriber fell behind. It is
+// only meaningful once the events channel is closed.
+func (s *Subscription) Lagged() bool {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	return s.lagged
+}
+
+// Close ends the subscription.
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	s.hub.unsubscribe(s)
+}
@@ -0,0 +1,258 @@
+package events
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// clock is a settable time source for the expiry of the streams.
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func newTestHub(opts ...Option) (*Hub, *clock) {
+	c := &clock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	h := NewHub(opts...)
+	h.now = c.Now
+
+	return h, c
+}
+
+func eventIDs(events []Event) []uint64 {
+	ids := make([]uint64, 0, len(events))
+	for _, e := range events {
+		ids = append(ids, e.ID)
+	}
+
+	return ids
+}
+
+// drain returns the events delivered until the subscription ends.
+func drain(t *testing.T, sub *Subscription) []Event {
+	t.Helper()
+
+	var events []Event
+
+	timeout := time.After(time.Second)
+
+	for {
+		select {
+		case e, ok := <-sub.Events():
+			if !ok {
+				return events
+			}
+
+			events = append(events, e)
+		case <-timeout:
+			t.Fatal("the subscription did not end")
+		}
+	}
+}
+
+func TestHubSubscribeReplay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		bufferSize  int
+		published   int
+		lastEventID uint64
+		want        []uint64
+	}{
+		{name: "from the start", bufferSize: 10, published: 3, lastEventID: 0, want: []uint64{1, 2, 3}},
+		{name: "after the last event received", bufferSize: 10, published: 5, lastEventID: 2, want: []uint64{3, 4, 5}},
+		{name: "up to date", bufferSize: 10, published: 3, lastEventID: 3, want: []uint64{}},
+		{name: "ahead of the stream", bufferSize: 10, published: 3, lastEventID: 7, want: []uint64{}},
+		{name: "trimmed to the buffer", bufferSize: 3, published: 6, lastEventID: 0, want: []uint64{4, 5, 6}},
+		{name: "behind the buffer", bufferSize: 3, published: 6, lastEventID: 1, want: []uint64{4, 5, 6}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, _ := newTestHub(WithBufferSize(tt.bufferSize))
+			id := uuid.New()
+
+			for i := range tt.published {
+				h.Publish(id, Progress("fetching", i, ""))
+			}
+
+			replay, sub := h.Subscribe(id, tt.lastEventID)
+			if sub == nil {
+				t.Fatal("Subscribe() subscription = nil, want one for an unfinished analysis")
+			}
+			defer sub.Close()
+
+			if got := eventIDs(replay); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Subscribe(%d) replay = %v, want %v", tt.lastEventID, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHubDeliversUntilTerminal(t *testing.T) {
+	t.Parallel()
+
+	h, _ := newTestHub()
+	id := uuid.New()
+
+	// Subscribers may arrive before the first event of the analysis.
+	replay, sub := h.Subscribe(id, 0)
+	if len(replay) != 0 || sub == nil {
+		t.Fatalf("Subscribe() = %v, %v, want no replay and a subscription", replay, sub)
+	}
+
+	h.Publish(id, Started(id))
+	h.Publish(id, Progress("fetching", 10, ""))
+	h.Publish(id, Message{Type: TypeCompleted, Data: map[string]any{}})
+	h.Publish(id, Progress("late", 100, ""))
+
+	events := drain(t, sub)
+
+	var types []string
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+
+	if want := []string{TypeStarted, TypeProgress, TypeCompleted}; !reflect.DeepEqual(types, want) {
+		t.Errorf("delivered %v, want %v", types, want)
+	}
+
+	if sub.Lagged() {
+		t.Error("Lagged() = true, want false once the analysis finished")
+	}
+
+	if !h.Finished(id) {
+		t.Error("Finished() = false, want true")
+	}
+
+	replay, sub = h.Subscribe(id, 2)
+	if sub != nil {
+		t.Error("Subscribe() to a finished analysis returned a subscription, want nil")
+	}
+
+	if got := eventIDs(replay); !reflect.DeepEqual(got, []uint64{3}) {
+		t.Errorf("Subscribe() replay = %v, want the outcome", got)
+	}
+}
+
+func TestHubDisconnectsLaggedSubscribers(t *testing.T) {
+	t.Parallel()
+
+	h, _ := newTestHub(WithBufferSize(2))
+	id := uuid.New()
+
+	h.Publish(id, Started(id))
+
+	_, slow := h.Subscribe(id, 1)
+	_, fast := h.Subscribe(id, 1)
+	defer fast.Close()
+
+	for i := range 3 {
+		h.Publish(id, Progress("fetching", i, ""))
+		<-fast.Events()
+	}
+
+	if got := len(drain(t, slow)); got != 2 {
+		t.Errorf("lagged subscriber received %d events, want the 2 it had room for", got)
+	}
+
+	if !slow.Lagged() {
+		t.Error("Lagged() = false, want true")
+	}
+
+	if fast.Lagged() {
+		t.Error("Lagged() of the subscriber keeping up = true, want false")
+	}
+}
+
+func TestHubSubscribeLeavesNoStream(t *testing.T) {
+	t.Parallel()
+
+	h, _ := newTestHub()
+
+	_, first := h.Subscribe(uuid.New(), 0)
+	_, second := h.Subscribe(uuid.New(), 42)
+
+	first.Close()
+	second.Close()
+	second.Close()
+
+	if len(h.streams) != 0 || len(h.waiting) != 0 {
+		t.Errorf("hub holds %d streams and %d waiting analyses, want none", len(h.streams), len(h.waiting))
+	}
+
+	if _, ok := <-first.Events(); ok {
+		t.Error("Events() delivered after Close, want the channel closed")
+	}
+}
+
+func TestHubExpiresStreams(t *testing.T) {
+	t.Parallel()
+
+	const (
+		retention   = time.Minute
+		idleTimeout = 10 * time.Minute
+	)
+
+	h, c := newTestHub(WithRetention(retention), WithIdleTimeout(idleTimeout))
+
+	finished, running, idle := uuid.New(), uuid.New(), uuid.New()
+
+	h.Publish(finished, Started(finished))
+	h.Publish(finished, Message{Type: TypeError, Data: map[string]any{}})
+	h.Publish(running, Started(running))
+	h.Publish(idle, Started(idle))
+
+	_, sub := h.Subscribe(idle, 0)
+
+	c.Advance(retention)
+
+	if h.Finished(finished) {
+		t.Error("Finished() after the retention = true, want the outcome dropped")
+	}
+
+	c.Advance(idleTimeout - retention - time.Second)
+	h.Publish(running, Progress("fetching", 50, ""))
+	c.Advance(retention)
+
+	// Any call sweeps the streams that expired, at most once per retention.
+	h.Publish(uuid.New(), Started(uuid.New()))
+
+	if _, ok := h.streams[idle]; ok {
+		t.Error("the idle stream was kept, want it dropped")
+	}
+
+	if _, ok := h.streams[running]; !ok {
+		t.Error("the stream with recent events was dropped, want it kept")
+	}
+
+	if got := len(drain(t, sub)); got != 0 {
+		t.Errorf("subscriber of the idle stream received %d events, want 0", got)
+	}
+
+	if sub.Lagged() {
+		t.Error("Lagged() of an expired subscription = true, want false")
+	}
+}
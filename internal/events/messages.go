@@ -0,0 +1,138 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+// Event types, as documented for GET /v1/analysis/{analysisId}/events.
+const (
+	TypeStarted       = "started"
+	TypeProgress      = "progress"
+	TypeStepCompleted = "step_completed"
+	TypeCompleted     = "completed"
+	TypeError         = "error"
+)
+
+// TypeItemFinished is the type of the events of the batch streams announcing that an analysis of
+// the batch finished, as documented for GET /v1/batches/{batchId}/events. The streams end with a
+// TypeCompleted event once all of them did.
+const TypeItemFinished = "item_finished"
+
+// Message is an event to publish.
+type Message struct {
+	Type string
+	Data any
+}
+
+// Started announces that an attempt to run the analysis began.
+func Started(id uuid.UUID) Message {
+	return started(id, time.Now().UTC())
+}
+
+func started(id uuid.UUID, at time.Time) Message {
+	return Message{Type: TypeStarted, Data: map[string]any{
+		"analysis_id": id,
+		"status":      "started",
+		"timestamp":   at,
+	}}
+}
+
+// Progress announces that the analysis moved to a new step.
+func Progress(step string, progress int, message string) Message {
+	data := map[string]any{
+		"step":      step,
+		"progress":  progress,
+		"timestamp": time.Now().UTC(),
+	}
+
+	if message != "" {
+		data["message"] = message
+	}
+
+	return Message{Type: TypeProgress, Data: data}
+}
+
+// StepCompleted announces that an analyzer finished, with the result section it contributed.
+func StepCompleted(step domain.Step) Message {
+	return Message{Type: TypeStepCompleted, Data: map[string]any{
+		"step":      step.Name,
+		"progress":  step.Progress,
+		"results":   step.Results,
+		"timestamp": step.CompletedAt,
+	}}
+}
+
+// Outcome announces that the analysis completed or failed.
+func Outcome(a *domain.Analysis) Message {
+	data := map[string]any{
+		"analysis_id": a.ID,
+		"status":      a.Status,
+		"timestamp":   time.Now().UTC(),
+	}
+
+	if a.CompletedAt != nil {
+		data["timestamp"] = *a.CompletedAt
+	}
+
+	if a.Status == domain.StatusCompleted {
+		return Message{Type: TypeCompleted, Data: data}
+	}
+
+	if a.Error != nil {
+		data["error"] = a.Error.Code
+		data["message"] = a.Error.Message
+	}
+
+	return Message{Type: TypeError, Data: data}
+}
+
+// Snapshot rebuilds the events of a finished analysis from its stored state, for subscribers
+// of analyses whose events are no longer, or were never, held by the hub.
+func Snapshot(a *domain.Analysis) []Message {
+	messages := make([]Message, 0, len(a.Steps)+2)
+	startedAt := a.CreatedAt
+	if a.StartedAt != nil {
+		startedAt = *a.StartedAt
+	}
+
+	messages = append(messages, started(a.ID, startedAt))
+
+	for _, step := range a.Steps {
+		messages = append(messages, StepCompleted(step))
+	}
+
+	return append(messages, Outcome(a))
+}
+
+// ItemFinished announces that an analysis of the batch completed or failed, along with the
+// progress of the batch.
+func ItemFinished(b *domain.Batch, a *domain.Analysis) Message {
+	data := map[string]any{
+		"batch_id":    b.ID,
+		"analysis_id": a.ID,
+		"url":         a.URL,
+		"status":      a.Status,
+		"progress":    b.Progress,
+		"timestamp":   time.Now().UTC(),
+	}
+
+	if a.Error != nil {
+		data["error"] = a.Error.Code
+	}
+
+	return Message{Type: TypeItemFinished, Data: data}
+}
+
+// BatchCompleted announces that all the analyses of the batch finished.
+func BatchCompleted(b *domain.Batch) Message {
+	return Message{Type: TypeCompleted, Data: map[string]any{
+		"batch_id":  b.ID,
+		"status":    domain.StatusCompleted,
+		"progress":  b.Progress,
+		"timestamp": time.Now().UTC(),
+	}}
+}
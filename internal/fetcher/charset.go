@@ -0,0 +1,190 @@
+package fetcher
+
+import (
+	"bytes"
+	"mime"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/charset"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+// prescanLength is how far into a page its <meta> charset declaration is looked for, as
+// browsers do.
+const prescanLength = 1024
+
+type byteOrderMark struct {
+	bom      []byte
+	charset  string
+	encoding encoding.Encoding
+}
+
+var byteOrderMarks = []byteOrderMark{
+	{[]byte{0xEF, 0xBB, 0xBF}, "utf-8", unicode.UTF8},
+	{[]byte{0xFE, 0xFF}, "utf-16be", unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)},
+	{[]byte{0xFF, 0xFE}, "utf-16le", unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)},
+}
+
+// decode transcodes body to UTF-8. Its encoding is taken from, in order, its byte order mark,
+// the charset of contentType and its <meta> declaration; pages declaring none are read as
+// UTF-8 when they are valid UTF-8, as windows-1252 otherwise. Unknown charsets are ignored.
+func decode(body []byte, contentType string) ([]byte, *domain.Encoding, error) {
+	enc := &domain.Encoding{
+		HeaderCharset: headerCharset(contentType),
+		MetaCharset:   metaCharset(body),
+	}
+
+	headerEncoding, headerName := charset.Lookup(enc.HeaderCharset)
+	metaEncoding, metaName := lookupMeta(enc.MetaCharset)
+
+	// The declarations are compared as written, before a UTF-16 one of the page is read as
+	// UTF-8.
+	declaredEncoding, declaredName := charset.Lookup(enc.MetaCharset)
+	enc.Mismatch = headerEncoding != nil && declaredEncoding != nil && headerName != declaredName
+
+	var e encoding.Encoding
+
+	mark, hasBOM := findBOM(body)
+
+	switch {
+	case hasBOM:
+		body = body[len(mark.bom):]
+		e, enc.Charset, enc.Source = mark.encoding, mark.charset, domain.EncodingSourceBOM
+	case headerEncoding != nil:
+		e, enc.Charset, enc.Source = headerEncoding, headerName, domain.EncodingSourceHeader
+	case metaEncoding != nil:
+		e, enc.Charset, enc.Source = metaEncoding, metaName, domain.EncodingSourceMeta
+	case utf8.Valid(body):
+		e, enc.Charset, enc.Source = unicode.UTF8, "utf-8", domain.EncodingSourceDefault
+	default:
+		e, enc.Charset, enc.Source = charmap.Windows1252, "windows-1252", domain.EncodingSourceDefault
+	}
+
+	// Valid UTF-8 is passed as is, sparing a copy of the page.
+	if enc.Charset == "utf-8" && utf8.Valid(body) {
+		return body, enc, nil
+	}
+
+	decoded, err := e.NewDecoder().Bytes(body)
+	if err != nil {
+		return nil, enc, err
+	}
+
+	return decoded, enc, nil
+}
+
+func findBOM(body []byte) (byteOrderMark, bool) {
+	for _, mark := range byteOrderMarks {
+		if bytes.HasPrefix(body, mark.bom) {
+			return mark, true
+		}
+	}
+
+	return byteOrderMark{}, false
+}
+
+func headerCharset(contentType string) string {
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(params["charset"])
+}
+
+// lookupMeta returns the encoding of a charset declared by a <meta> element. Pages declaring
+// UTF-16 there cannot have been parsed to find the declaration, and are read as UTF-8.
+func lookupMeta(label string) (encoding.Encoding, string) {
+	e, name := charset.Lookup(label)
+	if e != nil && strings.HasPrefix(name, "utf-16") {
+		return unicode.UTF8, "utf-8"
+	}
+
+	return e, name
+}
+
+// metaCharset returns the charset declared by the first <meta charset> or
+// <meta http-equiv="Content-Type"> element found within the first bytes of body.
+func metaCharset(body []byte) string {
+	if len(body) > prescanLength {
+		body = body[:prescanLength]
+	}
+
+	z := html.NewTokenizer(bytes.NewReader(body))
+
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return ""
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			if string(name) != "meta" {
+				continue
+			}
+
+			var (
+				pragma                bool
+				declared, fromContent string
+			)
+
+			for hasAttr {
+				var key, val []byte
+				key, val, hasAttr = z.TagAttr()
+
+				switch string(key) {
+				case "charset":
+					declared = strings.TrimSpace(string(val))
+				case "http-equiv":
+					pragma = strings.EqualFold(strings.TrimSpace(string(val)), "content-type")
+				case "content":
+					fromContent = contentCharset(string(val))
+				}
+			}
+
+			// The content of a <meta> element only declares the charset along with
+			// http-equiv="Content-Type".
+			if declared == "" && pragma {
+				declared = fromContent
+			}
+
+			if declared != "" {
+				return declared
+			}
+		}
+	}
+}
+
+// contentCharset returns the charset parameter of the content of a
+// <meta http-equiv="Content-Type"> element, such as "text/html; charset=Shift_JIS".
+func contentCharset(content string) string {
+	i := strings.Index(strings.ToLower(content), "charset")
+	if i < 0 {
+		return ""
+	}
+
+	value, ok := strings.CutPrefix(strings.TrimLeft(content[i+len("charset"):], " \t\n\f\r"), "=")
+	if !ok {
+		return ""
+	}
+
+	value = strings.TrimLeft(value, " \t\n\f\r")
+	if value != "" && (value[0] == '"' || value[0] == '\'') {
+		if end := strings.IndexByte(value[1:], value[0]); end >= 0 {
+			return value[1 : end+1]
+		}
+
+		return ""
+	}
+
+	if end := strings.IndexAny(value, " \t\n\f\r;"); end >= 0 {
+		value = value[:end]
+	}
+
+	return value
+}
@@ -0,0 +1,223 @@
+package fetcher
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/unicode"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+func encode(t *testing.T, e encoding.Encoding, s string) []byte {
+	t.Helper()
+
+	encoded, err := e.NewEncoder().Bytes([]byte(s))
+	if err != nil {
+		t.Fatalf("encoding %q: %v", s, err)
+	}
+
+	return encoded
+}
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	utf16LE := unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)
+	utf16BE := unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)
+
+	// padding pushes what follows past the prescan.
+	padding := "<!--" + strings.Repeat("x", prescanLength) + "-->"
+
+	tests := []struct {
+		name        string
+		body        []byte
+		contentType string
+		want        domain.Encoding
+		// wantText is found in the decoded page, unless empty.
+		wantText string
+	}{
+		{
+			name:        "Shift_JIS header",
+			body:        encode(t, japanese.ShiftJIS, "<p>日本語</p>"),
+			contentType: "text/html; charset=Shift_JIS",
+			want:        domain.Encoding{Charset: "shift_jis", Source: domain.EncodingSourceHeader, HeaderCharset: "Shift_JIS"},
+			wantText:    "日本語",
+		},
+		{
+			name:        "Shift_JIS http-equiv",
+			body:        encode(t, japanese.ShiftJIS, `<meta http-equiv="Content-Type" content="text/html; charset=Shift_JIS"><p>日本語</p>`),
+			contentType: "text/html",
+			want:        domain.Encoding{Charset: "shift_jis", Source: domain.EncodingSourceMeta, MetaCharset: "Shift_JIS"},
+			wantText:    "日本語",
+		},
+		{
+			name:        "windows-1251 meta",
+			body:        encode(t, charmap.Windows1251, `<meta charset="windows-1251"><p>Привет</p>`),
+			contentType: "text/html",
+			want:        domain.Encoding{Charset: "windows-1251", Source: domain.EncodingSourceMeta, MetaCharset: "windows-1251"},
+			wantText:    "Привет",
+		},
+		{
+			name:        "ISO-8859-2 header",
+			body:        encode(t, charmap.ISO8859_2, "<p>Zażółć</p>"),
+			contentType: "text/html; charset=ISO-8859-2",
+			want:        domain.Encoding{Charset: "iso-8859-2", Source: domain.EncodingSourceHeader, HeaderCharset: "ISO-8859-2"},
+			wantText:    "Zażółć",
+		},
+		{
+			name:        "UTF-8 BOM over the header",
+			body:        append([]byte{0xEF, 0xBB, 0xBF}, "<p>café</p>"...),
+			contentType: "text/html; charset=windows-1252",
+			want:        domain.Encoding{Charset: "utf-8", Source: domain.EncodingSourceBOM, HeaderCharset: "windows-1252"},
+			wantText:    "<p>café</p>",
+		},
+		{
+			name:     "UTF-16LE BOM",
+			body:     append([]byte{0xFF, 0xFE}, encode(t, utf16LE, "<p>café</p>")...),
+			want:     domain.Encoding{Charset: "utf-16le", Source: domain.EncodingSourceBOM},
+			wantText: "<p>café</p>",
+		},
+		{
+			name:     "UTF-16BE BOM",
+			body:     append([]byte{0xFE, 0xFF}, encode(t, utf16BE, "<p>café</p>")...),
+			want:     domain.Encoding{Charset: "utf-16be", Source: domain.EncodingSourceBOM},
+			wantText: "<p>café</p>",
+		},
+		{
+			name:        "header over meta",
+			body:        encode(t, charmap.Windows1251, `<meta charset="iso-8859-2"><p>Привет</p>`),
+			contentType: "text/html; charset=windows-1251",
+			want: domain.Encoding{
+				Charset:       "windows-1251",
+				Source:        domain.EncodingSourceHeader,
+				HeaderCharset: "windows-1251",
+				MetaCharset:   "iso-8859-2",
+				Mismatch:      true,
+			},
+			wantText: "Привет",
+		},
+		{
+			name:        "header and meta agreeing",
+			body:        []byte(`<meta charset="UTF-8"><p>café</p>`),
+			contentType: "text/html; charset=utf-8",
+			want:        domain.Encoding{Charset: "utf-8", Source: domain.EncodingSourceHeader, HeaderCharset: "utf-8", MetaCharset: "UTF-8"},
+			wantText:    "café",
+		},
+		{
+			name:        "header and meta both UTF-16",
+			body:        []byte(`<meta charset="utf-16">`),
+			contentType: "text/html; charset=utf-16",
+			want:        domain.Encoding{Charset: "utf-16le", Source: domain.EncodingSourceHeader, HeaderCharset: "utf-16", MetaCharset: "utf-16"},
+		},
+		{
+			name:     "UTF-16 meta read as UTF-8",
+			body:     []byte(`<meta charset="utf-16"><p>café</p>`),
+			want:     domain.Encoding{Charset: "utf-8", Source: domain.EncodingSourceMeta, MetaCharset: "utf-16"},
+			wantText: "café",
+		},
+		{
+			name:     "meta past the prescan",
+			body:     encode(t, charmap.Windows1251, padding+`<meta charset="windows-1251"><p>Привет</p>`),
+			want:     domain.Encoding{Charset: "windows-1252", Source: domain.EncodingSourceDefault},
+			wantText: "Ïðèâåò",
+		},
+		{
+			name:        "unknown header charset",
+			body:        []byte("<p>café</p>"),
+			contentType: "text/html; charset=x-unknown",
+			want:        domain.Encoding{Charset: "utf-8", Source: domain.EncodingSourceDefault, HeaderCharset: "x-unknown"},
+			wantText:    "café",
+		},
+		{
+			name:     "UTF-8 default",
+			body:     []byte("<p>café</p>"),
+			want:     domain.Encoding{Charset: "utf-8", Source: domain.EncodingSourceDefault},
+			wantText: "café",
+		},
+		{
+			name:     "windows-1252 fallback",
+			body:     []byte("<p>caf\xe9 \x80</p>"),
+			want:     domain.Encoding{Charset: "windows-1252", Source: domain.EncodingSourceDefault},
+			wantText: "café €",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			decoded, enc, err := decode(tt.body, tt.contentType)
+			if err != nil {
+				t.Fatalf("decode() error = %v", err)
+			}
+
+			if *enc != tt.want {
+				t.Errorf("decode() encoding = %+v, want %+v", *enc, tt.want)
+			}
+
+			if !strings.Contains(string(decoded), tt.wantText) {
+				t.Errorf("decode() = %q, want it to contain %q", decoded, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestMetaCharset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "charset", body: `<head><meta charset=" Shift_JIS ">`, want: "Shift_JIS"},
+		{name: "http-equiv", body: `<meta http-equiv="content-type" content="text/html; charset=koi8-r">`, want: "koi8-r"},
+		{name: "content without http-equiv", body: `<meta name="x" content="text/html; charset=koi8-r">`, want: ""},
+		{name: "first declaration", body: `<meta charset="utf-8"><meta charset="koi8-r">`, want: "utf-8"},
+		{name: "none", body: `<title>charset=koi8-r</title>`, want: ""},
+		{name: "within the prescan", body: strings.Repeat(" ", prescanLength-len(`<meta charset="koi8-r">`)) + `<meta charset="koi8-r">`, want: "koi8-r"},
+		{name: "past the prescan", body: strings.Repeat(" ", prescanLength) + `<meta charset="koi8-r">`, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := metaCharset([]byte(tt.body)); got != tt.want {
+				t.Errorf("metaCharset(%q) = %q, want %q", tt.body, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContentCharset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		content string
+		want    string
+	}{
+		{content: "text/html; charset=Shift_JIS", want: "Shift_JIS"},
+		{content: "text/html;charset=utf-8; level=1", want: "utf-8"},
+		{content: "text/html; CHARSET = windows-1251", want: "windows-1251"},
+		{content: `text/html; charset="iso-8859-2"`, want: "iso-8859-2"},
+		{content: "text/html; charset='koi8-r'", want: "koi8-r"},
+		{content: `text/html; charset="unterminated`, want: ""},
+		{content: "text/html; charset", want: ""},
+		{content: "text/html", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.content, func(t *testing.T) {
+			t.Parallel()
+
+			if got := contentCharset(tt.content); got != tt.want {
+				t.Errorf("contentCharset(%q) = %q, want %q", tt.content, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+// Package fetcher retrieves the web pages submitted for analysis.
+package fetcher
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/netguard"
+)
+
+const (
+	defaultMaxBodySize     = 10 << 20
+	defaultMaxResponseSize = 10 << 20
+	defaultMaxRedirects    = 5
+	defaultUserAgent       = "web-analyzer/1.0 (+https://github.com/architeacher/svc-web-analyzer)"
+)
+
+var errTooLarge = errors.New("size limit exceeded")
+
+// Response is a fetched page.
+type Response struct {
+	// URL is the final URL after following redirects.
+	URL         *url.URL
+	StatusCode  int
+	Header      http.Header
+	ContentType string
+	// Body is the page transcoded to UTF-8, from Encoding.
+	Body     []byte
+	Encoding *domain.Encoding
+}
+
+// Fetcher retrieves a page by URL.
+type Fetcher interface {
+	Fetch(ctx context.Context, rawURL string) (*Response, error)
+}
+
+// Option configures an HTTPFetcher.
+type Option func(*HTTPFetcher)
+
+// WithClient overrides the HTTP client used to fetch pages, along with its protection of the
+// internal network.
+func WithClient(client *http.Client) Option {
+	return func(f *HTTPFetcher) {
+		f.client = client
+	}
+}
+
+// WithGuard sets the guard checking the addresses pages are fetched from, on every redirect.
+// By default, only public addresses are reachable.
+func WithGuard(guard *netguard.Guard) Option {
+	return func(f *HTTPFetcher) {
+		f.guard = guard
+	}
+}
+
+// WithMaxBodySize limits the size of a response body, once decompressed.
+func WithMaxBodySize(size int64) Option {
+	return func(f *HTTPFetcher) {
+		f.maxBodySize = size
+	}
+}
+
+// WithMaxResponseSize limits the number of bytes read off the wire for a response body, before
+// it is decompressed.
+func WithMaxResponseSize(size int64) Option {
+	return func(f *HTTPFetcher) {
+		f.maxResponseSize = size
+	}
+}
+
+// WithMaxRedirects limits the number of redirects followed.
+func WithMaxRedirects(redirects int) Option {
+	return func(f *HTTPFetcher) {
+		if redirects >= 0 {
+			f.maxRedirects = redirects
+		}
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(f *HTTPFetcher) {
+		f.userAgent = userAgent
+	}
+}
+
+// HTTPFetcher fetches pages over HTTP(S). Since the URLs are supplied by the clients, it only
+// reaches public addresses unless its guard allows otherwise, follows a bounded number of
+// redirects to http and https URLs only, and refuses pages exceeding its size limits,
+// compressed or not.
+type HTTPFetcher struct {
+	client          *http.Client
+	guard           *netguard.Guard
+	maxBodySize     int64
+	maxResponseSize int64
+	maxRedirects    int
+	userAgent       string
+}
+
+// New creates an HTTPFetcher.
+func New(opts ...Option) *HTTPFetcher {
+	f := &HTTPFetcher{
+		maxBodySize:     defaultMaxBodySize,
+		maxResponseSize: defaultMaxResponseSize,
+		maxRedirects:    defaultMaxRedirects,
+		userAgent:       defaultUserAgent,
+	}
+
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	if f.client == nil {
+		f.client = netguard.NewClient(f.guard, f.maxRedirects, func(t *http.Transport) {
+			// Bodies are decompressed by Fetch, within the size limit.
+			t.DisableCompression = true
+		})
+	}
+
+	return f
+}
+
+// Fetch retrieves the page and maps transport and HTTP failures to domain errors.
+func (f *HTTPFetcher) Fetch(ctx context.Context, rawURL string) (*Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, domain.NewAnalysisError(domain.ErrCodePageUnreachable, "The URL could not be requested", 0, err.Error())
+	}
+
+	if !allowedScheme(req.URL) {
+		return nil, blockedTarget(netguard.ErrSchemeNotAllowed)
+	}
+
+	req.Header.Set("User-Agent", f.userAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml;q=0.9,*/*;q=0.8")
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		switch {
+		case errors.Is(err, netguard.ErrBlocked), errors.Is(err, netguard.ErrSchemeNotAllowed):
+			return nil, blockedTarget(err)
+		case errors.Is(err, netguard.ErrTooManyRedirects):
+			return nil, domain.NewAnalysisError(domain.ErrCodeTooManyRedirects, "Failed to fetch page: too many redirects", 0, err.Error())
+		case errors.Is(err, context.DeadlineExceeded):
+			return nil, domain.NewAnalysisError(domain.ErrCodeTimeout, "Failed to fetch page: timeout", 0, err.Error())
+		default:
+			return nil, domain.NewAnalysisError(domain.ErrCodePageUnreachable, "Failed to fetch page", 0, err.Error())
+		}
+	}
+	defer resp.Body.Close()
+
+	if err := statusError(resp.StatusCode); err != nil {
+		return nil, err
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if !isHTML(contentType) {
+		return nil, domain.NewAnalysisError(
+			domain.ErrCodeInvalidContent,
+			"The page content could not be parsed",
+			resp.StatusCode,
+			fmt.Sprintf("unsupported content type %q", contentType),
+		)
+	}
+
+	body, err := f.readBody(resp)
+	if err != nil {
+		if errors.Is(err, errTooLarge) {
+			return nil, domain.NewAnalysisError(domain.ErrCodePageTooLarge, "The page exceeds the size limit", resp.StatusCode, err.Error())
+		}
+
+		return nil, domain.NewAnalysisError(domain.ErrCodePageUnreachable, "Failed to read page content", resp.StatusCode, err.Error())
+	}
+
+	body, enc, err := decode(body, contentType)
+	if err != nil {
+		return nil, domain.NewAnalysisError(
+			domain.ErrCodeInvalidContent,
+			"The page content could not be decoded",
+			resp.StatusCode,
+			fmt.Sprintf("decoding the page from %s: %v", enc.Charset, err),
+		)
+	}
+
+	return &Response{
+		URL:         resp.Request.URL,
+		StatusCode:  resp.StatusCode,
+		Header:      resp.Header,
+		ContentType: contentType,
+		Body:        body,
+		Encoding:    enc,
+	}, nil
+}
+
+// readBody reads the body of resp within the size limits, decompressing it when needed.
+func (f *HTTPFetcher) readBody(resp *http.Response) ([]byte, error) {
+	if resp.ContentLength > f.maxResponseSize {
+		return nil, fmt.Errorf("%w: the response is %d bytes long, the limit is %d", errTooLarge, resp.ContentLength, f.maxResponseSize)
+	}
+
+	var body io.Reader = &cappedReader{r: resp.Body, remaining: f.maxResponseSize,
+		err: fmt.Errorf("%w: the response exceeds %d bytes", errTooLarge, f.maxResponseSize)}
+
+	switch encoding := strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding"))); encoding {
+	case "", "identity":
+	case "gzip", "x-gzip":
+		zr, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, fmt.Errorf("decompressing the response: %w", err)
+		}
+		defer zr.Close()
+
+		body = zr
+	default:
+		return nil, fmt.Errorf("unsupported content encoding %q", encoding)
+	}
+
+	return io.ReadAll(&cappedReader{r: body, remaining: f.maxBodySize,
+		err: fmt.Errorf("%w: the page exceeds %d bytes once decompressed", errTooLarge, f.maxBodySize)})
+}
+
+func allowedScheme(u *url.URL) bool {
+	return u.Scheme == "http" || u.Scheme == "https"
+}
+
+func blockedTarget(err error) error {
+	return domain.NewAnalysisError(domain.ErrCodeBlockedTarget, "The URL targets a host that may not be analyzed", 0, err.Error())
+}
+
+// cappedReader reads up to remaining bytes of r, and fails with err if r holds more.
+type cappedReader struct {
+	r         io.Reader
+	remaining int64
+	err       error
+}
+
+func (c *cappedReader) Read(p []byte) (int, error) {
+	if c.remaining <= 0 {
+		// Tell a body of exactly the limit from a longer one.
+		var probe [1]byte
+
+		n, err := c.r.Read(probe[:])
+		if n > 0 {
+			return 0, c.err
+		}
+
+		return 0, err
+	}
+
+	if int64(len(p)) > c.remaining {
+		p = p[:c.remaining]
+	}
+
+	n, err := c.r.Read(p)
+	c.remaining -= int64(n)
+
+	return n, err
+}
+
+func statusError(statusCode int) error {
+	switch {
+	case statusCode < http.StatusBadRequest:
+		return nil
+	case statusCode == http.StatusUnauthorized, statusCode == http.StatusForbidden:
+		return domain.NewAnalysisError(
+			domain.ErrCodeForbiddenAccess,
+			"Access to the requested page is forbidden",
+			statusCode,
+			"The server denied access to the requested resource",
+		)
+	case statusCode == http.StatusNotFound, statusCode == http.StatusGone:
+		return domain.NewAnalysisError(
+			domain.ErrCodePageNotFound,
+			"The requested page does not exist",
+			statusCode,
+			http.StatusText(statusCode),
+		)
+	default:
+		return domain.NewAnalysisError(
+			domain.ErrCodeUpstreamError,
+			"The target server returned an error",
+			statusCode,
+			http.StatusText(statusCode),
+		)
+	}
+}
+
+func isHTML(contentType string) bool {
+	if contentType == "" {
+		// Servers omitting the header are given the benefit of the doubt.
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "text/html" ||
+		mediaType == "application/xhtml+xml" ||
+		strings.HasSuffix(mediaType, "+xml") ||
+		mediaType == "application/xml" ||
+		mediaType == "text/xml"
+}
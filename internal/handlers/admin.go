@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/auth"
+	"github.com/architeacher/svc-web-analyzer/internal/paseto"
+)
+
+const (
+	errCodeAdminDisabled           = "admin_disabled"
+	errCodeSigningKeyNotConfigured = "signing_key_not_configured"
+
+	defaultTokenTTL = time.Hour
+	minTokenTTL     = time.Minute
+)
+
+// GenerateSigningKey generates an Ed25519 key pair for v4.public tokens.
+// (POST /v1/admin/keys)
+func (h *RequestHandler) GenerateSigningKey(w http.ResponseWriter, r *http.Request) {
+	if !h.adminEnabled(w, r) {
+		return
+	}
+
+	public, secret, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, SigningKey{
+		KeyId:     paseto.PublicKeyID(public),
+		PublicKey: paseto.FormatPublicKey(public),
+		SecretKey: paseto.FormatSecretKey(secret),
+	})
+}
+
+// IssueToken issues a v4.public token signed with the configured signing key.
+// (POST /v1/admin/tokens)
+func (h *RequestHandler) IssueToken(w http.ResponseWriter, r *http.Request) {
+	if !h.adminEnabled(w, r) {
+		return
+	}
+
+	if h.issuer == nil {
+		writeError(w, r, http.StatusServiceUnavailable, errCodeSigningKeyNotConfigured, "Tokens cannot be issued",
+			"No signing key is configured. Please set AUTH_SIGNING_KEY")
+
+		return
+	}
+
+	var body IssueTokenJSONRequestBody
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodySize)).Decode(&body); err != nil {
+		writeError(w, r, http.StatusBadRequest, errCodeInvalidRequest, "The request body is not valid JSON", err.Error())
+
+		return
+	}
+
+	req := auth.TokenRequest{
+		Subject: strings.TrimSpace(body.Subject),
+		TTL:     defaultTokenTTL,
+	}
+
+	if body.Tenant != nil {
+		req.Tenant = strings.TrimSpace(*body.Tenant)
+	}
+
+	for _, scope := range body.Scopes {
+		req.Scopes = append(req.Scopes, string(scope))
+	}
+
+	if body.ExpiresIn != nil {
+		req.TTL = time.Duration(*body.ExpiresIn) * time.Second
+	}
+
+	if req.TTL < minTokenTTL {
+		writeError(w, r, http.StatusBadRequest, errCodeInvalidRequest, "The token lifetime is too short",
+			"The 'expires_in' field must be at least 60 seconds")
+
+		return
+	}
+
+	token, claims, err := h.issuer.Issue(req)
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	resp := IssuedToken{
+		Token:     token,
+		TokenId:   claims.TokenID,
+		KeyId:     h.issuer.KeyID(),
+		Subject:   claims.Subject,
+		Scopes:    claims.Scopes,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}
+
+	if claims.Tenant != "" {
+		resp.Tenant = ptr(claims.Tenant)
+	}
+
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+// ListRevocations lists the revoked token IDs.
+// (GET /v1/admin/revocations)
+func (h *RequestHandler) ListRevocations(w http.ResponseWriter, r *http.Request) {
+	if !h.adminEnabled(w, r) {
+		return
+	}
+
+	revocations, err := h.revocations.List(r.Context())
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	if revocations == nil {
+		revocations = []auth.Revocation{}
+	}
+
+	var resp RevocationList
+	if err := convert(map[string]any{"revocations": revocations}, &resp); err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// RevokeToken revokes a token by ID.
+// (POST /v1/admin/revocations)
+func (h *RequestHandler) RevokeToken(w http.ResponseWriter, r *http.Request) {
+	if !h.adminEnabled(w, r) {
+		return
+	}
+
+	var body RevokeTokenJSONRequestBody
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodySize)).Decode(&body); err != nil {
+		writeError(w, r, http.StatusBadRequest, errCodeInvalidRequest, "The request body is not valid JSON", err.Error())
+
+		return
+	}
+
+	now := time.Now().UTC()
+	revocation := auth.Revocation{
+		TokenID:   strings.TrimSpace(body.TokenId),
+		RevokedAt: now,
+		ExpiresAt: now.Add(h.maxTokenTTL),
+	}
+
+	if body.ExpiresAt != nil {
+		revocation.ExpiresAt = body.ExpiresAt.UTC()
+	}
+
+	if revocation.TokenID == "" {
+		writeError(w, r, http.StatusBadRequest, errCodeMissingField, "Required field is missing", "The 'token_id' field is required")
+
+		return
+	}
+
+	if !revocation.ExpiresAt.After(now) {
+		writeError(w, r, http.StatusBadRequest, errCodeInvalidRequest, "The token has already expired",
+			"The 'expires_at' field must be in the future")
+
+		return
+	}
+
+	if err := h.revocations.Revoke(r.Context(), revocation); err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, Revocation{
+		TokenId:   revocation.TokenID,
+		RevokedAt: revocation.RevokedAt,
+		ExpiresAt: revocation.ExpiresAt,
+	})
+}
+
+// adminEnabled reports whether the admin endpoints are enabled, answering with 404 Not Found
+// otherwise.
+func (h *RequestHandler) adminEnabled(w http.ResponseWriter, r *http.Request) bool {
+	if h.revocations != nil {
+		return true
+	}
+
+	writeError(w, r, http.StatusNotFound, errCodeAdminDisabled, "Administration is disabled",
+		"Please configure BASIC_AUTH_USERS or BASIC_AUTH_USERS_FILE to enable the admin endpoints")
+
+	return false
+}
@@ -0,0 +1,255 @@
+package handlers_test
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/auth"
+	"github.com/architeacher/svc-web-analyzer/internal/handlers"
+)
+
+func newAdminHandler(t *testing.T, opts ...handlers.HandlerOption) *handlers.RequestHandler {
+	t.Helper()
+
+	return handlers.NewRequestHandler(nil, "test", opts...)
+}
+
+func newTestIssuer(t *testing.T) *auth.Issuer {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("ed25519.GenerateKey() error = %v", err)
+	}
+
+	return auth.NewIssuer(key, auth.WithMaxTTL(24*time.Hour))
+}
+
+func TestAdminEndpoints(t *testing.T) {
+	t.Parallel()
+
+	issuer := newTestIssuer(t)
+	admin := []handlers.HandlerOption{
+		handlers.WithTokenAdmin(auth.NewMemoryRevocationList(), issuer.MaxTTL()),
+		handlers.WithTokenIssuer(issuer),
+	}
+
+	past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+
+	tests := []struct {
+		name       string
+		opts       []handlers.HandlerOption
+		operation  func(*handlers.RequestHandler) http.HandlerFunc
+		body       string
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "signing key",
+			opts:       admin,
+			operation:  func(h *handlers.RequestHandler) http.HandlerFunc { return h.GenerateSigningKey },
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "token",
+			opts:       admin,
+			operation:  func(h *handlers.RequestHandler) http.HandlerFunc { return h.IssueToken },
+			body:       `{"subject":"ci","scopes":["analysis:read"],"expires_in":3600}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "token for the longest lifetime",
+			opts:       admin,
+			operation:  func(h *handlers.RequestHandler) http.HandlerFunc { return h.IssueToken },
+			body:       `{"subject":"ci","scopes":["analysis:read"],"expires_in":86400}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "token past the longest lifetime",
+			opts:       admin,
+			operation:  func(h *handlers.RequestHandler) http.HandlerFunc { return h.IssueToken },
+			body:       `{"subject":"ci","scopes":["analysis:read"],"expires_in":86401}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+		},
+		{
+			name:       "token for too short a lifetime",
+			opts:       admin,
+			operation:  func(h *handlers.RequestHandler) http.HandlerFunc { return h.IssueToken },
+			body:       `{"subject":"ci","scopes":["analysis:read"],"expires_in":59}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+		},
+		{
+			name:       "token of an unknown scope",
+			opts:       admin,
+			operation:  func(h *handlers.RequestHandler) http.HandlerFunc { return h.IssueToken },
+			body:       `{"subject":"ci","scopes":["admin"]}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+		},
+		{
+			name:       "token without a subject",
+			opts:       admin,
+			operation:  func(h *handlers.RequestHandler) http.HandlerFunc { return h.IssueToken },
+			body:       `{"subject":" ","scopes":["analysis:read"]}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+		},
+		{
+			name:       "token of an invalid body",
+			opts:       admin,
+			operation:  func(h *handlers.RequestHandler) http.HandlerFunc { return h.IssueToken },
+			body:       `{"subject":`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+		},
+		{
+			name:       "token without a signing key",
+			opts:       admin[:1],
+			operation:  func(h *handlers.RequestHandler) http.HandlerFunc { return h.IssueToken },
+			body:       `{"subject":"ci","scopes":["analysis:read"]}`,
+			wantStatus: http.StatusServiceUnavailable,
+			wantCode:   "signing_key_not_configured",
+		},
+		{
+			name:       "revocation",
+			opts:       admin,
+			operation:  func(h *handlers.RequestHandler) http.HandlerFunc { return h.RevokeToken },
+			body:       `{"token_id":"0b6a3c52-8f1e-4e44-9d8a-5d1c2e3f4a5b"}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "revocation without a token ID",
+			opts:       admin,
+			operation:  func(h *handlers.RequestHandler) http.HandlerFunc { return h.RevokeToken },
+			body:       `{"token_id":" "}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "missing_required_field",
+		},
+		{
+			name:       "revocation of an expired token",
+			opts:       admin,
+			operation:  func(h *handlers.RequestHandler) http.HandlerFunc { return h.RevokeToken },
+			body:       `{"token_id":"0b6a3c52-8f1e-4e44-9d8a-5d1c2e3f4a5b","expires_at":"` + past + `"}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+		},
+		{
+			name:       "revocations",
+			opts:       admin,
+			operation:  func(h *handlers.RequestHandler) http.HandlerFunc { return h.ListRevocations },
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "signing key with administration disabled",
+			operation:  func(h *handlers.RequestHandler) http.HandlerFunc { return h.GenerateSigningKey },
+			wantStatus: http.StatusNotFound,
+			wantCode:   "admin_disabled",
+		},
+		{
+			name:       "token with administration disabled",
+			operation:  func(h *handlers.RequestHandler) http.HandlerFunc { return h.IssueToken },
+			body:       `{"subject":"ci","scopes":["analysis:read"]}`,
+			wantStatus: http.StatusNotFound,
+			wantCode:   "admin_disabled",
+		},
+		{
+			name:       "revocation with administration disabled",
+			operation:  func(h *handlers.RequestHandler) http.HandlerFunc { return h.RevokeToken },
+			body:       `{"token_id":"0b6a3c52-8f1e-4e44-9d8a-5d1c2e3f4a5b"}`,
+			wantStatus: http.StatusNotFound,
+			wantCode:   "admin_disabled",
+		},
+		{
+			name:       "revocations with administration disabled",
+			operation:  func(h *handlers.RequestHandler) http.HandlerFunc { return h.ListRevocations },
+			wantStatus: http.StatusNotFound,
+			wantCode:   "admin_disabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := newAdminHandler(t, tt.opts...)
+
+			method := http.MethodGet
+			if tt.body != "" {
+				method = http.MethodPost
+			}
+
+			rec := httptest.NewRecorder()
+			tt.operation(h)(rec, httptest.NewRequest(method, "/v1/admin", strings.NewReader(tt.body)))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+
+			if tt.wantCode == "" {
+				return
+			}
+
+			var got handlers.ErrorResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatalf("json.Unmarshal(%s) error = %v", rec.Body, err)
+			}
+
+			if got.Error == nil || *got.Error != tt.wantCode {
+				t.Errorf("error = %s, want %s", rec.Body, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestAdminRevokeIssuedToken(t *testing.T) {
+	t.Parallel()
+
+	issuer := newTestIssuer(t)
+	revocations := auth.NewMemoryRevocationList()
+	h := newAdminHandler(t, handlers.WithTokenAdmin(revocations, issuer.MaxTTL()), handlers.WithTokenIssuer(issuer))
+
+	rec := httptest.NewRecorder()
+	h.IssueToken(rec, httptest.NewRequest(http.MethodPost, "/v1/admin/tokens",
+		strings.NewReader(`{"subject":"ci","scopes":["analysis:read"]}`)))
+
+	var issued handlers.IssuedToken
+	if err := json.Unmarshal(rec.Body.Bytes(), &issued); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", rec.Body, err)
+	}
+
+	rec = httptest.NewRecorder()
+	h.RevokeToken(rec, httptest.NewRequest(http.MethodPost, "/v1/admin/revocations",
+		strings.NewReader(`{"token_id":"`+issued.TokenId+`"}`)))
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("RevokeToken() status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body)
+	}
+
+	verifier := auth.NewVerifier(
+		auth.WithPublicKey(issuer.KeyID(), issuer.PublicKey()),
+		auth.WithRevocationList(revocations),
+	)
+
+	if _, err := verifier.Verify(t.Context(), issued.Token); err == nil || !strings.Contains(err.Error(), auth.ErrTokenRevoked.Error()) {
+		t.Errorf("Verify() of the revoked token error = %v, want %v", err, auth.ErrTokenRevoked)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ListRevocations(rec, httptest.NewRequest(http.MethodGet, "/v1/admin/revocations", nil))
+
+	var list handlers.RevocationList
+	if err := json.Unmarshal(rec.Body.Bytes(), &list); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", rec.Body, err)
+	}
+
+	if len(list.Revocations) != 1 || list.Revocations[0].TokenId != issued.TokenId {
+		t.Errorf("ListRevocations() = %+v, want the revocation of %s", list.Revocations, issued.TokenId)
+	}
+}
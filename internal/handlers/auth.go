@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/architeacher/svc-web-analyzer/internal/auth"
+)
+
+// Error codes of the PasetoAuth failures, as documented by the unauthorized and PASETO error
+// responses.
+const (
+	errCodeMissingToken       = "missing_token"
+	errCodeInvalidToken       = "invalid_token"
+	errCodeInvalidPasetoToken = "invalid_paseto_token"
+	errCodePasetoSignature    = "paseto_signature_invalid"
+	errCodePasetoExpired      = "paseto_token_expired"
+	errCodePasetoIssuer       = "paseto_issuer_validation_failed"
+	errCodePasetoAudience     = "paseto_audience_validation_failed"
+	errCodeInsufficientScope  = "insufficient_scope"
+	errCodeTokenRevoked       = "token_revoked"
+)
+
+// WWW-Authenticate challenges of the rejected requests, as per RFC 6750.
+const (
+	authenticateChallenge        = `Bearer realm="web-analyzer"`
+	authenticateChallengeInvalid = authenticateChallenge + `, error="invalid_token"`
+)
+
+// TokenVerifier authenticates the bearer tokens of the operations secured with PasetoAuth.
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (*auth.Claims, error)
+}
+
+// NewAuthMiddleware authenticates and authorizes the operations secured with PasetoAuth. The
+// claims of valid tokens are added to the request context; requests without a valid token are
+// rejected with 401 Unauthorized, and those whose token lacks a scope the operation declares
+// with 403 Forbidden.
+func NewAuthMiddleware(verifier TokenVerifier) MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			required, secured := r.Context().Value(PasetoAuthScopes).([]string)
+			if !secured {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			token, ok := bearerToken(r)
+			if !ok {
+				w.Header().Set("WWW-Authenticate", authenticateChallenge)
+				writeError(w, r, http.StatusUnauthorized, errCodeMissingToken, "Authentication token is required",
+					"Please provide a valid Bearer token in the Authorization header")
+
+				return
+			}
+
+			claims, err := verifier.Verify(r.Context(), token)
+			if err != nil {
+				apiErr := toAPIError(err)
+				if apiErr.Status == http.StatusInternalServerError {
+					// Tokens failing for an unforeseen reason are invalid all the same.
+					apiErr = &APIError{Status: http.StatusUnauthorized, Code: errCodeInvalidToken,
+						Message: messageInvalidToken, Err: err}
+				}
+
+				if apiErr.Status == http.StatusUnauthorized {
+					w.Header().Set("WWW-Authenticate", authenticateChallengeInvalid)
+				}
+
+				renderError(w, r, apiErr)
+
+				return
+			}
+
+			if !claims.HasScopes(required...) {
+				scope := strings.Join(required, " ")
+
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`%s, error="insufficient_scope", scope=%q`,
+					authenticateChallenge, scope))
+				writeError(w, r, http.StatusForbidden, errCodeInsufficientScope, "Insufficient permissions",
+					fmt.Sprintf("The token must grant the %q scopes", scope))
+
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(auth.WithClaims(r.Context(), claims)))
+		})
+	}
+}
+
+// bearerToken returns the token of the Authorization header using the Bearer scheme.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+
+	return token, token != ""
+}
+
+// owner returns the tenant the request acts for. Requests served without authentication
+// share the empty owner.
+func owner(r *http.Request) string {
+	if claims, ok := auth.ClaimsFromContext(r.Context()); ok {
+		return claims.Owner()
+	}
+
+	return ""
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	errCodeInvalidCredentials = "invalid_credentials"
+	errCodeTooManyAttempts    = "too_many_attempts"
+
+	basicAuthChallenge = `Basic realm="web-analyzer", charset="UTF-8"`
+)
+
+// CredentialsVerifier checks the credentials of the operations secured with BasicAuth.
+type CredentialsVerifier interface {
+	Verify(username, password string) bool
+}
+
+// LoginLimiter locks out the clients failing to authenticate too often.
+type LoginLimiter interface {
+	// Blocked returns how long client remains locked out, zero if it is not.
+	Blocked(client string) time.Duration
+	// Fail records a failed attempt of client.
+	Fail(client string)
+	// Reset forgets the failed attempts of client.
+	Reset(client string)
+}
+
+// NewBasicAuthMiddleware authenticates the operations secured with BasicAuth, rejecting
+// requests without valid credentials with 401 Unauthorized. When limiter is not nil, clients,
+// identified by their IP address, are answered with 429 Too Many Requests while locked out,
+// without their credentials being checked.
+func NewBasicAuthMiddleware(credentials CredentialsVerifier, limiter LoginLimiter) MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Context().Value(BasicAuthScopes) == nil {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			client := clientIP(r)
+
+			if limiter != nil {
+				if wait := limiter.Blocked(client); wait > 0 {
+					renderError(w, r, &APIError{Status: http.StatusTooManyRequests, Code: errCodeTooManyAttempts,
+						Message: "Too many failed authentication attempts", RetryAfter: wait})
+
+					return
+				}
+			}
+
+			username, password, ok := r.BasicAuth()
+			if !ok || !credentials.Verify(username, password) {
+				// Requests without credentials are how clients ask for the challenge, they are
+				// not guesses.
+				if ok && limiter != nil {
+					limiter.Fail(client)
+				}
+
+				w.Header().Set("WWW-Authenticate", basicAuthChallenge)
+				writeError(w, r, http.StatusUnauthorized, errCodeInvalidCredentials, "Invalid username or password",
+					"Please provide valid credentials using the Basic scheme")
+
+				return
+			}
+
+			if limiter != nil {
+				limiter.Reset(client)
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// clientIP returns the IP address of the client: the peer address, or the address the RealIP
+// middleware found in the headers of a trusted proxy.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
@@ -0,0 +1,128 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/auth"
+	"github.com/architeacher/svc-web-analyzer/internal/handlers"
+	"github.com/architeacher/svc-web-analyzer/internal/middleware"
+)
+
+type staticCredentials struct {
+	username, password string
+}
+
+func (c staticCredentials) Verify(username, password string) bool {
+	return username == c.username && password == c.password
+}
+
+// newBasicAuthHandler serves an operation secured with BasicAuth behind the RealIP middleware
+// trusting trustedProxies, locking clients out after two failures.
+func newBasicAuthHandler(t *testing.T, trustedProxies ...string) http.Handler {
+	t.Helper()
+
+	realIP, err := middleware.RealIP(trustedProxies...)
+	if err != nil {
+		t.Fatalf("RealIP() error = %v", err)
+	}
+
+	basicAuth := handlers.NewBasicAuthMiddleware(staticCredentials{"admin", "secret"}, auth.NewLockout(2, time.Minute))
+
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	return realIP(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), handlers.BasicAuthScopes, []string{})
+		basicAuth(ok).ServeHTTP(w, r.WithContext(ctx))
+	}))
+}
+
+func basicAuthRequest(remoteAddr, forwardedFor, password string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/v1/health", nil)
+	r.RemoteAddr = remoteAddr
+	r.SetBasicAuth("admin", password)
+
+	if forwardedFor != "" {
+		r.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+
+	return r
+}
+
+func TestBasicAuthLockout(t *testing.T) {
+	t.Parallel()
+
+	type attempt struct {
+		remoteAddr   string
+		forwardedFor string
+		password     string
+		wantStatus   int
+	}
+
+	tests := []struct {
+		name     string
+		trusted  []string
+		attempts []attempt
+	}{
+		{
+			name: "locked out after repeated failures",
+			attempts: []attempt{
+				{"203.0.113.7:1000", "", "guess", http.StatusUnauthorized},
+				{"203.0.113.7:1001", "", "guess", http.StatusUnauthorized},
+				{"203.0.113.7:1002", "", "secret", http.StatusTooManyRequests},
+				{"198.51.100.9:1000", "", "secret", http.StatusNoContent},
+			},
+		},
+		{
+			name: "spoofed forwarded headers are ignored",
+			attempts: []attempt{
+				{"203.0.113.7:1000", "192.0.2.1", "guess", http.StatusUnauthorized},
+				{"203.0.113.7:1001", "192.0.2.2", "guess", http.StatusUnauthorized},
+				{"203.0.113.7:1002", "192.0.2.3", "guess", http.StatusTooManyRequests},
+			},
+		},
+		{
+			name:    "clients behind a trusted proxy are told apart",
+			trusted: []string{"10.0.0.0/8"},
+			attempts: []attempt{
+				{"10.0.0.2:1000", "192.0.2.1", "guess", http.StatusUnauthorized},
+				{"10.0.0.2:1001", "192.0.2.1", "guess", http.StatusUnauthorized},
+				{"10.0.0.2:1002", "192.0.2.1", "secret", http.StatusTooManyRequests},
+				{"10.0.0.2:1003", "192.0.2.2", "secret", http.StatusNoContent},
+			},
+		},
+		{
+			name:    "success resets the failures",
+			trusted: []string{"10.0.0.0/8"},
+			attempts: []attempt{
+				{"10.0.0.2:1000", "192.0.2.1", "guess", http.StatusUnauthorized},
+				{"10.0.0.2:1001", "192.0.2.1", "secret", http.StatusNoContent},
+				{"10.0.0.2:1002", "192.0.2.1", "guess", http.StatusUnauthorized},
+				{"10.0.0.2:1003", "192.0.2.1", "secret", http.StatusNoContent},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := newBasicAuthHandler(t, tt.trusted...)
+
+			for i, a := range tt.attempts {
+				w := httptest.NewRecorder()
+				handler.ServeHTTP(w, basicAuthRequest(a.remoteAddr, a.forwardedFor, a.password))
+
+				if w.Code != a.wantStatus {
+					t.Fatalf("attempt %d from %s (X-Forwarded-For: %q): status = %d, want %d",
+						i+1, a.remoteAddr, a.forwardedFor, w.Code, a.wantStatus)
+				}
+			}
+		})
+	}
+}
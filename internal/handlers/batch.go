@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/events"
+)
+
+// AnalyzeBatch submits a batch of URLs, or the URLs listed by a sitemap, for analysis.
+// (POST /v1/analyze/batch)
+func (h *RequestHandler) AnalyzeBatch(w http.ResponseWriter, r *http.Request, _ AnalyzeBatchParams) {
+	var body AnalyzeBatchJSONRequestBody
+
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodySize)).Decode(&body); err != nil {
+		writeError(w, r, http.StatusBadRequest, errCodeInvalidRequest, "The request body is not valid JSON", err.Error())
+
+		return
+	}
+
+	var (
+		batch    *domain.Batch
+		analyses []*domain.Analysis
+		err      error
+	)
+
+	switch {
+	case body.Items != nil && body.SitemapUrl != nil:
+		err = &APIError{Status: http.StatusBadRequest, Code: errCodeInvalidRequest, Message: "The batch request is not valid",
+			Details: "Only one of the 'items' and 'sitemap_url' fields may be given"}
+	case body.SitemapUrl != nil:
+		var (
+			sitemapURL string
+			opts       domain.Options
+		)
+
+		if sitemapURL, opts, err = parseBatchSitemap(body); err == nil {
+			batch, analyses, err = h.service.SubmitSitemap(r.Context(), owner(r), sitemapURL, opts)
+		}
+	case body.Items != nil:
+		var items []domain.BatchItem
+
+		if items, err = parseBatchItems(body); err == nil {
+			batch, analyses, err = h.service.SubmitBatch(r.Context(), owner(r), items)
+		}
+	default:
+		err = &APIError{Status: http.StatusBadRequest, Code: errCodeMissingField, Message: "Required field is missing",
+			Details: "Either the 'items' or the 'sitemap_url' field is required"}
+	}
+
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	resp, err := toBatchResponse(batch, analyses)
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, resp)
+}
+
+// GetBatch returns the progress of a batch, along with a page of its analyses.
+// (GET /v1/batches/{batchId})
+func (h *RequestHandler) GetBatch(w http.ResponseWriter, r *http.Request, batchId openapi_types.UUID, params GetBatchParams) {
+	page, limit, err := pageParams(params.Page, params.Limit)
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	batch, err := h.service.GetBatch(r.Context(), owner(r), batchId)
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	analyses, total, err := h.service.ListBatchItems(r.Context(), owner(r), batchId, (page-1)*limit, limit)
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	resp, err := toBatchStatus(batch, analyses, total, page, limit)
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// GetBatchEvents streams the progress of a batch as Server-Sent Events.
+// (GET /v1/batches/{batchId}/events)
+func (h *RequestHandler) GetBatchEvents(w http.ResponseWriter, r *http.Request, batchId openapi_types.UUID, params GetBatchEventsParams) {
+	batch, err := h.service.GetBatch(r.Context(), owner(r), batchId)
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	var lastEventID uint64
+	if params.LastEventID != nil && *params.LastEventID > 0 {
+		lastEventID = uint64(*params.LastEventID)
+	}
+
+	stream, ok := newEventStream(w)
+	if !ok {
+		writeError(w, r, http.StatusInternalServerError, errCodeStreamingUnsupported, "Streaming is not supported", "")
+
+		return
+	}
+
+	// The hub no longer holds the events of batches that finished a while ago.
+	if batch.Progress.Finished() && !h.events.Finished(batchId) {
+		sendBatchSnapshot(stream, batch, lastEventID)
+
+		return
+	}
+
+	replay, sub := h.events.Subscribe(batchId, lastEventID)
+	for _, e := range replay {
+		stream.send(e)
+	}
+
+	if sub == nil {
+		return
+	}
+
+	defer sub.Close()
+
+	heartbeat := time.NewTicker(h.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-h.closed:
+			return
+		case e, ok := <-sub.Events():
+			if !ok {
+				// The batch finished, or the client fell behind and resumes by reconnecting.
+				return
+			}
+
+			lastEventID = e.ID
+			stream.send(e)
+		case <-heartbeat.C:
+			stream.heartbeat()
+
+			// The analyses of a batch may run on other instances, which publish their events
+			// there; the completion of the batch is picked up from the repository instead.
+			if batch, err = h.service.GetBatch(r.Context(), owner(r), batchId); err == nil &&
+				batch.Progress.Finished() && !h.events.Finished(batchId) {
+				sendBatchSnapshot(stream, batch, lastEventID)
+
+				return
+			}
+		}
+	}
+}
+
+// sendBatchSnapshot sends the completion of a finished batch, rebuilt from its stored state.
+func sendBatchSnapshot(stream *eventStream, batch *domain.Batch, lastEventID uint64) {
+	msg := events.BatchCompleted(batch)
+
+	data, err := json.Marshal(msg.Data)
+	if err != nil {
+		return
+	}
+
+	stream.send(events.Event{ID: lastEventID + 1, Type: msg.Type, Data: data})
+}
+
+// parseBatchItems parses the pages of a batch as the requests of POST /v1/analyze are.
+func parseBatchItems(body AnalyzeBatchJSONRequestBody) ([]domain.BatchItem, error) {
+	if n := len(*body.Items); n == 0 || n > domain.MaxBatchItems {
+		return nil, &APIError{Status: http.StatusBadRequest, Code: errCodeInvalidRequest, Message: "The batch request is not valid",
+			Details: fmt.Sprintf("A batch holds between 1 and %d items", domain.MaxBatchItems)}
+	}
+
+	items := make([]domain.BatchItem, 0, len(*body.Items))
+
+	for i, item := range *body.Items {
+		var req AnalyzeURLJSONRequestBody
+		if err := convert(item, &req); err != nil {
+			return nil, err
+		}
+
+		if strings.TrimSpace(req.Url) == "" {
+			return nil, &APIError{Status: http.StatusBadRequest, Code: errCodeMissingField, Message: "Required field is missing",
+				Details: fmt.Sprintf("The 'items[%d].url' field is required", i)}
+		}
+
+		target, opts, err := parseAnalyzeRequest(req)
+		if err != nil {
+			return nil, fmt.Errorf("items[%d]: %w", i, err)
+		}
+
+		items = append(items, domain.BatchItem{URL: target, Options: opts})
+	}
+
+	return items, nil
+}
+
+// parseBatchSitemap parses the sitemap URL of a batch, and the options its pages are analyzed
+// with, as the requests of POST /v1/analyze are.
+func parseBatchSitemap(body AnalyzeBatchJSONRequestBody) (string, domain.Options, error) {
+	req := AnalyzeURLJSONRequestBody{Url: strings.TrimSpace(*body.SitemapUrl)}
+	if req.Url == "" {
+		return "", domain.Options{}, &APIError{Status: http.StatusBadRequest, Code: errCodeMissingField,
+			Message: "Required field is missing", Details: "The 'sitemap_url' field is required"}
+	}
+
+	if err := convert(body.Options, &req.Options); err != nil {
+		return "", domain.Options{}, err
+	}
+
+	return parseAnalyzeRequest(req)
+}
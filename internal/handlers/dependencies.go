@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"context"
+	"time"
+)
+
+// dependencyCheckTimeout bounds the check of a single dependency.
+const dependencyCheckTimeout = 2 * time.Second
+
+// Dependency statuses reported in DependencyCheck.Status.
+const (
+	dependencyHealthy   = "healthy"
+	dependencyUnhealthy = "unhealthy"
+)
+
+// Dependency is a backing service reported by the readiness and health checks.
+type Dependency interface {
+	Ping(ctx context.Context) error
+}
+
+// DetailedDependency is a Dependency reporting additional information, such as usage
+// statistics, in the health check.
+type DetailedDependency interface {
+	Dependency
+	Details(ctx context.Context) (map[string]any, error)
+}
+
+// DependencyFunc adapts a function to the Dependency interface.
+type DependencyFunc func(ctx context.Context) error
+
+// Ping calls f.
+func (f DependencyFunc) Ping(ctx context.Context) error {
+	return f(ctx)
+}
+
+type namedDependency struct {
+	name       string
+	dependency Dependency
+}
+
+// dependencyCheck mirrors DependencyCheck; it is converted into the generated check types.
+type dependencyCheck struct {
+	Status       string         `json:"status"`
+	LastChecked  *time.Time     `json:"last_checked,omitempty"`
+	ResponseTime *float32       `json:"response_time,omitempty"`
+	Error        *string        `json:"error,omitempty"`
+	Details      map[string]any `json:"details,omitempty"`
+}
+
+// checkDependencies pings every dependency and reports whether all of them are healthy. With
+// details, the healthy dependencies implementing DetailedDependency are asked for their details.
+func (h *RequestHandler) checkDependencies(ctx context.Context, details bool) (map[string]dependencyCheck, bool) {
+	checks := make(map[string]dependencyCheck, len(h.dependencies))
+	healthy := true
+
+	for _, d := range h.dependencies {
+		check := pingDependency(ctx, d.dependency)
+		if check.Status != dependencyHealthy {
+			healthy = false
+		} else if detailed, ok := d.dependency.(DetailedDependency); ok && details {
+			check.Details = dependencyDetails(ctx, detailed)
+		}
+
+		checks[d.name] = check
+	}
+
+	return checks, healthy
+}
+
+func pingDependency(ctx context.Context, d Dependency) dependencyCheck {
+	ctx, cancel := context.WithTimeout(ctx, dependencyCheckTimeout)
+	defer cancel()
+
+	started := time.Now()
+	err := d.Ping(ctx)
+	checkedAt := time.Now().UTC()
+
+	check := dependencyCheck{
+		Status:       dependencyHealthy,
+		LastChecked:  &checkedAt,
+		ResponseTime: ptr(float32(time.Since(started).Microseconds()) / 1000),
+	}
+
+	if err != nil {
+		check.Status = dependencyUnhealthy
+		check.Error = ptr(err.Error())
+	}
+
+	return check
+}
+
+// dependencyDetails collects the details of d. Failing to do so does not make the dependency
+// unhealthy, so the error is reported among the details.
+func dependencyDetails(ctx context.Context, d DetailedDependency) map[string]any {
+	ctx, cancel := context.WithTimeout(ctx, dependencyCheckTimeout)
+	defer cancel()
+
+	details, err := d.Details(ctx)
+	if err != nil {
+		return map[string]any{"error": err.Error()}
+	}
+
+	return details
+}
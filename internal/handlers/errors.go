@@ -0,0 +1,295 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+
+	"github.com/architeacher/svc-web-analyzer/internal/auth"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/middleware"
+)
+
+// Error codes of the parameter binding and routing failures.
+const (
+	errCodeInvalidParameter = "invalid_parameter"
+	errCodeMissingParameter = "missing_required_parameter"
+	errCodeTooManyValues    = "too_many_parameter_values"
+	errCodeRouteNotFound    = "route_not_found"
+	errCodeMethodNotAllowed = "method_not_allowed"
+)
+
+// Messages shared by several errors.
+const (
+	messageInternalError       = "An unexpected error occurred"
+	messageServiceBusy         = "Service is temporarily unavailable"
+	messageInvalidToken        = "Authentication token is invalid"
+	messageInvalidTokenRequest = "The token request is not valid"
+)
+
+// retryAfterBusy is how long clients are asked to wait when a backing service is saturated or
+// unavailable.
+const retryAfterBusy = 30 * time.Second
+
+// APIError is an error rendered as an ErrorResponse.
+type APIError struct {
+	Status  int
+	Code    string
+	Message string
+	Details string
+	// RetryAfter, when positive, is sent in the Retry-After header and in
+	// ErrorResponse.RetryAfter.
+	RetryAfter time.Duration
+	// Err is the cause of the error. It is logged for server errors, and never sent to clients.
+	Err error
+}
+
+func (e *APIError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s: %v", e.Code, e.Message, e.Err)
+	}
+
+	return e.Code + ": " + e.Message
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// domainErrors maps the errors of the domain and of the authentication to their response. The
+// details of the client errors without fixed details are those of the error.
+var domainErrors = []struct {
+	err      error
+	response APIError
+}{
+	{domain.ErrAnalysisNotFound, APIError{Status: http.StatusNotFound, Code: errCodeAnalysisNotFound,
+		Message: "Analysis not found", Details: "No analysis found with the provided ID"}},
+	{domain.ErrNotCrawl, APIError{Status: http.StatusNotFound, Code: errCodeCrawlNotFound,
+		Message: "Crawl not found", Details: "The analysis was not submitted with crawl options, and has no pages"}},
+	{domain.ErrBatchNotFound, APIError{Status: http.StatusNotFound, Code: errCodeBatchNotFound,
+		Message: "Batch not found", Details: "No batch found with the provided ID"}},
+	{domain.ErrBlockedTarget, APIError{Status: http.StatusBadRequest, Code: errCodeBlockedTarget,
+		Message: "The URL targets a host that may not be analyzed"}},
+	{domain.ErrInvalidSitemap, APIError{Status: http.StatusBadRequest, Code: errCodeInvalidSitemap,
+		Message: "The sitemap could not be read"}},
+	{domain.ErrInvalidOptions, APIError{Status: http.StatusBadRequest, Code: errCodeInvalidOptions,
+		Message: "Invalid analysis options provided"}},
+	{domain.ErrInvalidURL, APIError{Status: http.StatusBadRequest, Code: errCodeInvalidURL,
+		Message: "The provided URL is not valid"}},
+	{domain.ErrServiceUnavailable, APIError{Status: http.StatusServiceUnavailable, Code: errCodeServiceUnavailable,
+		Message: messageServiceBusy, Details: "Too many analyses are queued. Please try again shortly",
+		RetryAfter: retryAfterBusy}},
+	{auth.ErrRevocationCheck, APIError{Status: http.StatusServiceUnavailable, Code: errCodeServiceUnavailable,
+		Message: messageServiceBusy, Details: "The token could not be checked. Please try again shortly",
+		RetryAfter: retryAfterBusy}},
+	{auth.ErrMalformedToken, APIError{Status: http.StatusUnauthorized, Code: errCodeInvalidPasetoToken,
+		Message: "PASETO token format is invalid",
+		Details: "Token must follow format: v4.public.{payload}[.{footer}] or v4.local.{payload}[.{footer}]"}},
+	{auth.ErrUnsupportedToken, APIError{Status: http.StatusUnauthorized, Code: errCodeInvalidPasetoToken,
+		Message: "PASETO token format is invalid", Details: "Only v4.public and v4.local tokens are supported"}},
+	{auth.ErrInvalidSignature, APIError{Status: http.StatusUnauthorized, Code: errCodePasetoSignature,
+		Message: "PASETO token signature is invalid", Details: "The token signature could not be verified"}},
+	{auth.ErrTokenExpired, APIError{Status: http.StatusUnauthorized, Code: errCodePasetoExpired,
+		Message: "PASETO token has expired", Details: "Please obtain a new token"}},
+	{auth.ErrInvalidIssuer, APIError{Status: http.StatusUnauthorized, Code: errCodePasetoIssuer,
+		Message: "PASETO token issuer validation failed",
+		Details: "The token issuer could not be validated or is not trusted"}},
+	{auth.ErrInvalidAudience, APIError{Status: http.StatusUnauthorized, Code: errCodePasetoAudience,
+		Message: "PASETO token audience validation failed", Details: "The token is not intended for this service"}},
+	{auth.ErrTokenNotYetValid, APIError{Status: http.StatusUnauthorized, Code: errCodeInvalidToken,
+		Message: messageInvalidToken, Details: "The token is not valid yet"}},
+	{auth.ErrInvalidClaims, APIError{Status: http.StatusUnauthorized, Code: errCodeInvalidToken,
+		Message: messageInvalidToken,
+		Details: "The token claims are malformed, lack an expiration time or a subject, or were issued in the future"}},
+	{auth.ErrTokenRevoked, APIError{Status: http.StatusUnauthorized, Code: errCodeTokenRevoked,
+		Message: "Authentication token has been revoked", Details: "Please obtain a new token"}},
+	{auth.ErrInvalidTokenRequest, APIError{Status: http.StatusBadRequest, Code: errCodeInvalidRequest,
+		Message: messageInvalidTokenRequest}},
+	{auth.ErrTTLTooLong, APIError{Status: http.StatusBadRequest, Code: errCodeInvalidRequest,
+		Message: messageInvalidTokenRequest}},
+}
+
+// toAPIError returns the response of err: err itself when it is an APIError, the response of
+// the parameter binding errors of the generated wrappers, of the request validation errors and
+// of the domain errors, or 500 Internal Server Error.
+func toAPIError(err error) *APIError {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	if apiErr := bindingError(err); apiErr != nil {
+		return apiErr
+	}
+
+	if apiErr := validationError(err); apiErr != nil {
+		return apiErr
+	}
+
+	for _, e := range domainErrors {
+		if !errors.Is(err, e.err) {
+			continue
+		}
+
+		apiErr := e.response
+		apiErr.Err = err
+
+		if apiErr.Details == "" && apiErr.Status < http.StatusInternalServerError {
+			apiErr.Details = err.Error()
+		}
+
+		return &apiErr
+	}
+
+	return &APIError{
+		Status:  http.StatusInternalServerError,
+		Code:    errCodeInternalServer,
+		Message: messageInternalError,
+		Err:     err,
+	}
+}
+
+// bindingError returns the response of the parameter binding errors raised by the generated
+// wrappers, nil for other errors.
+func bindingError(err error) *APIError {
+	var (
+		invalidFormat   *InvalidParamFormatError
+		unmarshaling    *UnmarshalingParamError
+		unescapedCookie *UnescapedCookieParamError
+		requiredParam   *RequiredParamError
+		requiredHeader  *RequiredHeaderError
+		tooManyValues   *TooManyValuesForParamError
+	)
+
+	apiErr := &APIError{Status: http.StatusBadRequest, Details: err.Error(), Err: err}
+
+	switch {
+	case errors.As(err, &invalidFormat), errors.As(err, &unmarshaling), errors.As(err, &unescapedCookie):
+		apiErr.Code, apiErr.Message = errCodeInvalidParameter, "A parameter of the request is not valid"
+	case errors.As(err, &requiredParam), errors.As(err, &requiredHeader):
+		apiErr.Code, apiErr.Message = errCodeMissingParameter, "A required parameter is missing"
+	case errors.As(err, &tooManyValues):
+		apiErr.Code, apiErr.Message = errCodeTooManyValues, "A parameter of the request has several values"
+	default:
+		return nil
+	}
+
+	return apiErr
+}
+
+// renderError answers r with the ErrorResponse of err, as mapped by toAPIError, carrying the
+// request ID as its correlation ID. Server errors are recorded for the request log.
+func renderError(w http.ResponseWriter, r *http.Request, err error) {
+	apiErr := toAPIError(err)
+
+	if apiErr.Status >= http.StatusInternalServerError {
+		middleware.RecordError(r.Context(), apiErr)
+	}
+
+	now := time.Now().UTC()
+	resp := ErrorResponse{
+		Error:      &apiErr.Code,
+		Message:    &apiErr.Message,
+		StatusCode: &apiErr.Status,
+		Timestamp:  &now,
+	}
+
+	details := apiErr.Details
+
+	if apiErr.RetryAfter > 0 {
+		seconds := int(math.Ceil(apiErr.RetryAfter.Seconds()))
+
+		if details == "" {
+			details = "Please try again in " + strconv.Itoa(seconds) + " seconds"
+		}
+
+		w.Header().Set("Retry-After", strconv.Itoa(seconds))
+		resp.RetryAfter = &seconds
+	}
+
+	if details != "" {
+		resp.Details = &details
+	}
+
+	if id := chimiddleware.GetReqID(r.Context()); id != "" {
+		resp.CorrelationId = &id
+	}
+
+	writeJSON(w, apiErr.Status, resp)
+}
+
+// writeError answers r with an ErrorResponse.
+func writeError(w http.ResponseWriter, r *http.Request, status int, code, message, details string) {
+	renderError(w, r, &APIError{Status: status, Code: code, Message: message, Details: details})
+}
+
+// errorHandler renders the parameter binding errors raised by the generated wrappers.
+func errorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	renderError(w, r, err)
+}
+
+// notFound answers the requests matching no route.
+func notFound(w http.ResponseWriter, r *http.Request) {
+	writeError(w, r, http.StatusNotFound, errCodeRouteNotFound, "Route not found",
+		fmt.Sprintf("No operation is served at %s", r.URL.Path))
+}
+
+// methodNotAllowed answers the requests of a route with a method it does not serve, listing
+// the methods it does in the Allow header.
+func methodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	var allowed []string
+
+	if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.Routes != nil {
+		for _, method := range []string{
+			http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+			http.MethodPatch, http.MethodDelete, http.MethodOptions,
+		} {
+			if rctx.Routes.Match(chi.NewRouteContext(), method, r.URL.Path) {
+				allowed = append(allowed, method)
+			}
+		}
+	}
+
+	details := fmt.Sprintf("%s is not served at %s", r.Method, r.URL.Path)
+	if len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		details += ", allowed methods: " + strings.Join(allowed, ", ")
+	}
+
+	writeError(w, r, http.StatusMethodNotAllowed, errCodeMethodNotAllowed, "Method not allowed", details)
+}
+
+// Recoverer recovers from the panics of the next handlers, answering with 500 Internal Server
+// Error. The panic and its stack trace are recorded for the request log.
+func Recoverer(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+
+			// Aborting handlers panic on purpose, to have the server drop the connection.
+			if err, ok := rec.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+				panic(rec)
+			}
+
+			renderError(w, r, &APIError{
+				Status:  http.StatusInternalServerError,
+				Code:    errCodeInternalServer,
+				Message: messageInternalError,
+				Err:     fmt.Errorf("panic: %v\n%s", rec, debug.Stack()),
+			})
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
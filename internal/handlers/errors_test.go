@@ -0,0 +1,336 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/architeacher/svc-web-analyzer/internal/auth"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/middleware"
+)
+
+func decodeError(t *testing.T, rec *httptest.ResponseRecorder) ErrorResponse {
+	t.Helper()
+
+	var resp ErrorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", rec.Body, err)
+	}
+
+	if resp.Error == nil || resp.StatusCode == nil || *resp.StatusCode != rec.Code {
+		t.Fatalf("error response = %s, want an error code and a status code of %d", rec.Body, rec.Code)
+	}
+
+	return resp
+}
+
+func TestRenderError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		err            error
+		wantStatus     int
+		wantCode       string
+		wantRetryAfter string
+		wantDetails    string
+	}{
+		{
+			name:       "analysis not found",
+			err:        domain.ErrAnalysisNotFound,
+			wantStatus: http.StatusNotFound,
+			wantCode:   "analysis_not_found",
+		},
+		{
+			name:       "not a crawl",
+			err:        fmt.Errorf("listing pages: %w", domain.ErrNotCrawl),
+			wantStatus: http.StatusNotFound,
+			wantCode:   "crawl_not_found",
+		},
+		{
+			name:       "batch not found",
+			err:        domain.ErrBatchNotFound,
+			wantStatus: http.StatusNotFound,
+			wantCode:   "batch_not_found",
+		},
+		{
+			name:        "blocked target",
+			err:         fmt.Errorf("%w: 10.0.0.1 is a private address", domain.ErrBlockedTarget),
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    "blocked_target",
+			wantDetails: "10.0.0.1 is a private address",
+		},
+		{
+			name:       "invalid sitemap",
+			err:        fmt.Errorf("%w: the sitemap lists no pages", domain.ErrInvalidSitemap),
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_sitemap",
+		},
+		{
+			name:       "invalid options",
+			err:        domain.ErrInvalidOptions,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_options",
+		},
+		{
+			name:       "invalid URL",
+			err:        domain.ErrInvalidURL,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_url",
+		},
+		{
+			name:           "service unavailable",
+			err:            domain.ErrServiceUnavailable,
+			wantStatus:     http.StatusServiceUnavailable,
+			wantCode:       "service_unavailable",
+			wantRetryAfter: "30",
+		},
+		{
+			name:           "revocation check failed",
+			err:            auth.ErrRevocationCheck,
+			wantStatus:     http.StatusServiceUnavailable,
+			wantCode:       "service_unavailable",
+			wantRetryAfter: "30",
+		},
+		{
+			name:       "malformed token",
+			err:        auth.ErrMalformedToken,
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   "invalid_paseto_token",
+		},
+		{
+			name:       "unsupported token",
+			err:        auth.ErrUnsupportedToken,
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   "invalid_paseto_token",
+		},
+		{
+			name:       "invalid signature",
+			err:        auth.ErrInvalidSignature,
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   "paseto_signature_invalid",
+		},
+		{
+			name:       "expired token",
+			err:        auth.ErrTokenExpired,
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   "paseto_token_expired",
+		},
+		{
+			name:       "invalid issuer",
+			err:        auth.ErrInvalidIssuer,
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   "paseto_issuer_validation_failed",
+		},
+		{
+			name:       "invalid audience",
+			err:        auth.ErrInvalidAudience,
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   "paseto_audience_validation_failed",
+		},
+		{
+			name:       "token not yet valid",
+			err:        auth.ErrTokenNotYetValid,
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   "invalid_token",
+		},
+		{
+			name:       "invalid claims",
+			err:        auth.ErrInvalidClaims,
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   "invalid_token",
+		},
+		{
+			name:       "revoked token",
+			err:        auth.ErrTokenRevoked,
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   "token_revoked",
+		},
+		{
+			name:       "invalid token request",
+			err:        auth.ErrInvalidTokenRequest,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+		},
+		{
+			name:       "token lifetime too long",
+			err:        auth.ErrTTLTooLong,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+		},
+		{
+			name:       "invalid parameter format",
+			err:        &InvalidParamFormatError{ParamName: "analysisId", Err: errors.New("invalid UUID length: 3")},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_parameter",
+		},
+		{
+			name:       "parameter not unmarshaled",
+			err:        &UnmarshalingParamError{ParamName: "limit", Err: errors.New("not a number")},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_parameter",
+		},
+		{
+			name:       "unescaped cookie",
+			err:        &UnescapedCookieParamError{ParamName: "session", Err: errors.New("invalid escape")},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_parameter",
+		},
+		{
+			name:       "required parameter",
+			err:        &RequiredParamError{ParamName: "url"},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "missing_required_parameter",
+		},
+		{
+			name:       "required header",
+			err:        &RequiredHeaderError{ParamName: "API-Version"},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "missing_required_parameter",
+		},
+		{
+			name:       "too many values",
+			err:        &TooManyValuesForParamError{ParamName: "API-Version", Count: 2},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "too_many_parameter_values",
+		},
+		{
+			name: "invalid parameter value",
+			err: &openapi3filter.RequestError{
+				Parameter: &openapi3.Parameter{In: "query", Name: "limit"},
+				Err:       errors.New("number must be at most 100"),
+			},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_parameter",
+		},
+		{
+			name: "API error",
+			err: fmt.Errorf("checking the limit: %w", &APIError{
+				Status: http.StatusTooManyRequests, Code: "rate_limit_exceeded", Message: "Rate limit exceeded",
+			}),
+			wantStatus: http.StatusTooManyRequests,
+			wantCode:   "rate_limit_exceeded",
+		},
+		{
+			name:       "unknown error",
+			err:        errors.New("connection refused"),
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   "internal_server_error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+			renderError(rec, httptest.NewRequest(http.MethodGet, "/v1/analyses", nil), tt.err)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+
+			resp := decodeError(t, rec)
+
+			if *resp.Error != tt.wantCode {
+				t.Errorf("error = %s, want %s", *resp.Error, tt.wantCode)
+			}
+
+			if got := rec.Header().Get("Retry-After"); got != tt.wantRetryAfter {
+				t.Errorf("Retry-After = %q, want %q", got, tt.wantRetryAfter)
+			}
+
+			if tt.wantDetails != "" && (resp.Details == nil || !strings.Contains(*resp.Details, tt.wantDetails)) {
+				t.Errorf("details = %v, want them to contain %q", resp.Details, tt.wantDetails)
+			}
+
+			// The causes of server errors are logged, never sent.
+			if tt.wantStatus >= http.StatusInternalServerError && strings.Contains(rec.Body.String(), tt.err.Error()) {
+				t.Errorf("body = %s, want it without %q", rec.Body, tt.err)
+			}
+		})
+	}
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	t.Parallel()
+
+	ok := func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }
+
+	r := chi.NewRouter()
+	r.MethodNotAllowed(methodNotAllowed)
+	r.Get("/v1/analyses/{analysisId}", ok)
+	r.Delete("/v1/analyses/{analysisId}", ok)
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/v1/analyses/42", nil))
+
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+
+	if got, want := rec.Header().Get("Allow"), "GET, DELETE"; got != want {
+		t.Errorf("Allow = %q, want %q", got, want)
+	}
+
+	if resp := decodeError(t, rec); *resp.Error != errCodeMethodNotAllowed {
+		t.Errorf("error = %s, want %s", *resp.Error, errCodeMethodNotAllowed)
+	}
+}
+
+func TestRecoverer(t *testing.T) {
+	t.Parallel()
+
+	var logs bytes.Buffer
+
+	logger := slog.New(slog.NewJSONHandler(&logs, nil))
+	h := middleware.RequestLogger(logger)(Recoverer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	})))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/analyses", nil))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusInternalServerError)
+	}
+
+	if resp := decodeError(t, rec); *resp.Error != errCodeInternalServer {
+		t.Errorf("error = %s, want %s", *resp.Error, errCodeInternalServer)
+	}
+
+	if strings.Contains(rec.Body.String(), "boom") {
+		t.Errorf("body = %s, want it without the panic", rec.Body)
+	}
+
+	if !strings.Contains(logs.String(), "panic: boom") {
+		t.Errorf("logs = %s, want the panic recorded", logs.String())
+	}
+}
+
+func TestRecovererAbortHandler(t *testing.T) {
+	t.Parallel()
+
+	h := Recoverer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+
+	defer func() {
+		if rec := recover(); rec != http.ErrAbortHandler {
+			t.Errorf("recover() = %v, want %v", rec, http.ErrAbortHandler)
+		}
+	}()
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/analyses", nil))
+}
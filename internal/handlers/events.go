@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/architeacher/svc-web-analyzer/internal/events"
+)
+
+type eventStream struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+func newEventStream(w http.ResponseWriter) (*eventStream, bool) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, false
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	return &eventStream{w: w, flusher: flusher}, true
+}
+
+func (s *eventStream) send(e events.Event) {
+	_, _ = fmt.Fprintf(s.w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, e.Data)
+	s.flusher.Flush()
+}
+
+// heartbeat writes a comment line, which clients ignore, to keep idle connections open.
+func (s *eventStream) heartbeat() {
+	_, _ = fmt.Fprint(s.w, ": heartbeat\n\n")
+	s.flusher.Flush()
+}
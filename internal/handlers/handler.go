@@ -0,0 +1,517 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/architeacher/svc-web-analyzer/internal/auth"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/events"
+)
+
+const (
+	maxRequestBodySize       = 1 << 20
+	defaultHeartbeatInterval = 15 * time.Second
+	defaultPagesLimit        = 20
+	maxPagesLimit            = 100
+)
+
+// AnalysisService is the application service behind the analysis endpoints.
+type AnalysisService interface {
+	Submit(ctx context.Context, owner, rawURL string, opts domain.Options) (*domain.Analysis, error)
+	Get(ctx context.Context, owner string, id uuid.UUID) (*domain.Analysis, error)
+	ListPages(ctx context.Context, owner string, id uuid.UUID, offset, limit int) ([]domain.CrawledPage, int, error)
+	SubmitBatch(ctx context.Context, owner string, items []domain.BatchItem) (*domain.Batch, []*domain.Analysis, error)
+	SubmitSitemap(ctx context.Context, owner, sitemapURL string, opts domain.Options) (*domain.Batch, []*domain.Analysis, error)
+	GetBatch(ctx context.Context, owner string, id uuid.UUID) (*domain.Batch, error)
+	ListBatchItems(ctx context.Context, owner string, id uuid.UUID, offset, limit int) ([]*domain.Analysis, int, error)
+}
+
+// HandlerOption configures a RequestHandler.
+type HandlerOption func(*RequestHandler)
+
+// WithDependency adds a backing service to the readiness and health checks.
+func WithDependency(name string, d Dependency) HandlerOption {
+	return func(h *RequestHandler) {
+		h.dependencies = append(h.dependencies, namedDependency{name: name, dependency: d})
+	}
+}
+
+// WithEvents sets the hub the event streams subscribe to. It must be the hub the analysis
+// service publishes to.
+func WithEvents(hub *events.Hub) HandlerOption {
+	return func(h *RequestHandler) {
+		h.events = hub
+	}
+}
+
+// WithHeartbeatInterval sets how often idle event streams receive a heartbeat comment.
+func WithHeartbeatInterval(interval time.Duration) HandlerOption {
+	return func(h *RequestHandler) {
+		if interval > 0 {
+			h.heartbeatInterval = interval
+		}
+	}
+}
+
+// WithTokenAdmin enables the admin endpoints, managing revocations. Tokens without an
+// explicit expiration time are revoked for maxTokenTTL. The admin endpoints must be guarded
+// by the BasicAuth middleware.
+func WithTokenAdmin(revocations auth.RevocationList, maxTokenTTL time.Duration) HandlerOption {
+	return func(h *RequestHandler) {
+		h.revocations = revocations
+		h.maxTokenTTL = maxTokenTTL
+	}
+}
+
+// WithTokenIssuer sets the issuer of the tokens requested through the admin endpoints.
+func WithTokenIssuer(issuer *auth.Issuer) HandlerOption {
+	return func(h *RequestHandler) {
+		h.issuer = issuer
+	}
+}
+
+// RequestHandler implements ServerInterface.
+type RequestHandler struct {
+	service           AnalysisService
+	version           string
+	startedAt         time.Time
+	dependencies      []namedDependency
+	events            *events.Hub
+	heartbeatInterval time.Duration
+	revocations       auth.RevocationList
+	maxTokenTTL       time.Duration
+	issuer            *auth.Issuer
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+var _ ServerInterface = (*RequestHandler)(nil)
+
+// NewRequestHandler creates a RequestHandler. version is reported by the system endpoints.
+func NewRequestHandler(service AnalysisService, version string, opts ...HandlerOption) *RequestHandler {
+	h := &RequestHandler{
+		service:           service,
+		version:           version,
+		startedAt:         time.Now(),
+		events:            events.NewHub(),
+		heartbeatInterval: defaultHeartbeatInterval,
+		closed:            make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// CloseStreams ends every open event stream. It is meant to be called during shutdown,
+// once in-flight analyses have been drained, so that http.Server.Shutdown can complete.
+func (h *RequestHandler) CloseStreams() {
+	h.closeOnce.Do(func() {
+		close(h.closed)
+	})
+}
+
+// AnalyzeURL submits a URL for analysis.
+// (POST /v1/analyze)
+func (h *RequestHandler) AnalyzeURL(w http.ResponseWriter, r *http.Request, _ AnalyzeURLParams) {
+	var body AnalyzeURLJSONRequestBody
+
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodySize)).Decode(&body); err != nil {
+		writeError(w, r, http.StatusBadRequest, errCodeInvalidRequest, "The request body is not valid JSON", err.Error())
+
+		return
+	}
+
+	if strings.TrimSpace(body.Url) == "" {
+		writeError(w, r, http.StatusBadRequest, errCodeMissingField, "Required field is missing", "The 'url' field is required")
+
+		return
+	}
+
+	target, opts, err := parseAnalyzeRequest(body)
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	analysis, err := h.service.Submit(r.Context(), owner(r), target, opts)
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, toAnalysisResponse(analysis))
+}
+
+// GetAnalysis returns the result of a previously submitted analysis.
+// (GET /v1/analysis/{analysisId})
+func (h *RequestHandler) GetAnalysis(w http.ResponseWriter, r *http.Request, analysisId openapi_types.UUID, _ GetAnalysisParams) {
+	analysis, err := h.service.Get(r.Context(), owner(r), analysisId)
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	switch analysis.Status {
+	case domain.StatusCompleted:
+		result, err := toAnalysisResult(analysis)
+		if err != nil {
+			renderError(w, r, err)
+
+			return
+		}
+
+		writeJSON(w, http.StatusOK, result)
+	case domain.StatusFailed:
+		writeJSON(w, http.StatusGone, toAnalysisError(analysis))
+	default:
+		writeJSON(w, http.StatusAccepted, toAnalysisInProgress(analysis))
+	}
+}
+
+// GetAnalysisPages lists the pages of a completed crawl.
+// (GET /v1/analysis/{analysisId}/pages)
+func (h *RequestHandler) GetAnalysisPages(w http.ResponseWriter, r *http.Request, analysisId openapi_types.UUID, params GetAnalysisPagesParams) {
+	page, limit, err := pageParams(params.Page, params.Limit)
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	analysis, err := h.service.Get(r.Context(), owner(r), analysisId)
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	switch {
+	case analysis.Options.Crawl == nil:
+		renderError(w, r, domain.ErrNotCrawl)
+
+		return
+	case analysis.Status == domain.StatusFailed:
+		writeJSON(w, http.StatusGone, toAnalysisError(analysis))
+
+		return
+	case analysis.Status != domain.StatusCompleted:
+		writeJSON(w, http.StatusAccepted, toAnalysisInProgress(analysis))
+
+		return
+	}
+
+	pages, total, err := h.service.ListPages(r.Context(), owner(r), analysisId, (page-1)*limit, limit)
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	list, err := toCrawlPageList(analysisId, pages, total, page, limit)
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, list)
+}
+
+// GetAnalysisEvents streams the analysis progress as Server-Sent Events.
+// (GET /v1/analysis/{analysisId}/events)
+func (h *RequestHandler) GetAnalysisEvents(w http.ResponseWriter, r *http.Request, analysisId openapi_types.UUID, params GetAnalysisEventsParams) {
+	analysis, err := h.service.Get(r.Context(), owner(r), analysisId)
+	if err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	var lastEventID uint64
+	if params.LastEventID != nil && *params.LastEventID > 0 {
+		lastEventID = uint64(*params.LastEventID)
+	}
+
+	stream, ok := newEventStream(w)
+	if !ok {
+		writeError(w, r, http.StatusInternalServerError, errCodeStreamingUnsupported, "Streaming is not supported", "")
+
+		return
+	}
+
+	// The hub no longer holds the events of analyses that finished a while ago.
+	if analysis.Status.IsTerminal() && !h.events.Finished(analysisId) {
+		sendSnapshot(stream, analysis, lastEventID)
+
+		return
+	}
+
+	replay, sub := h.events.Subscribe(analysisId, lastEventID)
+	for _, e := range replay {
+		stream.send(e)
+	}
+
+	if sub == nil {
+		return
+	}
+
+	defer sub.Close()
+
+	received := len(replay) > 0
+
+	heartbeat := time.NewTicker(h.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-h.closed:
+			return
+		case e, ok := <-sub.Events():
+			if !ok {
+				// The analysis finished, or the client fell behind and resumes by reconnecting.
+				return
+			}
+
+			received = true
+			stream.send(e)
+		case <-heartbeat.C:
+			stream.heartbeat()
+
+			if received {
+				continue
+			}
+
+			// Analyses run by another instance publish their events there; their outcome is
+			// picked up from the repository instead.
+			if analysis, err = h.service.Get(r.Context(), owner(r), analysisId); err == nil && analysis.Status.IsTerminal() {
+				sendSnapshot(stream, analysis, lastEventID)
+
+				return
+			}
+		}
+	}
+}
+
+// sendSnapshot sends the events of a finished analysis rebuilt from its stored state. A
+// resuming client only receives the outcome, since the rebuilt events are numbered afresh.
+func sendSnapshot(stream *eventStream, analysis *domain.Analysis, lastEventID uint64) {
+	messages := events.Snapshot(analysis)
+	if lastEventID > 0 {
+		messages = messages[len(messages)-1:]
+	}
+
+	for i, msg := range messages {
+		data, err := json.Marshal(msg.Data)
+		if err != nil {
+			return
+		}
+
+		stream.send(events.Event{ID: lastEventID + uint64(i) + 1, Type: msg.Type, Data: data})
+	}
+}
+
+// HealthCheck reports the service health including uptime.
+// (GET /v1/health)
+func (h *RequestHandler) HealthCheck(w http.ResponseWriter, r *http.Request) {
+	checks, healthy := h.checkDependencies(r.Context(), true)
+
+	resp := HealthResponse{
+		Status:    HealthResponseStatusOK,
+		Timestamp: time.Now().UTC(),
+		Version:   &h.version,
+		Uptime:    ptr(float32(time.Since(h.startedAt).Seconds())),
+	}
+
+	if err := convert(checks, &resp.Checks); err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	status := http.StatusOK
+	if !healthy {
+		resp.Status = HealthResponseStatusDOWN
+		status = http.StatusServiceUnavailable
+	}
+
+	writeJSON(w, status, resp)
+}
+
+// LivenessCheck reports whether the process is alive.
+// (GET /v1/liveness)
+func (h *RequestHandler) LivenessCheck(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, LivenessResponse{
+		Status:    LivenessResponseStatusOK,
+		Timestamp: time.Now().UTC(),
+		Version:   h.version,
+	})
+}
+
+// ReadinessCheck reports whether the service is ready to accept traffic.
+// (GET /v1/readiness)
+func (h *RequestHandler) ReadinessCheck(w http.ResponseWriter, r *http.Request) {
+	checks, healthy := h.checkDependencies(r.Context(), false)
+
+	resp := ReadinessResponse{
+		Status:    OK,
+		Timestamp: time.Now().UTC(),
+		Version:   &h.version,
+	}
+
+	if err := convert(checks, &resp.Checks); err != nil {
+		renderError(w, r, err)
+
+		return
+	}
+
+	status := http.StatusOK
+	if !healthy {
+		resp.Status = DOWN
+		status = http.StatusServiceUnavailable
+	}
+
+	writeJSON(w, status, resp)
+}
+
+// pageParams returns the page of results and the number of results per page asked for, by
+// default the first page of defaultPagesLimit results.
+func pageParams(page, limit *int) (int, int, error) {
+	p, l := 1, defaultPagesLimit
+
+	if page != nil {
+		p = *page
+	}
+
+	if limit != nil {
+		l = *limit
+	}
+
+	if p < 1 {
+		return 0, 0, &APIError{Status: http.StatusBadRequest, Code: errCodeInvalidParameter,
+			Message: "A parameter of the request is not valid", Details: "The 'page' parameter must be at least 1"}
+	}
+
+	if l < 1 || l > maxPagesLimit {
+		return 0, 0, &APIError{Status: http.StatusBadRequest, Code: errCodeInvalidParameter,
+			Message: "A parameter of the request is not valid",
+			Details: fmt.Sprintf("The 'limit' parameter must be between 1 and %d", maxPagesLimit)}
+	}
+
+	return p, l, nil
+}
+
+func parseAnalyzeRequest(body AnalyzeURLJSONRequestBody) (string, domain.Options, error) {
+	opts := domain.DefaultOptions()
+
+	target, err := url.Parse(strings.TrimSpace(body.Url))
+	if err != nil {
+		return "", opts, fmt.Errorf("%w: %v", domain.ErrInvalidURL, err)
+	}
+
+	if (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return "", opts, fmt.Errorf("%w: URL must be a valid HTTP or HTTPS URL", domain.ErrInvalidURL)
+	}
+
+	if o := body.Options; o != nil {
+		if o.IncludeHeadings != nil {
+			opts.IncludeHeadings = *o.IncludeHeadings
+		}
+
+		if o.CheckLinks != nil {
+			opts.CheckLinks = *o.CheckLinks
+		}
+
+		if o.DetectForms != nil {
+			opts.DetectForms = *o.DetectForms
+		}
+
+		if o.Timeout != nil {
+			opts.Timeout = time.Duration(*o.Timeout) * time.Second
+			if opts.Timeout < domain.MinTimeout || opts.Timeout > domain.MaxTimeout {
+				return "", opts, fmt.Errorf("%w: Timeout must be between %d and %d seconds",
+					domain.ErrInvalidOptions, int(domain.MinTimeout.Seconds()), int(domain.MaxTimeout.Seconds()))
+			}
+		}
+
+		if r := o.Render; r != nil {
+			render := domain.RenderOptions{WaitFor: domain.WaitNetworkIdle, Delay: domain.DefaultRenderDelay}
+
+			if r.WaitFor != nil {
+				render.WaitFor = string(*r.WaitFor)
+			}
+
+			if r.Selector != nil {
+				render.Selector = strings.TrimSpace(*r.Selector)
+			}
+
+			if r.DelayMs != nil {
+				render.Delay = time.Duration(*r.DelayMs) * time.Millisecond
+			}
+
+			switch render.WaitFor {
+			case domain.WaitLoad, domain.WaitNetworkIdle:
+				render.Selector, render.Delay = "", 0
+			case domain.WaitSelector:
+				if render.Selector == "" {
+					return "", opts, fmt.Errorf("%w: A selector is required to wait for one", domain.ErrInvalidOptions)
+				}
+
+				render.Delay = 0
+			case domain.WaitDelay:
+				if render.Delay < 0 || render.Delay > domain.MaxRenderDelay {
+					return "", opts, fmt.Errorf("%w: Delay must be between 0 and %d milliseconds",
+						domain.ErrInvalidOptions, domain.MaxRenderDelay.Milliseconds())
+				}
+
+				render.Selector = ""
+			default:
+				return "", opts, fmt.Errorf("%w: Unsupported wait condition %q", domain.ErrInvalidOptions, render.WaitFor)
+			}
+
+			opts.Render = &render
+		}
+
+		if c := o.Crawl; c != nil {
+			crawl := domain.CrawlOptions{MaxDepth: domain.DefaultCrawlDepth, MaxPages: domain.DefaultCrawlPages}
+
+			if c.MaxDepth != nil {
+				crawl.MaxDepth = *c.MaxDepth
+			}
+
+			if c.MaxPages != nil {
+				crawl.MaxPages = *c.MaxPages
+			}
+
+			if crawl.MaxDepth < 0 || crawl.MaxDepth > domain.MaxCrawlDepth {
+				return "", opts, fmt.Errorf("%w: Max depth must be between 0 and %d", domain.ErrInvalidOptions, domain.MaxCrawlDepth)
+			}
+
+			if crawl.MaxPages < 1 || crawl.MaxPages > domain.MaxCrawlPages {
+				return "", opts, fmt.Errorf("%w: Max pages must be between 1 and %d", domain.ErrInvalidOptions, domain.MaxCrawlPages)
+			}
+
+			opts.Crawl = &crawl
+		}
+	}
+
+	return target.String(), opts, nil
+}
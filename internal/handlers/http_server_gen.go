@@ -27,11 +27,36 @@ const (
 	PasetoAuthScopes = "PasetoAuth.Scopes"
 )
 
+// Defines values for AnalysisDataEncodingSource.
+const (
+	AnalysisDataEncodingSourceBom     AnalysisDataEncodingSource = "bom"
+	AnalysisDataEncodingSourceDefault AnalysisDataEncodingSource = "default"
+	AnalysisDataEncodingSourceHeader  AnalysisDataEncodingSource = "header"
+	AnalysisDataEncodingSourceMeta    AnalysisDataEncodingSource = "meta"
+)
+
 // Defines values for AnalysisDataFormsLoginFormDetailsMethod.
 const (
+	AnalysisDataFormsLoginFormDetailsMethodGET  AnalysisDataFormsLoginFormDetailsMethod = "GET"
 	AnalysisDataFormsLoginFormDetailsMethodPOST AnalysisDataFormsLoginFormDetailsMethod = "POST"
 )
 
+// Defines values for AnalysisDataLinksInaccessibleLinksErrorCode.
+const (
+	AnalysisDataLinksInaccessibleLinksErrorCodeBlockedTarget     AnalysisDataLinksInaccessibleLinksErrorCode = "blocked_target"
+	AnalysisDataLinksInaccessibleLinksErrorCodeCanceled          AnalysisDataLinksInaccessibleLinksErrorCode = "canceled"
+	AnalysisDataLinksInaccessibleLinksErrorCodeConnectionRefused AnalysisDataLinksInaccessibleLinksErrorCode = "connection_refused"
+	AnalysisDataLinksInaccessibleLinksErrorCodeConnectionReset   AnalysisDataLinksInaccessibleLinksErrorCode = "connection_reset"
+	AnalysisDataLinksInaccessibleLinksErrorCodeDnsError          AnalysisDataLinksInaccessibleLinksErrorCode = "dns_error"
+	AnalysisDataLinksInaccessibleLinksErrorCodeHostUnreachable   AnalysisDataLinksInaccessibleLinksErrorCode = "host_unreachable"
+	AnalysisDataLinksInaccessibleLinksErrorCodeHttpError         AnalysisDataLinksInaccessibleLinksErrorCode = "http_error"
+	AnalysisDataLinksInaccessibleLinksErrorCodeInvalidUrl        AnalysisDataLinksInaccessibleLinksErrorCode = "invalid_url"
+	AnalysisDataLinksInaccessibleLinksErrorCodeNetworkError      AnalysisDataLinksInaccessibleLinksErrorCode = "network_error"
+	AnalysisDataLinksInaccessibleLinksErrorCodeTimeout           AnalysisDataLinksInaccessibleLinksErrorCode = "timeout"
+	AnalysisDataLinksInaccessibleLinksErrorCodeTlsError          AnalysisDataLinksInaccessibleLinksErrorCode = "tls_error"
+	AnalysisDataLinksInaccessibleLinksErrorCodeTooManyRedirects  AnalysisDataLinksInaccessibleLinksErrorCode = "too_many_redirects"
+)
+
 // Defines values for AnalysisErrorStatus.
 const (
 	AnalysisErrorStatusFailed AnalysisErrorStatus = "failed"
@@ -39,7 +64,7 @@ const (
 
 // Defines values for AnalysisInProgressStatus.
 const (
-	InProgress AnalysisInProgressStatus = "in_progress"
+	AnalysisInProgressStatusInProgress AnalysisInProgressStatus = "in_progress"
 )
 
 // Defines values for AnalysisResponseStatus.
@@ -50,14 +75,91 @@ const (
 	AnalysisResponseStatusRequested  AnalysisResponseStatus = "requested"
 )
 
+// Defines values for AnalysisResultResultsEncodingSource.
+const (
+	AnalysisResultResultsEncodingSourceBom     AnalysisResultResultsEncodingSource = "bom"
+	AnalysisResultResultsEncodingSourceDefault AnalysisResultResultsEncodingSource = "default"
+	AnalysisResultResultsEncodingSourceHeader  AnalysisResultResultsEncodingSource = "header"
+	AnalysisResultResultsEncodingSourceMeta    AnalysisResultResultsEncodingSource = "meta"
+)
+
 // Defines values for AnalysisResultResultsFormsLoginFormDetailsMethod.
 const (
+	AnalysisResultResultsFormsLoginFormDetailsMethodGET  AnalysisResultResultsFormsLoginFormDetailsMethod = "GET"
 	AnalysisResultResultsFormsLoginFormDetailsMethodPOST AnalysisResultResultsFormsLoginFormDetailsMethod = "POST"
 )
 
+// Defines values for AnalysisResultResultsLinksInaccessibleLinksErrorCode.
+const (
+	AnalysisResultResultsLinksInaccessibleLinksErrorCodeBlockedTarget     AnalysisResultResultsLinksInaccessibleLinksErrorCode = "blocked_target"
+	AnalysisResultResultsLinksInaccessibleLinksErrorCodeCanceled          AnalysisResultResultsLinksInaccessibleLinksErrorCode = "canceled"
+	AnalysisResultResultsLinksInaccessibleLinksErrorCodeConnectionRefused AnalysisResultResultsLinksInaccessibleLinksErrorCode = "connection_refused"
+	AnalysisResultResultsLinksInaccessibleLinksErrorCodeConnectionReset   AnalysisResultResultsLinksInaccessibleLinksErrorCode = "connection_reset"
+	AnalysisResultResultsLinksInaccessibleLinksErrorCodeDnsError          AnalysisResultResultsLinksInaccessibleLinksErrorCode = "dns_error"
+	AnalysisResultResultsLinksInaccessibleLinksErrorCodeHostUnreachable   AnalysisResultResultsLinksInaccessibleLinksErrorCode = "host_unreachable"
+	AnalysisResultResultsLinksInaccessibleLinksErrorCodeHttpError         AnalysisResultResultsLinksInaccessibleLinksErrorCode = "http_error"
+	AnalysisResultResultsLinksInaccessibleLinksErrorCodeInvalidUrl        AnalysisResultResultsLinksInaccessibleLinksErrorCode = "invalid_url"
+	AnalysisResultResultsLinksInaccessibleLinksErrorCodeNetworkError      AnalysisResultResultsLinksInaccessibleLinksErrorCode = "network_error"
+	AnalysisResultResultsLinksInaccessibleLinksErrorCodeTimeout           AnalysisResultResultsLinksInaccessibleLinksErrorCode = "timeout"
+	AnalysisResultResultsLinksInaccessibleLinksErrorCodeTlsError          AnalysisResultResultsLinksInaccessibleLinksErrorCode = "tls_error"
+	AnalysisResultResultsLinksInaccessibleLinksErrorCodeTooManyRedirects  AnalysisResultResultsLinksInaccessibleLinksErrorCode = "too_many_redirects"
+)
+
 // Defines values for AnalysisResultStatus.
 const (
-	Completed AnalysisResultStatus = "completed"
+	AnalysisResultStatusCompleted AnalysisResultStatus = "completed"
+)
+
+// Defines values for AnalyzeRequestOptionsRenderWaitFor.
+const (
+	AnalyzeRequestOptionsRenderWaitForDelay       AnalyzeRequestOptionsRenderWaitFor = "delay"
+	AnalyzeRequestOptionsRenderWaitForLoad        AnalyzeRequestOptionsRenderWaitFor = "load"
+	AnalyzeRequestOptionsRenderWaitForNetworkIdle AnalyzeRequestOptionsRenderWaitFor = "network_idle"
+	AnalyzeRequestOptionsRenderWaitForSelector    AnalyzeRequestOptionsRenderWaitFor = "selector"
+)
+
+// Defines values for BatchAnalyzeRequestItemsOptionsRenderWaitFor.
+const (
+	BatchAnalyzeRequestItemsOptionsRenderWaitForDelay       BatchAnalyzeRequestItemsOptionsRenderWaitFor = "delay"
+	BatchAnalyzeRequestItemsOptionsRenderWaitForLoad        BatchAnalyzeRequestItemsOptionsRenderWaitFor = "load"
+	BatchAnalyzeRequestItemsOptionsRenderWaitForNetworkIdle BatchAnalyzeRequestItemsOptionsRenderWaitFor = "network_idle"
+	BatchAnalyzeRequestItemsOptionsRenderWaitForSelector    BatchAnalyzeRequestItemsOptionsRenderWaitFor = "selector"
+)
+
+// Defines values for BatchAnalyzeRequestOptionsRenderWaitFor.
+const (
+	BatchAnalyzeRequestOptionsRenderWaitForDelay       BatchAnalyzeRequestOptionsRenderWaitFor = "delay"
+	BatchAnalyzeRequestOptionsRenderWaitForLoad        BatchAnalyzeRequestOptionsRenderWaitFor = "load"
+	BatchAnalyzeRequestOptionsRenderWaitForNetworkIdle BatchAnalyzeRequestOptionsRenderWaitFor = "network_idle"
+	BatchAnalyzeRequestOptionsRenderWaitForSelector    BatchAnalyzeRequestOptionsRenderWaitFor = "selector"
+)
+
+// Defines values for BatchResponseItemsStatus.
+const (
+	BatchResponseItemsStatusCompleted  BatchResponseItemsStatus = "completed"
+	BatchResponseItemsStatusFailed     BatchResponseItemsStatus = "failed"
+	BatchResponseItemsStatusInProgress BatchResponseItemsStatus = "in_progress"
+	BatchResponseItemsStatusRequested  BatchResponseItemsStatus = "requested"
+)
+
+// Defines values for BatchResponseStatus.
+const (
+	BatchResponseStatusCompleted  BatchResponseStatus = "completed"
+	BatchResponseStatusInProgress BatchResponseStatus = "in_progress"
+)
+
+// Defines values for BatchStatusItemsStatus.
+const (
+	BatchStatusItemsStatusCompleted  BatchStatusItemsStatus = "completed"
+	BatchStatusItemsStatusFailed     BatchStatusItemsStatus = "failed"
+	BatchStatusItemsStatusInProgress BatchStatusItemsStatus = "in_progress"
+	BatchStatusItemsStatusRequested  BatchStatusItemsStatus = "requested"
+)
+
+// Defines values for BatchStatusStatus.
+const (
+	BatchStatusStatusCompleted  BatchStatusStatus = "completed"
+	BatchStatusStatusInProgress BatchStatusStatus = "in_progress"
 )
 
 // Defines values for CacheDependencyCheckStatus.
@@ -67,6 +169,66 @@ const (
 	CacheDependencyCheckStatusUnknown   CacheDependencyCheckStatus = "unknown"
 )
 
+// Defines values for CrawlPageListPagesResultsEncodingSource.
+const (
+	CrawlPageListPagesResultsEncodingSourceBom     CrawlPageListPagesResultsEncodingSource = "bom"
+	CrawlPageListPagesResultsEncodingSourceDefault CrawlPageListPagesResultsEncodingSource = "default"
+	CrawlPageListPagesResultsEncodingSourceHeader  CrawlPageListPagesResultsEncodingSource = "header"
+	CrawlPageListPagesResultsEncodingSourceMeta    CrawlPageListPagesResultsEncodingSource = "meta"
+)
+
+// Defines values for CrawlPageListPagesResultsFormsLoginFormDetailsMethod.
+const (
+	CrawlPageListPagesResultsFormsLoginFormDetailsMethodGET  CrawlPageListPagesResultsFormsLoginFormDetailsMethod = "GET"
+	CrawlPageListPagesResultsFormsLoginFormDetailsMethodPOST CrawlPageListPagesResultsFormsLoginFormDetailsMethod = "POST"
+)
+
+// Defines values for CrawlPageListPagesResultsLinksInaccessibleLinksErrorCode.
+const (
+	CrawlPageListPagesResultsLinksInaccessibleLinksErrorCodeBlockedTarget     CrawlPageListPagesResultsLinksInaccessibleLinksErrorCode = "blocked_target"
+	CrawlPageListPagesResultsLinksInaccessibleLinksErrorCodeCanceled          CrawlPageListPagesResultsLinksInaccessibleLinksErrorCode = "canceled"
+	CrawlPageListPagesResultsLinksInaccessibleLinksErrorCodeConnectionRefused CrawlPageListPagesResultsLinksInaccessibleLinksErrorCode = "connection_refused"
+	CrawlPageListPagesResultsLinksInaccessibleLinksErrorCodeConnectionReset   CrawlPageListPagesResultsLinksInaccessibleLinksErrorCode = "connection_reset"
+	CrawlPageListPagesResultsLinksInaccessibleLinksErrorCodeDnsError          CrawlPageListPagesResultsLinksInaccessibleLinksErrorCode = "dns_error"
+	CrawlPageListPagesResultsLinksInaccessibleLinksErrorCodeHostUnreachable   CrawlPageListPagesResultsLinksInaccessibleLinksErrorCode = "host_unreachable"
+	CrawlPageListPagesResultsLinksInaccessibleLinksErrorCodeHttpError         CrawlPageListPagesResultsLinksInaccessibleLinksErrorCode = "http_error"
+	CrawlPageListPagesResultsLinksInaccessibleLinksErrorCodeInvalidUrl        CrawlPageListPagesResultsLinksInaccessibleLinksErrorCode = "invalid_url"
+	CrawlPageListPagesResultsLinksInaccessibleLinksErrorCodeNetworkError      CrawlPageListPagesResultsLinksInaccessibleLinksErrorCode = "network_error"
+	CrawlPageListPagesResultsLinksInaccessibleLinksErrorCodeTimeout           CrawlPageListPagesResultsLinksInaccessibleLinksErrorCode = "timeout"
+	CrawlPageListPagesResultsLinksInaccessibleLinksErrorCodeTlsError          CrawlPageListPagesResultsLinksInaccessibleLinksErrorCode = "tls_error"
+	CrawlPageListPagesResultsLinksInaccessibleLinksErrorCodeTooManyRedirects  CrawlPageListPagesResultsLinksInaccessibleLinksErrorCode = "too_many_redirects"
+)
+
+// Defines values for CrawledPageResultsEncodingSource.
+const (
+	CrawledPageResultsEncodingSourceBom     CrawledPageResultsEncodingSource = "bom"
+	CrawledPageResultsEncodingSourceDefault CrawledPageResultsEncodingSource = "default"
+	CrawledPageResultsEncodingSourceHeader  CrawledPageResultsEncodingSource = "header"
+	CrawledPageResultsEncodingSourceMeta    CrawledPageResultsEncodingSource = "meta"
+)
+
+// Defines values for CrawledPageResultsFormsLoginFormDetailsMethod.
+const (
+	CrawledPageResultsFormsLoginFormDetailsMethodGET  CrawledPageResultsFormsLoginFormDetailsMethod = "GET"
+	CrawledPageResultsFormsLoginFormDetailsMethodPOST CrawledPageResultsFormsLoginFormDetailsMethod = "POST"
+)
+
+// Defines values for CrawledPageResultsLinksInaccessibleLinksErrorCode.
+const (
+	CrawledPageResultsLinksInaccessibleLinksErrorCodeBlockedTarget     CrawledPageResultsLinksInaccessibleLinksErrorCode = "blocked_target"
+	CrawledPageResultsLinksInaccessibleLinksErrorCodeCanceled          CrawledPageResultsLinksInaccessibleLinksErrorCode = "canceled"
+	CrawledPageResultsLinksInaccessibleLinksErrorCodeConnectionRefused CrawledPageResultsLinksInaccessibleLinksErrorCode = "connection_refused"
+	CrawledPageResultsLinksInaccessibleLinksErrorCodeConnectionReset   CrawledPageResultsLinksInaccessibleLinksErrorCode = "connection_reset"
+	CrawledPageResultsLinksInaccessibleLinksErrorCodeDnsError          CrawledPageResultsLinksInaccessibleLinksErrorCode = "dns_error"
+	CrawledPageResultsLinksInaccessibleLinksErrorCodeHostUnreachable   CrawledPageResultsLinksInaccessibleLinksErrorCode = "host_unreachable"
+	CrawledPageResultsLinksInaccessibleLinksErrorCodeHttpError         CrawledPageResultsLinksInaccessibleLinksErrorCode = "http_error"
+	CrawledPageResultsLinksInaccessibleLinksErrorCodeInvalidUrl        CrawledPageResultsLinksInaccessibleLinksErrorCode = "invalid_url"
+	CrawledPageResultsLinksInaccessibleLinksErrorCodeNetworkError      CrawledPageResultsLinksInaccessibleLinksErrorCode = "network_error"
+	CrawledPageResultsLinksInaccessibleLinksErrorCodeTimeout           CrawledPageResultsLinksInaccessibleLinksErrorCode = "timeout"
+	CrawledPageResultsLinksInaccessibleLinksErrorCodeTlsError          CrawledPageResultsLinksInaccessibleLinksErrorCode = "tls_error"
+	CrawledPageResultsLinksInaccessibleLinksErrorCodeTooManyRedirects  CrawledPageResultsLinksInaccessibleLinksErrorCode = "too_many_redirects"
+)
+
 // Defines values for DependencyCheckStatus.
 const (
 	DependencyCheckStatusHealthy   DependencyCheckStatus = "healthy"
@@ -76,6 +238,7 @@ const (
 
 // Defines values for FormAnalysisLoginFormDetailsMethod.
 const (
+	FormAnalysisLoginFormDetailsMethodGET  FormAnalysisLoginFormDetailsMethod = "GET"
 	FormAnalysisLoginFormDetailsMethodPOST FormAnalysisLoginFormDetailsMethod = "POST"
 )
 
@@ -114,6 +277,44 @@ const (
 	HealthResponseStatusOK          HealthResponseStatus = "OK"
 )
 
+// Defines values for InaccessibleLinkErrorCode.
+const (
+	InaccessibleLinkErrorCodeBlockedTarget     InaccessibleLinkErrorCode = "blocked_target"
+	InaccessibleLinkErrorCodeCanceled          InaccessibleLinkErrorCode = "canceled"
+	InaccessibleLinkErrorCodeConnectionRefused InaccessibleLinkErrorCode = "connection_refused"
+	InaccessibleLinkErrorCodeConnectionReset   InaccessibleLinkErrorCode = "connection_reset"
+	InaccessibleLinkErrorCodeDnsError          InaccessibleLinkErrorCode = "dns_error"
+	InaccessibleLinkErrorCodeHostUnreachable   InaccessibleLinkErrorCode = "host_unreachable"
+	InaccessibleLinkErrorCodeHttpError         InaccessibleLinkErrorCode = "http_error"
+	InaccessibleLinkErrorCodeInvalidUrl        InaccessibleLinkErrorCode = "invalid_url"
+	InaccessibleLinkErrorCodeNetworkError      InaccessibleLinkErrorCode = "network_error"
+	InaccessibleLinkErrorCodeTimeout           InaccessibleLinkErrorCode = "timeout"
+	InaccessibleLinkErrorCodeTlsError          InaccessibleLinkErrorCode = "tls_error"
+	InaccessibleLinkErrorCodeTooManyRedirects  InaccessibleLinkErrorCode = "too_many_redirects"
+)
+
+// Defines values for IssueTokenRequestScopes.
+const (
+	IssueTokenRequestScopesAnalysisRead  IssueTokenRequestScopes = "analysis:read"
+	IssueTokenRequestScopesAnalysisWrite IssueTokenRequestScopes = "analysis:write"
+)
+
+// Defines values for LinkAnalysisInaccessibleLinksErrorCode.
+const (
+	LinkAnalysisInaccessibleLinksErrorCodeBlockedTarget     LinkAnalysisInaccessibleLinksErrorCode = "blocked_target"
+	LinkAnalysisInaccessibleLinksErrorCodeCanceled          LinkAnalysisInaccessibleLinksErrorCode = "canceled"
+	LinkAnalysisInaccessibleLinksErrorCodeConnectionRefused LinkAnalysisInaccessibleLinksErrorCode = "connection_refused"
+	LinkAnalysisInaccessibleLinksErrorCodeConnectionReset   LinkAnalysisInaccessibleLinksErrorCode = "connection_reset"
+	LinkAnalysisInaccessibleLinksErrorCodeDnsError          LinkAnalysisInaccessibleLinksErrorCode = "dns_error"
+	LinkAnalysisInaccessibleLinksErrorCodeHostUnreachable   LinkAnalysisInaccessibleLinksErrorCode = "host_unreachable"
+	LinkAnalysisInaccessibleLinksErrorCodeHttpError         LinkAnalysisInaccessibleLinksErrorCode = "http_error"
+	LinkAnalysisInaccessibleLinksErrorCodeInvalidUrl        LinkAnalysisInaccessibleLinksErrorCode = "invalid_url"
+	LinkAnalysisInaccessibleLinksErrorCodeNetworkError      LinkAnalysisInaccessibleLinksErrorCode = "network_error"
+	LinkAnalysisInaccessibleLinksErrorCodeTimeout           LinkAnalysisInaccessibleLinksErrorCode = "timeout"
+	LinkAnalysisInaccessibleLinksErrorCodeTlsError          LinkAnalysisInaccessibleLinksErrorCode = "tls_error"
+	LinkAnalysisInaccessibleLinksErrorCodeTooManyRedirects  LinkAnalysisInaccessibleLinksErrorCode = "too_many_redirects"
+)
+
 // Defines values for LivenessResponseStatus.
 const (
 	LivenessResponseStatusDOWN        LivenessResponseStatus = "DOWN"
@@ -123,6 +324,7 @@ const (
 
 // Defines values for LoginFormMethod.
 const (
+	LoginFormMethodGET  LoginFormMethod = "GET"
 	LoginFormMethodPOST LoginFormMethod = "POST"
 )
 
@@ -173,6 +375,12 @@ const (
 	ApiVersionHeaderV1 ApiVersionHeader = "v1"
 )
 
+// Defines values for IssueTokenJSONBodyScopes.
+const (
+	IssueTokenJSONBodyScopesAnalysisRead  IssueTokenJSONBodyScopes = "analysis:read"
+	IssueTokenJSONBodyScopesAnalysisWrite IssueTokenJSONBodyScopes = "analysis:write"
+)
+
 // Defines values for GetAnalysisParamsAPIVersion.
 const (
 	GetAnalysisParamsAPIVersionV1 GetAnalysisParamsAPIVersion = "v1"
@@ -183,22 +391,118 @@ const (
 	GetAnalysisEventsParamsAPIVersionV1 GetAnalysisEventsParamsAPIVersion = "v1"
 )
 
+// Defines values for GetAnalysisPagesParamsAPIVersion.
+const (
+	GetAnalysisPagesParamsAPIVersionV1 GetAnalysisPagesParamsAPIVersion = "v1"
+)
+
 // Defines values for AnalyzeURLParamsAPIVersion.
 const (
 	AnalyzeURLParamsAPIVersionV1 AnalyzeURLParamsAPIVersion = "v1"
 )
 
+// Defines values for AnalyzeURLJSONBodyOptionsRenderWaitFor.
+const (
+	AnalyzeURLJSONBodyOptionsRenderWaitForDelay       AnalyzeURLJSONBodyOptionsRenderWaitFor = "delay"
+	AnalyzeURLJSONBodyOptionsRenderWaitForLoad        AnalyzeURLJSONBodyOptionsRenderWaitFor = "load"
+	AnalyzeURLJSONBodyOptionsRenderWaitForNetworkIdle AnalyzeURLJSONBodyOptionsRenderWaitFor = "network_idle"
+	AnalyzeURLJSONBodyOptionsRenderWaitForSelector    AnalyzeURLJSONBodyOptionsRenderWaitFor = "selector"
+)
+
+// Defines values for AnalyzeBatchParamsAPIVersion.
+const (
+	AnalyzeBatchParamsAPIVersionV1 AnalyzeBatchParamsAPIVersion = "v1"
+)
+
+// Defines values for AnalyzeBatchJSONBodyItemsOptionsRenderWaitFor.
+const (
+	AnalyzeBatchJSONBodyItemsOptionsRenderWaitForDelay       AnalyzeBatchJSONBodyItemsOptionsRenderWaitFor = "delay"
+	AnalyzeBatchJSONBodyItemsOptionsRenderWaitForLoad        AnalyzeBatchJSONBodyItemsOptionsRenderWaitFor = "load"
+	AnalyzeBatchJSONBodyItemsOptionsRenderWaitForNetworkIdle AnalyzeBatchJSONBodyItemsOptionsRenderWaitFor = "network_idle"
+	AnalyzeBatchJSONBodyItemsOptionsRenderWaitForSelector    AnalyzeBatchJSONBodyItemsOptionsRenderWaitFor = "selector"
+)
+
+// Defines values for AnalyzeBatchJSONBodyOptionsRenderWaitFor.
+const (
+	AnalyzeBatchJSONBodyOptionsRenderWaitForDelay       AnalyzeBatchJSONBodyOptionsRenderWaitFor = "delay"
+	AnalyzeBatchJSONBodyOptionsRenderWaitForLoad        AnalyzeBatchJSONBodyOptionsRenderWaitFor = "load"
+	AnalyzeBatchJSONBodyOptionsRenderWaitForNetworkIdle AnalyzeBatchJSONBodyOptionsRenderWaitFor = "network_idle"
+	AnalyzeBatchJSONBodyOptionsRenderWaitForSelector    AnalyzeBatchJSONBodyOptionsRenderWaitFor = "selector"
+)
+
+// Defines values for GetBatchParamsAPIVersion.
+const (
+	GetBatchParamsAPIVersionV1 GetBatchParamsAPIVersion = "v1"
+)
+
+// Defines values for GetBatchEventsParamsAPIVersion.
+const (
+	GetBatchEventsParamsAPIVersionV1 GetBatchEventsParamsAPIVersion = "v1"
+)
+
 // AnalysisData defines model for AnalysisData.
 type AnalysisData struct {
-	Forms *struct {
+	// Crawl Aggregate of the pages of a crawl, reported along with the results of the page submitted.
+	// The counts of the checks disabled by the options of the analysis are zero.
+	Crawl *struct {
+		// BrokenLinks Distinct inaccessible links found on the pages, when links are checked
+		BrokenLinks int `json:"broken_links"`
+
+		// MaxDepthReached Largest number of links between the page submitted and a page crawled
+		MaxDepthReached int `json:"max_depth_reached"`
+
+		// PagesCrawled Pages retrieved, the page submitted included
+		PagesCrawled int `json:"pages_crawled"`
+
+		// PagesDisallowed Pages robots.txt kept from being crawled
+		PagesDisallowed int `json:"pages_disallowed"`
+
+		// PagesFailed Pages crawled that could not be analyzed, such as pages not found
+		PagesFailed int `json:"pages_failed"`
+
+		// PagesWithLoginForms Pages with a login form, when forms are detected
+		PagesWithLoginForms int `json:"pages_with_login_forms"`
+
+		// PagesWithoutH1 Pages without a `h1` heading, when headings are included
+		PagesWithoutH1 int `json:"pages_without_h1"`
+
+		// Truncated Whether pages were left uncrawled, once `max_pages` or the time limit of the crawl was
+		// reached
+		Truncated bool `json:"truncated"`
+	} `json:"crawl,omitempty"`
+
+	// Encoding Character encoding the page was transcoded to UTF-8 from before being parsed
+	Encoding *struct {
+		// Charset Canonical name of the encoding
+		Charset string `json:"charset"`
+
+		// HeaderCharset Charset declared by the Content-Type header, as written
+		HeaderCharset *string `json:"header_charset,omitempty"`
+
+		// MetaCharset Charset declared by the page, as written
+		MetaCharset *string `json:"meta_charset,omitempty"`
+
+		// Mismatch Whether the header and the page declare different encodings
+		Mismatch bool `json:"mismatch"`
+
+		// Source Where the encoding was detected from: the byte order mark, the charset of the
+		// Content-Type header or the `<meta charset>` and `http-equiv` declarations of the page.
+		// Pages declaring no known encoding are read as UTF-8 when they are valid UTF-8, and as
+		// windows-1252 otherwise.
+		Source AnalysisDataEncodingSource `json:"source"`
+	} `json:"encoding,omitempty"`
+
+	// Extensions Sections contributed by additional analyzers, and the failures of any analyzer as an object with an `error` message, keyed by analyzer name
+	Extensions *map[string]interface{} `json:"extensions,omitempty"`
+	Forms      *struct {
 		LoginFormDetails *[]struct {
-			// Action Form action URL
+			// Action Absolute form action URL
 			Action *string `json:"action,omitempty"`
 
 			// Fields Form field names
 			Fields *[]string `json:"fields,omitempty"`
 
-			// Method Form submission method
+			// Method Form submission method. GET login forms expose credentials in URLs and logs.
 			Method *AnalysisDataFormsLoginFormDetailsMethod `json:"method,omitempty"`
 		} `json:"login_form_details,omitempty"`
 
@@ -226,6 +530,9 @@ type AnalysisData struct {
 			// Error Error description
 			Error *string `json:"error,omitempty"`
 
+			// ErrorCode Machine readable reason the link is inaccessible
+			ErrorCode *AnalysisDataLinksInaccessibleLinksErrorCode `json:"error_code,omitempty"`
+
 			// StatusCode HTTP status code received
 			StatusCode *int    `json:"status_code,omitempty"`
 			Url        *string `json:"url,omitempty"`
@@ -242,9 +549,18 @@ type AnalysisData struct {
 	Title *string `json:"title,omitempty"`
 }
 
-// AnalysisDataFormsLoginFormDetailsMethod Form submission method
+// AnalysisDataEncodingSource Where the encoding was detected from: the byte order mark, the charset of the
+// Content-Type header or the `<meta charset>` and `http-equiv` declarations of the page.
+// Pages declaring no known encoding are read as UTF-8 when they are valid UTF-8, and as
+// windows-1252 otherwise.
+type AnalysisDataEncodingSource string
+
+// AnalysisDataFormsLoginFormDetailsMethod Form submission method. GET login forms expose credentials in URLs and logs.
 type AnalysisDataFormsLoginFormDetailsMethod string
 
+// AnalysisDataLinksInaccessibleLinksErrorCode Machine readable reason the link is inaccessible
+type AnalysisDataLinksInaccessibleLinksErrorCode string
+
 // AnalysisError defines model for AnalysisError.
 type AnalysisError struct {
 	AnalysisId *openapi_types.UUID `json:"analysis_id,omitempty"`
@@ -314,15 +630,67 @@ type AnalysisResult struct {
 	// Duration Analysis duration
 	Duration *string `json:"duration,omitempty"`
 	Results  *struct {
-		Forms *struct {
+		// Crawl Aggregate of the pages of a crawl, reported along with the results of the page submitted.
+		// The counts of the checks disabled by the options of the analysis are zero.
+		Crawl *struct {
+			// BrokenLinks Distinct inaccessible links found on the pages, when links are checked
+			BrokenLinks int `json:"broken_links"`
+
+			// MaxDepthReached Largest number of links between the page submitted and a page crawled
+			MaxDepthReached int `json:"max_depth_reached"`
+
+			// PagesCrawled Pages retrieved, the page submitted included
+			PagesCrawled int `json:"pages_crawled"`
+
+			// PagesDisallowed Pages robots.txt kept from being crawled
+			PagesDisallowed int `json:"pages_disallowed"`
+
+			// PagesFailed Pages crawled that could not be analyzed, such as pages not found
+			PagesFailed int `json:"pages_failed"`
+
+			// PagesWithLoginForms Pages with a login form, when forms are detected
+			PagesWithLoginForms int `json:"pages_with_login_forms"`
+
+			// PagesWithoutH1 Pages without a `h1` heading, when headings are included
+			PagesWithoutH1 int `json:"pages_without_h1"`
+
+			// Truncated Whether pages were left uncrawled, once `max_pages` or the time limit of the crawl was
+			// reached
+			Truncated bool `json:"truncated"`
+		} `json:"crawl,omitempty"`
+
+		// Encoding Character encoding the page was transcoded to UTF-8 from before being parsed
+		Encoding *struct {
+			// Charset Canonical name of the encoding
+			Charset string `json:"charset"`
+
+			// HeaderCharset Charset declared by the Content-Type header, as written
+			HeaderCharset *string `json:"header_charset,omitempty"`
+
+			// MetaCharset Charset declared by the page, as written
+			MetaCharset *string `json:"meta_charset,omitempty"`
+
+			// Mismatch Whether the header and the page declare different encodings
+			Mismatch bool `json:"mismatch"`
+
+			// Source Where the encoding was detected from: the byte order mark, the charset of the
+			// Content-Type header or the `<meta charset>` and `http-equiv` declarations of the page.
+			// Pages declaring no known encoding are read as UTF-8 when they are valid UTF-8, and as
+			// windows-1252 otherwise.
+			Source AnalysisResultResultsEncodingSource `json:"source"`
+		} `json:"encoding,omitempty"`
+
+		// Extensions Sections contributed by additional analyzers, and the failures of any analyzer as an object with an `error` message, keyed by analyzer name
+		Extensions *map[string]interface{} `json:"extensions,omitempty"`
+		Forms      *struct {
 			LoginFormDetails *[]struct {
-				// Action Form action URL
+				// Action Absolute form action URL
 				Action *string `json:"action,omitempty"`
 
 				// Fields Form field names
 				Fields *[]string `json:"fields,omitempty"`
 
-				// Method Form submission method
+				// Method Form submission method. GET login forms expose credentials in URLs and logs.
 				Method *AnalysisResultResultsFormsLoginFormDetailsMethod `json:"method,omitempty"`
 			} `json:"login_form_details,omitempty"`
 
@@ -350,6 +718,9 @@ type AnalysisResult struct {
 				// Error Error description
 				Error *string `json:"error,omitempty"`
 
+				// ErrorCode Machine readable reason the link is inaccessible
+				ErrorCode *AnalysisResultResultsLinksInaccessibleLinksErrorCode `json:"error_code,omitempty"`
+
 				// StatusCode HTTP status code received
 				StatusCode *int    `json:"status_code,omitempty"`
 				Url        *string `json:"url,omitempty"`
@@ -369,9 +740,18 @@ type AnalysisResult struct {
 	Url    *string               `json:"url,omitempty"`
 }
 
-// AnalysisResultResultsFormsLoginFormDetailsMethod Form submission method
+// AnalysisResultResultsEncodingSource Where the encoding was detected from: the byte order mark, the charset of the
+// Content-Type header or the `<meta charset>` and `http-equiv` declarations of the page.
+// Pages declaring no known encoding are read as UTF-8 when they are valid UTF-8, and as
+// windows-1252 otherwise.
+type AnalysisResultResultsEncodingSource string
+
+// AnalysisResultResultsFormsLoginFormDetailsMethod Form submission method. GET login forms expose credentials in URLs and logs.
 type AnalysisResultResultsFormsLoginFormDetailsMethod string
 
+// AnalysisResultResultsLinksInaccessibleLinksErrorCode Machine readable reason the link is inaccessible
+type AnalysisResultResultsLinksInaccessibleLinksErrorCode string
+
 // AnalysisResultStatus defines model for AnalysisResult.Status.
 type AnalysisResultStatus string
 
@@ -381,12 +761,44 @@ type AnalyzeRequest struct {
 		// CheckLinks Whether to check link accessibility
 		CheckLinks *bool `json:"check_links,omitempty"`
 
+		// Crawl Crawls the site from the page, following its internal links breadth first, and
+		// analyzes every page crawled with the same options, `timeout` applying to each page.
+		// Pages disallowed by robots.txt are skipped and requests are spaced by its
+		// `Crawl-delay`; the page submitted is analyzed regardless. The results describe the
+		// page submitted, with a `crawl` summary of the site; the pages are listed by
+		// `GET /v1/analysis/{analysisId}/pages`.
+		Crawl *struct {
+			// MaxDepth How many links away from the page submitted the pages crawled may be
+			MaxDepth *int `json:"max_depth,omitempty"`
+
+			// MaxPages How many pages are analyzed, the page submitted included
+			MaxPages *int `json:"max_pages,omitempty"`
+		} `json:"crawl,omitempty"`
+
 		// DetectForms Whether to detect login forms
 		DetectForms *bool `json:"detect_forms,omitempty"`
 
 		// IncludeHeadings Whether to include heading analysis
 		IncludeHeadings *bool `json:"include_headings,omitempty"`
 
+		// Render Renders the page in a headless browser, running its scripts, before analyzing it, for
+		// single-page applications and other pages built by JavaScript. The page is still
+		// fetched first, for its status and encoding. Requests of the page to non-public
+		// addresses fail. Rejected with `invalid_options` when rendering is not enabled on the
+		// server.
+		Render *struct {
+			// DelayMs Milliseconds waited after the load event with `wait_for` `delay`
+			DelayMs *int `json:"delay_ms,omitempty"`
+
+			// Selector CSS selector of the element awaited, required with `wait_for` `selector`
+			Selector *string `json:"selector,omitempty"`
+
+			// WaitFor What the page is awaited on before it is analyzed: its `load` event, no network
+			// connection for 500 ms (`network_idle`), an element matching `selector`, or
+			// `delay_ms` milliseconds after its load event
+			WaitFor *AnalyzeRequestOptionsRenderWaitFor `json:"wait_for,omitempty"`
+		} `json:"render,omitempty"`
+
 		// Timeout Request timeout in seconds
 		Timeout *int `json:"timeout,omitempty"`
 	} `json:"options,omitempty"`
@@ -395,6 +807,246 @@ type AnalyzeRequest struct {
 	Url string `json:"url"`
 }
 
+// AnalyzeRequestOptionsRenderWaitFor What the page is awaited on before it is analyzed: its `load` event, no network
+// connection for 500 ms (`network_idle`), an element matching `selector`, or
+// `delay_ms` milliseconds after its load event
+type AnalyzeRequestOptionsRenderWaitFor string
+
+// BatchAnalyzeRequest The pages of a batch, given either as `items` or as the `sitemap_url` listing them, but
+// not both.
+type BatchAnalyzeRequest struct {
+	// Items The pages to analyze, each with its own options
+	Items *[]struct {
+		Options *struct {
+			// CheckLinks Whether to check link accessibility
+			CheckLinks *bool `json:"check_links,omitempty"`
+
+			// Crawl Crawls the site from the page, following its internal links breadth first, and
+			// analyzes every page crawled with the same options, `timeout` applying to each page.
+			// Pages disallowed by robots.txt are skipped and requests are spaced by its
+			// `Crawl-delay`; the page submitted is analyzed regardless. The results describe the
+			// page submitted, with a `crawl` summary of the site; the pages are listed by
+			// `GET /v1/analysis/{analysisId}/pages`.
+			Crawl *struct {
+				// MaxDepth How many links away from the page submitted the pages crawled may be
+				MaxDepth *int `json:"max_depth,omitempty"`
+
+				// MaxPages How many pages are analyzed, the page submitted included
+				MaxPages *int `json:"max_pages,omitempty"`
+			} `json:"crawl,omitempty"`
+
+			// DetectForms Whether to detect login forms
+			DetectForms *bool `json:"detect_forms,omitempty"`
+
+			// IncludeHeadings Whether to include heading analysis
+			IncludeHeadings *bool `json:"include_headings,omitempty"`
+
+			// Render Renders the page in a headless browser, running its scripts, before analyzing it, for
+			// single-page applications and other pages built by JavaScript. The page is still
+			// fetched first, for its status and encoding. Requests of the page to non-public
+			// addresses fail. Rejected with `invalid_options` when rendering is not enabled on the
+			// server.
+			Render *struct {
+				// DelayMs Milliseconds waited after the load event with `wait_for` `delay`
+				DelayMs *int `json:"delay_ms,omitempty"`
+
+				// Selector CSS selector of the element awaited, required with `wait_for` `selector`
+				Selector *string `json:"selector,omitempty"`
+
+				// WaitFor What the page is awaited on before it is analyzed: its `load` event, no network
+				// connection for 500 ms (`network_idle`), an element matching `selector`, or
+				// `delay_ms` milliseconds after its load event
+				WaitFor *BatchAnalyzeRequestItemsOptionsRenderWaitFor `json:"wait_for,omitempty"`
+			} `json:"render,omitempty"`
+
+			// Timeout Request timeout in seconds
+			Timeout *int `json:"timeout,omitempty"`
+		} `json:"options,omitempty"`
+
+		// Url The URL to analyze (supports absolute URLs, relative paths, and internal links)
+		Url string `json:"url"`
+	} `json:"items,omitempty"`
+	Options *struct {
+		// CheckLinks Whether to check link accessibility
+		CheckLinks *bool `json:"check_links,omitempty"`
+
+		// Crawl Crawls the site from the page, following its internal links breadth first, and
+		// analyzes every page crawled with the same options, `timeout` applying to each page.
+		// Pages disallowed by robots.txt are skipped and requests are spaced by its
+		// `Crawl-delay`; the page submitted is analyzed regardless. The results describe the
+		// page submitted, with a `crawl` summary of the site; the pages are listed by
+		// `GET /v1/analysis/{analysisId}/pages`.
+		Crawl *struct {
+			// MaxDepth How many links away from the page submitted the pages crawled may be
+			MaxDepth *int `json:"max_depth,omitempty"`
+
+			// MaxPages How many pages are analyzed, the page submitted included
+			MaxPages *int `json:"max_pages,omitempty"`
+		} `json:"crawl,omitempty"`
+
+		// DetectForms Whether to detect login forms
+		DetectForms *bool `json:"detect_forms,omitempty"`
+
+		// IncludeHeadings Whether to include heading analysis
+		IncludeHeadings *bool `json:"include_headings,omitempty"`
+
+		// Render Renders the page in a headless browser, running its scripts, before analyzing it, for
+		// single-page applications and other pages built by JavaScript. The page is still
+		// fetched first, for its status and encoding. Requests of the page to non-public
+		// addresses fail. Rejected with `invalid_options` when rendering is not enabled on the
+		// server.
+		Render *struct {
+			// DelayMs Milliseconds waited after the load event with `wait_for` `delay`
+			DelayMs *int `json:"delay_ms,omitempty"`
+
+			// Selector CSS selector of the element awaited, required with `wait_for` `selector`
+			Selector *string `json:"selector,omitempty"`
+
+			// WaitFor What the page is awaited on before it is analyzed: its `load` event, no network
+			// connection for 500 ms (`network_idle`), an element matching `selector`, or
+			// `delay_ms` milliseconds after its load event
+			WaitFor *BatchAnalyzeRequestOptionsRenderWaitFor `json:"wait_for,omitempty"`
+		} `json:"render,omitempty"`
+
+		// Timeout Request timeout in seconds
+		Timeout *int `json:"timeout,omitempty"`
+	} `json:"options,omitempty"`
+
+	// SitemapUrl Sitemap listing the pages to analyze, or sitemap index listing such sitemaps, gzipped
+	// or not. It may list at most 500 pages.
+	SitemapUrl *string `json:"sitemap_url,omitempty"`
+}
+
+// BatchAnalyzeRequestItemsOptionsRenderWaitFor What the page is awaited on before it is analyzed: its `load` event, no network
+// connection for 500 ms (`network_idle`), an element matching `selector`, or
+// `delay_ms` milliseconds after its load event
+type BatchAnalyzeRequestItemsOptionsRenderWaitFor string
+
+// BatchAnalyzeRequestOptionsRenderWaitFor What the page is awaited on before it is analyzed: its `load` event, no network
+// connection for 500 ms (`network_idle`), an element matching `selector`, or
+// `delay_ms` milliseconds after its load event
+type BatchAnalyzeRequestOptionsRenderWaitFor string
+
+// BatchProgress The analyses of the batch by status. Analyses removed once their retention period is over
+// are not counted.
+type BatchProgress struct {
+	Completed  int `json:"completed"`
+	Failed     int `json:"failed"`
+	InProgress int `json:"in_progress"`
+
+	// Percentage Mean progress of the analyses, the finished ones counting as 100
+	Percentage int `json:"percentage"`
+	Requested  int `json:"requested"`
+	Total      int `json:"total"`
+}
+
+// BatchResponse defines model for BatchResponse.
+type BatchResponse struct {
+	// BatchId Unique identifier for the batch
+	BatchId   openapi_types.UUID `json:"batch_id"`
+	CreatedAt time.Time          `json:"created_at"`
+
+	// Items The analyses of the batch, in the order of the request or of the sitemap
+	Items []struct {
+		// AnalysisId Unique identifier for the analysis
+		AnalysisId *openapi_types.UUID `json:"analysis_id,omitempty"`
+
+		// CreatedAt When the analysis was created
+		CreatedAt *time.Time `json:"created_at,omitempty"`
+
+		// EstimatedCompletionTime Estimated time to completion
+		EstimatedCompletionTime *string `json:"estimated_completion_time,omitempty"`
+
+		// Status Current status of the analysis
+		Status *BatchResponseItemsStatus `json:"status,omitempty"`
+
+		// Url The URL being analyzed
+		Url *string `json:"url,omitempty"`
+	} `json:"items"`
+
+	// Progress The analyses of the batch by status. Analyses removed once their retention period is over
+	// are not counted.
+	Progress struct {
+		Completed  int `json:"completed"`
+		Failed     int `json:"failed"`
+		InProgress int `json:"in_progress"`
+
+		// Percentage Mean progress of the analyses, the finished ones counting as 100
+		Percentage int `json:"percentage"`
+		Requested  int `json:"requested"`
+		Total      int `json:"total"`
+	} `json:"progress"`
+
+	// SitemapUrl The sitemap the pages were listed by
+	SitemapUrl *string `json:"sitemap_url,omitempty"`
+
+	// Status Completed once all the analyses of the batch completed or failed
+	Status BatchResponseStatus `json:"status"`
+}
+
+// BatchResponseItemsStatus Current status of the analysis
+type BatchResponseItemsStatus string
+
+// BatchResponseStatus Completed once all the analyses of the batch completed or failed
+type BatchResponseStatus string
+
+// BatchStatus defines model for BatchStatus.
+type BatchStatus struct {
+	BatchId   openapi_types.UUID `json:"batch_id"`
+	CreatedAt time.Time          `json:"created_at"`
+
+	// Items Analyses of the batch, in submission order
+	Items []struct {
+		// AnalysisId Unique identifier for the analysis
+		AnalysisId *openapi_types.UUID `json:"analysis_id,omitempty"`
+
+		// CreatedAt When the analysis was created
+		CreatedAt *time.Time `json:"created_at,omitempty"`
+
+		// EstimatedCompletionTime Estimated time to completion
+		EstimatedCompletionTime *string `json:"estimated_completion_time,omitempty"`
+
+		// Status Current status of the analysis
+		Status *BatchStatusItemsStatus `json:"status,omitempty"`
+
+		// Url The URL being analyzed
+		Url *string `json:"url,omitempty"`
+	} `json:"items"`
+	Pagination struct {
+		HasNext     *bool `json:"has_next,omitempty"`
+		HasPrevious *bool `json:"has_previous,omitempty"`
+		Limit       *int  `json:"limit,omitempty"`
+		Page        *int  `json:"page,omitempty"`
+		TotalCount  *int  `json:"total_count,omitempty"`
+		TotalPages  *int  `json:"total_pages,omitempty"`
+	} `json:"pagination"`
+
+	// Progress The analyses of the batch by status. Analyses removed once their retention period is over
+	// are not counted.
+	Progress struct {
+		Completed  int `json:"completed"`
+		Failed     int `json:"failed"`
+		InProgress int `json:"in_progress"`
+
+		// Percentage Mean progress of the analyses, the finished ones counting as 100
+		Percentage int `json:"percentage"`
+		Requested  int `json:"requested"`
+		Total      int `json:"total"`
+	} `json:"progress"`
+
+	// SitemapUrl The sitemap the pages were listed by
+	SitemapUrl *string `json:"sitemap_url,omitempty"`
+
+	// Status Completed once all the analyses of the batch completed or failed
+	Status BatchStatusStatus `json:"status"`
+}
+
+// BatchStatusItemsStatus Current status of the analysis
+type BatchStatusItemsStatus string
+
+// BatchStatusStatus Completed once all the analyses of the batch completed or failed
+type BatchStatusStatus string
+
 // CacheDependencyCheck defines model for CacheDependencyCheck.
 type CacheDependencyCheck struct {
 	Details *CacheDependencyCheck_Details `json:"details,omitempty"`
@@ -448,6 +1100,328 @@ type CacheDependencyCheck_Details struct {
 // CacheDependencyCheckStatus Health status of the dependency
 type CacheDependencyCheckStatus string
 
+// CrawlPageList defines model for CrawlPageList.
+type CrawlPageList struct {
+	AnalysisId openapi_types.UUID `json:"analysis_id"`
+
+	// Pages Pages of the crawl, in crawl order
+	Pages []struct {
+		CrawledAt time.Time `json:"crawled_at"`
+
+		// Depth How many links away from the page submitted the page was found
+		Depth int `json:"depth"`
+
+		// Error Why the page could not be analyzed
+		Error *struct {
+			Details *string `json:"details,omitempty"`
+
+			// Error Error code, as reported for a failed analysis
+			Error          string `json:"error"`
+			ErrorMessage   string `json:"error_message"`
+			HttpStatusCode int    `json:"http_status_code"`
+		} `json:"error,omitempty"`
+		Results *struct {
+			// Crawl Aggregate of the pages of a crawl, reported along with the results of the page submitted.
+			// The counts of the checks disabled by the options of the analysis are zero.
+			Crawl *struct {
+				// BrokenLinks Distinct inaccessible links found on the pages, when links are checked
+				BrokenLinks int `json:"broken_links"`
+
+				// MaxDepthReached Largest number of links between the page submitted and a page crawled
+				MaxDepthReached int `json:"max_depth_reached"`
+
+				// PagesCrawled Pages retrieved, the page submitted included
+				PagesCrawled int `json:"pages_crawled"`
+
+				// PagesDisallowed Pages robots.txt kept from being crawled
+				PagesDisallowed int `json:"pages_disallowed"`
+
+				// PagesFailed Pages crawled that could not be analyzed, such as pages not found
+				PagesFailed int `json:"pages_failed"`
+
+				// PagesWithLoginForms Pages with a login form, when forms are detected
+				PagesWithLoginForms int `json:"pages_with_login_forms"`
+
+				// PagesWithoutH1 Pages without a `h1` heading, when headings are included
+				PagesWithoutH1 int `json:"pages_without_h1"`
+
+				// Truncated Whether pages were left uncrawled, once `max_pages` or the time limit of the crawl was
+				// reached
+				Truncated bool `json:"truncated"`
+			} `json:"crawl,omitempty"`
+
+			// Encoding Character encoding the page was transcoded to UTF-8 from before being parsed
+			Encoding *struct {
+				// Charset Canonical name of the encoding
+				Charset string `json:"charset"`
+
+				// HeaderCharset Charset declared by the Content-Type header, as written
+				HeaderCharset *string `json:"header_charset,omitempty"`
+
+				// MetaCharset Charset declared by the page, as written
+				MetaCharset *string `json:"meta_charset,omitempty"`
+
+				// Mismatch Whether the header and the page declare different encodings
+				Mismatch bool `json:"mismatch"`
+
+				// Source Where the encoding was detected from: the byte order mark, the charset of the
+				// Content-Type header or the `<meta charset>` and `http-equiv` declarations of the page.
+				// Pages declaring no known encoding are read as UTF-8 when they are valid UTF-8, and as
+				// windows-1252 otherwise.
+				Source CrawlPageListPagesResultsEncodingSource `json:"source"`
+			} `json:"encoding,omitempty"`
+
+			// Extensions Sections contributed by additional analyzers, and the failures of any analyzer as an object with an `error` message, keyed by analyzer name
+			Extensions *map[string]interface{} `json:"extensions,omitempty"`
+			Forms      *struct {
+				LoginFormDetails *[]struct {
+					// Action Absolute form action URL
+					Action *string `json:"action,omitempty"`
+
+					// Fields Form field names
+					Fields *[]string `json:"fields,omitempty"`
+
+					// Method Form submission method. GET login forms expose credentials in URLs and logs.
+					Method *CrawlPageListPagesResultsFormsLoginFormDetailsMethod `json:"method,omitempty"`
+				} `json:"login_form_details,omitempty"`
+
+				// LoginFormsDetected Number of login forms detected
+				LoginFormsDetected *int `json:"login_forms_detected,omitempty"`
+
+				// TotalCount Total number of forms found
+				TotalCount *int `json:"total_count,omitempty"`
+			} `json:"forms,omitempty"`
+			HeadingCounts *struct {
+				H1 *int `json:"h1,omitempty"`
+				H2 *int `json:"h2,omitempty"`
+				H3 *int `json:"h3,omitempty"`
+				H4 *int `json:"h4,omitempty"`
+				H5 *int `json:"h5,omitempty"`
+				H6 *int `json:"h6,omitempty"`
+			} `json:"heading_counts,omitempty"`
+
+			// HtmlVersion Detected HTML version
+			HtmlVersion *string `json:"html_version,omitempty"`
+			Links       *struct {
+				// ExternalCount Number of external links
+				ExternalCount     *int `json:"external_count,omitempty"`
+				InaccessibleLinks *[]struct {
+					// Error Error description
+					Error *string `json:"error,omitempty"`
+
+					// ErrorCode Machine readable reason the link is inaccessible
+					ErrorCode *CrawlPageListPagesResultsLinksInaccessibleLinksErrorCode `json:"error_code,omitempty"`
+
+					// StatusCode HTTP status code received
+					StatusCode *int    `json:"status_code,omitempty"`
+					Url        *string `json:"url,omitempty"`
+				} `json:"inaccessible_links,omitempty"`
+
+				// InternalCount Number of internal links
+				InternalCount *int `json:"internal_count,omitempty"`
+
+				// TotalCount Total number of links
+				TotalCount *int `json:"total_count,omitempty"`
+			} `json:"links,omitempty"`
+
+			// Title Page title
+			Title *string `json:"title,omitempty"`
+		} `json:"results,omitempty"`
+
+		// Url Address of the page, after redirects
+		Url string `json:"url"`
+	} `json:"pages"`
+	Pagination struct {
+		HasNext     *bool `json:"has_next,omitempty"`
+		HasPrevious *bool `json:"has_previous,omitempty"`
+		Limit       *int  `json:"limit,omitempty"`
+		Page        *int  `json:"page,omitempty"`
+		TotalCount  *int  `json:"total_count,omitempty"`
+		TotalPages  *int  `json:"total_pages,omitempty"`
+	} `json:"pagination"`
+}
+
+// CrawlPageListPagesResultsEncodingSource Where the encoding was detected from: the byte order mark, the charset of the
+// Content-Type header or the `<meta charset>` and `http-equiv` declarations of the page.
+// Pages declaring no known encoding are read as UTF-8 when they are valid UTF-8, and as
+// windows-1252 otherwise.
+type CrawlPageListPagesResultsEncodingSource string
+
+// CrawlPageListPagesResultsFormsLoginFormDetailsMethod Form submission method. GET login forms expose credentials in URLs and logs.
+type CrawlPageListPagesResultsFormsLoginFormDetailsMethod string
+
+// CrawlPageListPagesResultsLinksInaccessibleLinksErrorCode Machine readable reason the link is inaccessible
+type CrawlPageListPagesResultsLinksInaccessibleLinksErrorCode string
+
+// CrawlSummary Aggregate of the pages of a crawl, reported along with the results of the page submitted.
+// The counts of the checks disabled by the options of the analysis are zero.
+type CrawlSummary struct {
+	// BrokenLinks Distinct inaccessible links found on the pages, when links are checked
+	BrokenLinks int `json:"broken_links"`
+
+	// MaxDepthReached Largest number of links between the page submitted and a page crawled
+	MaxDepthReached int `json:"max_depth_reached"`
+
+	// PagesCrawled Pages retrieved, the page submitted included
+	PagesCrawled int `json:"pages_crawled"`
+
+	// PagesDisallowed Pages robots.txt kept from being crawled
+	PagesDisallowed int `json:"pages_disallowed"`
+
+	// PagesFailed Pages crawled that could not be analyzed, such as pages not found
+	PagesFailed int `json:"pages_failed"`
+
+	// PagesWithLoginForms Pages with a login form, when forms are detected
+	PagesWithLoginForms int `json:"pages_with_login_forms"`
+
+	// PagesWithoutH1 Pages without a `h1` heading, when headings are included
+	PagesWithoutH1 int `json:"pages_without_h1"`
+
+	// Truncated Whether pages were left uncrawled, once `max_pages` or the time limit of the crawl was
+	// reached
+	Truncated bool `json:"truncated"`
+}
+
+// CrawledPage A page of a crawl, with its results or why it could not be analyzed
+type CrawledPage struct {
+	CrawledAt time.Time `json:"crawled_at"`
+
+	// Depth How many links away from the page submitted the page was found
+	Depth int `json:"depth"`
+
+	// Error Why the page could not be analyzed
+	Error *struct {
+		Details *string `json:"details,omitempty"`
+
+		// Error Error code, as reported for a failed analysis
+		Error          string `json:"error"`
+		ErrorMessage   string `json:"error_message"`
+		HttpStatusCode int    `json:"http_status_code"`
+	} `json:"error,omitempty"`
+	Results *struct {
+		// Crawl Aggregate of the pages of a crawl, reported along with the results of the page submitted.
+		// The counts of the checks disabled by the options of the analysis are zero.
+		Crawl *struct {
+			// BrokenLinks Distinct inaccessible links found on the pages, when links are checked
+			BrokenLinks int `json:"broken_links"`
+
+			// MaxDepthReached Largest number of links between the page submitted and a page crawled
+			MaxDepthReached int `json:"max_depth_reached"`
+
+			// PagesCrawled Pages retrieved, the page submitted included
+			PagesCrawled int `json:"pages_crawled"`
+
+			// PagesDisallowed Pages robots.txt kept from being crawled
+			PagesDisallowed int `json:"pages_disallowed"`
+
+			// PagesFailed Pages crawled that could not be analyzed, such as pages not found
+			PagesFailed int `json:"pages_failed"`
+
+			// PagesWithLoginForms Pages with a login form, when forms are detected
+			PagesWithLoginForms int `json:"pages_with_login_forms"`
+
+			// PagesWithoutH1 Pages without a `h1` heading, when headings are included
+			PagesWithoutH1 int `json:"pages_without_h1"`
+
+			// Truncated Whether pages were left uncrawled, once `max_pages` or the time limit of the crawl was
+			// reached
+			Truncated bool `json:"truncated"`
+		} `json:"crawl,omitempty"`
+
+		// Encoding Character encoding the page was transcoded to UTF-8 from before being parsed
+		Encoding *struct {
+			// Charset Canonical name of the encoding
+			Charset string `json:"charset"`
+
+			// HeaderCharset Charset declared by the Content-Type header, as written
+			HeaderCharset *string `json:"header_charset,omitempty"`
+
+			// MetaCharset Charset declared by the page, as written
+			MetaCharset *string `json:"meta_charset,omitempty"`
+
+			// Mismatch Whether the header and the page declare different encodings
+			Mismatch bool `json:"mismatch"`
+
+			// Source Where the encoding was detected from: the byte order mark, the charset of the
+			// Content-Type header or the `<meta charset>` and `http-equiv` declarations of the page.
+			// Pages declaring no known encoding are read as UTF-8 when they are valid UTF-8, and as
+			// windows-1252 otherwise.
+			Source CrawledPageResultsEncodingSource `json:"source"`
+		} `json:"encoding,omitempty"`
+
+		// Extensions Sections contributed by additional analyzers, and the failures of any analyzer as an object with an `error` message, keyed by analyzer name
+		Extensions *map[string]interface{} `json:"extensions,omitempty"`
+		Forms      *struct {
+			LoginFormDetails *[]struct {
+				// Action Absolute form action URL
+				Action *string `json:"action,omitempty"`
+
+				// Fields Form field names
+				Fields *[]string `json:"fields,omitempty"`
+
+				// Method Form submission method. GET login forms expose credentials in URLs and logs.
+				Method *CrawledPageResultsFormsLoginFormDetailsMethod `json:"method,omitempty"`
+			} `json:"login_form_details,omitempty"`
+
+			// LoginFormsDetected Number of login forms detected
+			LoginFormsDetected *int `json:"login_forms_detected,omitempty"`
+
+			// TotalCount Total number of forms found
+			TotalCount *int `json:"total_count,omitempty"`
+		} `json:"forms,omitempty"`
+		HeadingCounts *struct {
+			H1 *int `json:"h1,omitempty"`
+			H2 *int `json:"h2,omitempty"`
+			H3 *int `json:"h3,omitempty"`
+			H4 *int `json:"h4,omitempty"`
+			H5 *int `json:"h5,omitempty"`
+			H6 *int `json:"h6,omitempty"`
+		} `json:"heading_counts,omitempty"`
+
+		// HtmlVersion Detected HTML version
+		HtmlVersion *string `json:"html_version,omitempty"`
+		Links       *struct {
+			// ExternalCount Number of external links
+			ExternalCount     *int `json:"external_count,omitempty"`
+			InaccessibleLinks *[]struct {
+				// Error Error description
+				Error *string `json:"error,omitempty"`
+
+				// ErrorCode Machine readable reason the link is inaccessible
+				ErrorCode *CrawledPageResultsLinksInaccessibleLinksErrorCode `json:"error_code,omitempty"`
+
+				// StatusCode HTTP status code received
+				StatusCode *int    `json:"status_code,omitempty"`
+				Url        *string `json:"url,omitempty"`
+			} `json:"inaccessible_links,omitempty"`
+
+			// InternalCount Number of internal links
+			InternalCount *int `json:"internal_count,omitempty"`
+
+			// TotalCount Total number of links
+			TotalCount *int `json:"total_count,omitempty"`
+		} `json:"links,omitempty"`
+
+		// Title Page title
+		Title *string `json:"title,omitempty"`
+	} `json:"results,omitempty"`
+
+	// Url Address of the page, after redirects
+	Url string `json:"url"`
+}
+
+// CrawledPageResultsEncodingSource Where the encoding was detected from: the byte order mark, the charset of the
+// Content-Type header or the `<meta charset>` and `http-equiv` declarations of the page.
+// Pages declaring no known encoding are read as UTF-8 when they are valid UTF-8, and as
+// windows-1252 otherwise.
+type CrawledPageResultsEncodingSource string
+
+// CrawledPageResultsFormsLoginFormDetailsMethod Form submission method. GET login forms expose credentials in URLs and logs.
+type CrawledPageResultsFormsLoginFormDetailsMethod string
+
+// CrawledPageResultsLinksInaccessibleLinksErrorCode Machine readable reason the link is inaccessible
+type CrawledPageResultsLinksInaccessibleLinksErrorCode string
+
 // DependencyCheck defines model for DependencyCheck.
 type DependencyCheck struct {
 	// Error Error message if the dependency is unhealthy
@@ -465,6 +1439,10 @@ type DependencyCheckStatus string
 
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
+	// CorrelationId ID of the request, taken from its X-Request-Id header when present, under which the
+	// service logged it
+	CorrelationId *string `json:"correlation_id,omitempty"`
+
 	// Details Additional error details
 	Details *string `json:"details,omitempty"`
 
@@ -474,7 +1452,7 @@ type ErrorResponse struct {
 	// Message Human-readable error message
 	Message *string `json:"message,omitempty"`
 
-	// RetryAfter Seconds to wait before retrying (for rate limit errors)
+	// RetryAfter Seconds to wait before retrying, also sent in the Retry-After header
 	RetryAfter *int `json:"retry_after,omitempty"`
 
 	// StatusCode HTTP status code
@@ -485,13 +1463,13 @@ type ErrorResponse struct {
 // FormAnalysis defines model for FormAnalysis.
 type FormAnalysis struct {
 	LoginFormDetails *[]struct {
-		// Action Form action URL
+		// Action Absolute form action URL
 		Action *string `json:"action,omitempty"`
 
 		// Fields Form field names
 		Fields *[]string `json:"fields,omitempty"`
 
-		// Method Form submission method
+		// Method Form submission method. GET login forms expose credentials in URLs and logs.
 		Method *FormAnalysisLoginFormDetailsMethod `json:"method,omitempty"`
 	} `json:"login_form_details,omitempty"`
 
@@ -502,7 +1480,7 @@ type FormAnalysis struct {
 	TotalCount *int `json:"total_count,omitempty"`
 }
 
-// FormAnalysisLoginFormDetailsMethod Form submission method
+// FormAnalysisLoginFormDetailsMethod Form submission method. GET login forms expose credentials in URLs and logs.
 type FormAnalysisLoginFormDetailsMethod string
 
 // HealthResponse defines model for HealthResponse.
@@ -643,21 +1621,65 @@ type InaccessibleLink struct {
 	// Error Error description
 	Error *string `json:"error,omitempty"`
 
+	// ErrorCode Machine readable reason the link is inaccessible
+	ErrorCode *InaccessibleLinkErrorCode `json:"error_code,omitempty"`
+
 	// StatusCode HTTP status code received
 	StatusCode *int    `json:"status_code,omitempty"`
 	Url        *string `json:"url,omitempty"`
 }
 
-// LinkAnalysis defines model for LinkAnalysis.
-type LinkAnalysis struct {
-	// ExternalCount Number of external links
-	ExternalCount     *int `json:"external_count,omitempty"`
-	InaccessibleLinks *[]struct {
-		// Error Error description
-		Error *string `json:"error,omitempty"`
+// InaccessibleLinkErrorCode Machine readable reason the link is inaccessible
+type InaccessibleLinkErrorCode string
 
-		// StatusCode HTTP status code received
-		StatusCode *int    `json:"status_code,omitempty"`
+// IssueTokenRequest defines model for IssueTokenRequest.
+type IssueTokenRequest struct {
+	// ExpiresIn Lifetime of the token in seconds, up to AUTH_MAX_TOKEN_TTL
+	ExpiresIn *int `json:"expires_in,omitempty"`
+
+	// Scopes The operations the token grants
+	Scopes []IssueTokenRequestScopes `json:"scopes"`
+
+	// Subject The client the token is issued to, e.g. a CI job
+	Subject string `json:"subject"`
+
+	// Tenant The tenant the analyses of the client belong to; defaults to the subject
+	Tenant *string `json:"tenant,omitempty"`
+}
+
+// IssueTokenRequestScopes defines model for IssueTokenRequest.Scopes.
+type IssueTokenRequestScopes string
+
+// IssuedToken defines model for IssuedToken.
+type IssuedToken struct {
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// KeyId ID of the key that signed the token
+	KeyId   string   `json:"key_id"`
+	Scopes  []string `json:"scopes"`
+	Subject string   `json:"subject"`
+	Tenant  *string  `json:"tenant,omitempty"`
+
+	// Token The v4.public token, to send as a Bearer token
+	Token string `json:"token"`
+
+	// TokenId The jti claim of the token, to revoke it
+	TokenId string `json:"token_id"`
+}
+
+// LinkAnalysis defines model for LinkAnalysis.
+type LinkAnalysis struct {
+	// ExternalCount Number of external links
+	ExternalCount     *int `json:"external_count,omitempty"`
+	InaccessibleLinks *[]struct {
+		// Error Error description
+		Error *string `json:"error,omitempty"`
+
+		// ErrorCode Machine readable reason the link is inaccessible
+		ErrorCode *LinkAnalysisInaccessibleLinksErrorCode `json:"error_code,omitempty"`
+
+		// StatusCode HTTP status code received
+		StatusCode *int    `json:"status_code,omitempty"`
 		Url        *string `json:"url,omitempty"`
 	} `json:"inaccessible_links,omitempty"`
 
@@ -668,6 +1690,9 @@ type LinkAnalysis struct {
 	TotalCount *int `json:"total_count,omitempty"`
 }
 
+// LinkAnalysisInaccessibleLinksErrorCode Machine readable reason the link is inaccessible
+type LinkAnalysisInaccessibleLinksErrorCode string
+
 // LivenessResponse defines model for LivenessResponse.
 type LivenessResponse struct {
 	// Status Service liveness status
@@ -685,17 +1710,17 @@ type LivenessResponseStatus string
 
 // LoginForm defines model for LoginForm.
 type LoginForm struct {
-	// Action Form action URL
+	// Action Absolute form action URL
 	Action *string `json:"action,omitempty"`
 
 	// Fields Form field names
 	Fields *[]string `json:"fields,omitempty"`
 
-	// Method Form submission method
+	// Method Form submission method. GET login forms expose credentials in URLs and logs.
 	Method *LoginFormMethod `json:"method,omitempty"`
 }
 
-// LoginFormMethod Form submission method
+// LoginFormMethod Form submission method. GET login forms expose credentials in URLs and logs.
 type LoginFormMethod string
 
 // Pagination defines model for Pagination.
@@ -809,6 +1834,45 @@ type ReadinessResponse_Checks struct {
 // ReadinessResponseStatus Overall readiness status - ready only if all dependencies are healthy
 type ReadinessResponseStatus string
 
+// Revocation defines model for Revocation.
+type Revocation struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	RevokedAt time.Time `json:"revoked_at"`
+	TokenId   string    `json:"token_id"`
+}
+
+// RevocationList defines model for RevocationList.
+type RevocationList struct {
+	// Revocations The revocations in effect, most recent first
+	Revocations []struct {
+		ExpiresAt time.Time `json:"expires_at"`
+		RevokedAt time.Time `json:"revoked_at"`
+		TokenId   string    `json:"token_id"`
+	} `json:"revocations"`
+}
+
+// RevokeTokenRequest defines model for RevokeTokenRequest.
+type RevokeTokenRequest struct {
+	// ExpiresAt Expiration time of the token, past which the revocation is dropped. Defaults to the
+	// longest lifetime of the issued tokens from now.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// TokenId The jti claim of the token to revoke
+	TokenId string `json:"token_id"`
+}
+
+// SigningKey defines model for SigningKey.
+type SigningKey struct {
+	// KeyId PASERK identifier of the public key, to name it in AUTH_PUBLIC_KEYS and AUTH_SIGNING_KEY_ID
+	KeyId string `json:"key_id"`
+
+	// PublicKey PASERK serialised Ed25519 public key verifying the tokens
+	PublicKey string `json:"public_key"`
+
+	// SecretKey PASERK serialised Ed25519 secret key signing the tokens. It is not stored and is returned only once
+	SecretKey string `json:"secret_key"`
+}
+
 // HealthResponseV1DependencyCheck defines model for health-response.v1_DependencyCheck.
 type HealthResponseV1DependencyCheck struct {
 	// Details Additional dependency-specific information
@@ -852,6 +1916,25 @@ type BadRequest struct {
 	Timestamp  *time.Time `json:"timestamp,omitempty"`
 }
 
+// Forbidden defines model for forbidden.
+type Forbidden struct {
+	// Details Additional error details
+	Details *string `json:"details,omitempty"`
+
+	// Error Error code
+	Error *string `json:"error,omitempty"`
+
+	// Message Human-readable error message
+	Message *string `json:"message,omitempty"`
+
+	// RetryAfter Seconds to wait before retrying (for rate limit errors)
+	RetryAfter *int `json:"retry_after,omitempty"`
+
+	// StatusCode HTTP status code
+	StatusCode *int       `json:"status_code,omitempty"`
+	Timestamp  *time.Time `json:"timestamp,omitempty"`
+}
+
 // NotFound defines model for not_found.
 type NotFound struct {
 	// Details Additional error details
@@ -928,10 +2011,39 @@ type Unauthorized struct {
 	Timestamp  *time.Time `json:"timestamp,omitempty"`
 }
 
+// RevokeTokenJSONBody defines parameters for RevokeToken.
+type RevokeTokenJSONBody struct {
+	// ExpiresAt Expiration time of the token, past which the revocation is dropped. Defaults to the
+	// longest lifetime of the issued tokens from now.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// TokenId The jti claim of the token to revoke
+	TokenId string `json:"token_id"`
+}
+
+// IssueTokenJSONBody defines parameters for IssueToken.
+type IssueTokenJSONBody struct {
+	// ExpiresIn Lifetime of the token in seconds, up to AUTH_MAX_TOKEN_TTL
+	ExpiresIn *int `json:"expires_in,omitempty"`
+
+	// Scopes The operations the token grants
+	Scopes []IssueTokenJSONBodyScopes `json:"scopes"`
+
+	// Subject The client the token is issued to, e.g. a CI job
+	Subject string `json:"subject"`
+
+	// Tenant The tenant the analyses of the client belong to; defaults to the subject
+	Tenant *string `json:"tenant,omitempty"`
+}
+
+// IssueTokenJSONBodyScopes defines parameters for IssueToken.
+type IssueTokenJSONBodyScopes string
+
 // GetAnalysisParams defines parameters for GetAnalysis.
 type GetAnalysisParams struct {
 	// APIVersion API version to use for this request. If not specified, defaults to v1.
-	// Supported versions: v1
+	// Supported versions: v1. It must agree with the version of the path and of any
+	// vendor media type.
 	APIVersion *GetAnalysisParamsAPIVersion `json:"API-Version,omitempty"`
 }
 
@@ -941,25 +2053,78 @@ type GetAnalysisParamsAPIVersion string
 // GetAnalysisEventsParams defines parameters for GetAnalysisEvents.
 type GetAnalysisEventsParams struct {
 	// APIVersion API version to use for this request. If not specified, defaults to v1.
-	// Supported versions: v1
+	// Supported versions: v1. It must agree with the version of the path and of any
+	// vendor media type.
 	APIVersion *GetAnalysisEventsParamsAPIVersion `json:"API-Version,omitempty"`
+
+	// LastEventID ID of the last event received, to resume a stream after reconnecting
+	LastEventID *int64 `json:"Last-Event-ID,omitempty"`
 }
 
 // GetAnalysisEventsParamsAPIVersion defines parameters for GetAnalysisEvents.
 type GetAnalysisEventsParamsAPIVersion string
 
+// GetAnalysisPagesParams defines parameters for GetAnalysisPages.
+type GetAnalysisPagesParams struct {
+	// Page The page of results, starting from 1
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit How many crawled pages are listed per page of results
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// APIVersion API version to use for this request. If not specified, defaults to v1.
+	// Supported versions: v1. It must agree with the version of the path and of any
+	// vendor media type.
+	APIVersion *GetAnalysisPagesParamsAPIVersion `json:"API-Version,omitempty"`
+}
+
+// GetAnalysisPagesParamsAPIVersion defines parameters for GetAnalysisPages.
+type GetAnalysisPagesParamsAPIVersion string
+
 // AnalyzeURLJSONBody defines parameters for AnalyzeURL.
 type AnalyzeURLJSONBody struct {
 	Options *struct {
 		// CheckLinks Whether to check link accessibility
 		CheckLinks *bool `json:"check_links,omitempty"`
 
+		// Crawl Crawls the site from the page, following its internal links breadth first, and
+		// analyzes every page crawled with the same options, `timeout` applying to each page.
+		// Pages disallowed by robots.txt are skipped and requests are spaced by its
+		// `Crawl-delay`; the page submitted is analyzed regardless. The results describe the
+		// page submitted, with a `crawl` summary of the site; the pages are listed by
+		// `GET /v1/analysis/{analysisId}/pages`.
+		Crawl *struct {
+			// MaxDepth How many links away from the page submitted the pages crawled may be
+			MaxDepth *int `json:"max_depth,omitempty"`
+
+			// MaxPages How many pages are analyzed, the page submitted included
+			MaxPages *int `json:"max_pages,omitempty"`
+		} `json:"crawl,omitempty"`
+
 		// DetectForms Whether to detect login forms
 		DetectForms *bool `json:"detect_forms,omitempty"`
 
 		// IncludeHeadings Whether to include heading analysis
 		IncludeHeadings *bool `json:"include_headings,omitempty"`
 
+		// Render Renders the page in a headless browser, running its scripts, before analyzing it, for
+		// single-page applications and other pages built by JavaScript. The page is still
+		// fetched first, for its status and encoding. Requests of the page to non-public
+		// addresses fail. Rejected with `invalid_options` when rendering is not enabled on the
+		// server.
+		Render *struct {
+			// DelayMs Milliseconds waited after the load event with `wait_for` `delay`
+			DelayMs *int `json:"delay_ms,omitempty"`
+
+			// Selector CSS selector of the element awaited, required with `wait_for` `selector`
+			Selector *string `json:"selector,omitempty"`
+
+			// WaitFor What the page is awaited on before it is analyzed: its `load` event, no network
+			// connection for 500 ms (`network_idle`), an element matching `selector`, or
+			// `delay_ms` milliseconds after its load event
+			WaitFor *AnalyzeURLJSONBodyOptionsRenderWaitFor `json:"wait_for,omitempty"`
+		} `json:"render,omitempty"`
+
 		// Timeout Request timeout in seconds
 		Timeout *int `json:"timeout,omitempty"`
 	} `json:"options,omitempty"`
@@ -971,16 +2136,181 @@ type AnalyzeURLJSONBody struct {
 // AnalyzeURLParams defines parameters for AnalyzeURL.
 type AnalyzeURLParams struct {
 	// APIVersion API version to use for this request. If not specified, defaults to v1.
-	// Supported versions: v1
+	// Supported versions: v1. It must agree with the version of the path and of any
+	// vendor media type.
 	APIVersion *AnalyzeURLParamsAPIVersion `json:"API-Version,omitempty"`
 }
 
 // AnalyzeURLParamsAPIVersion defines parameters for AnalyzeURL.
 type AnalyzeURLParamsAPIVersion string
 
+// AnalyzeURLJSONBodyOptionsRenderWaitFor defines parameters for AnalyzeURL.
+type AnalyzeURLJSONBodyOptionsRenderWaitFor string
+
+// AnalyzeBatchJSONBody defines parameters for AnalyzeBatch.
+type AnalyzeBatchJSONBody struct {
+	// Items The pages to analyze, each with its own options
+	Items *[]struct {
+		Options *struct {
+			// CheckLinks Whether to check link accessibility
+			CheckLinks *bool `json:"check_links,omitempty"`
+
+			// Crawl Crawls the site from the page, following its internal links breadth first, and
+			// analyzes every page crawled with the same options, `timeout` applying to each page.
+			// Pages disallowed by robots.txt are skipped and requests are spaced by its
+			// `Crawl-delay`; the page submitted is analyzed regardless. The results describe the
+			// page submitted, with a `crawl` summary of the site; the pages are listed by
+			// `GET /v1/analysis/{analysisId}/pages`.
+			Crawl *struct {
+				// MaxDepth How many links away from the page submitted the pages crawled may be
+				MaxDepth *int `json:"max_depth,omitempty"`
+
+				// MaxPages How many pages are analyzed, the page submitted included
+				MaxPages *int `json:"max_pages,omitempty"`
+			} `json:"crawl,omitempty"`
+
+			// DetectForms Whether to detect login forms
+			DetectForms *bool `json:"detect_forms,omitempty"`
+
+			// IncludeHeadings Whether to include heading analysis
+			IncludeHeadings *bool `json:"include_headings,omitempty"`
+
+			// Render Renders the page in a headless browser, running its scripts, before analyzing it, for
+			// single-page applications and other pages built by JavaScript. The page is still
+			// fetched first, for its status and encoding. Requests of the page to non-public
+			// addresses fail. Rejected with `invalid_options` when rendering is not enabled on the
+			// server.
+			Render *struct {
+				// DelayMs Milliseconds waited after the load event with `wait_for` `delay`
+				DelayMs *int `json:"delay_ms,omitempty"`
+
+				// Selector CSS selector of the element awaited, required with `wait_for` `selector`
+				Selector *string `json:"selector,omitempty"`
+
+				// WaitFor What the page is awaited on before it is analyzed: its `load` event, no network
+				// connection for 500 ms (`network_idle`), an element matching `selector`, or
+				// `delay_ms` milliseconds after its load event
+				WaitFor *AnalyzeBatchJSONBodyItemsOptionsRenderWaitFor `json:"wait_for,omitempty"`
+			} `json:"render,omitempty"`
+
+			// Timeout Request timeout in seconds
+			Timeout *int `json:"timeout,omitempty"`
+		} `json:"options,omitempty"`
+
+		// Url The URL to analyze (supports absolute URLs, relative paths, and internal links)
+		Url string `json:"url"`
+	} `json:"items,omitempty"`
+	Options *struct {
+		// CheckLinks Whether to check link accessibility
+		CheckLinks *bool `json:"check_links,omitempty"`
+
+		// Crawl Crawls the site from the page, following its internal links breadth first, and
+		// analyzes every page crawled with the same options, `timeout` applying to each page.
+		// Pages disallowed by robots.txt are skipped and requests are spaced by its
+		// `Crawl-delay`; the page submitted is analyzed regardless. The results describe the
+		// page submitted, with a `crawl` summary of the site; the pages are listed by
+		// `GET /v1/analysis/{analysisId}/pages`.
+		Crawl *struct {
+			// MaxDepth How many links away from the page submitted the pages crawled may be
+			MaxDepth *int `json:"max_depth,omitempty"`
+
+			// MaxPages How many pages are analyzed, the page submitted included
+			MaxPages *int `json:"max_pages,omitempty"`
+		} `json:"crawl,omitempty"`
+
+		// DetectForms Whether to detect login forms
+		DetectForms *bool `json:"detect_forms,omitempty"`
+
+		// IncludeHeadings Whether to include heading analysis
+		IncludeHeadings *bool `json:"include_headings,omitempty"`
+
+		// Render Renders the page in a headless browser, running its scripts, before analyzing it, for
+		// single-page applications and other pages built by JavaScript. The page is still
+		// fetched first, for its status and encoding. Requests of the page to non-public
+		// addresses fail. Rejected with `invalid_options` when rendering is not enabled on the
+		// server.
+		Render *struct {
+			// DelayMs Milliseconds waited after the load event with `wait_for` `delay`
+			DelayMs *int `json:"delay_ms,omitempty"`
+
+			// Selector CSS selector of the element awaited, required with `wait_for` `selector`
+			Selector *string `json:"selector,omitempty"`
+
+			// WaitFor What the page is awaited on before it is analyzed: its `load` event, no network
+			// connection for 500 ms (`network_idle`), an element matching `selector`, or
+			// `delay_ms` milliseconds after its load event
+			WaitFor *AnalyzeBatchJSONBodyOptionsRenderWaitFor `json:"wait_for,omitempty"`
+		} `json:"render,omitempty"`
+
+		// Timeout Request timeout in seconds
+		Timeout *int `json:"timeout,omitempty"`
+	} `json:"options,omitempty"`
+
+	// SitemapUrl Sitemap listing the pages to analyze, or sitemap index listing such sitemaps, gzipped
+	// or not. It may list at most 500 pages.
+	SitemapUrl *string `json:"sitemap_url,omitempty"`
+}
+
+// AnalyzeBatchParams defines parameters for AnalyzeBatch.
+type AnalyzeBatchParams struct {
+	// APIVersion API version to use for this request. If not specified, defaults to v1.
+	// Supported versions: v1. It must agree with the version of the path and of any
+	// vendor media type.
+	APIVersion *AnalyzeBatchParamsAPIVersion `json:"API-Version,omitempty"`
+}
+
+// AnalyzeBatchParamsAPIVersion defines parameters for AnalyzeBatch.
+type AnalyzeBatchParamsAPIVersion string
+
+// AnalyzeBatchJSONBodyItemsOptionsRenderWaitFor defines parameters for AnalyzeBatch.
+type AnalyzeBatchJSONBodyItemsOptionsRenderWaitFor string
+
+// AnalyzeBatchJSONBodyOptionsRenderWaitFor defines parameters for AnalyzeBatch.
+type AnalyzeBatchJSONBodyOptionsRenderWaitFor string
+
+// GetBatchParams defines parameters for GetBatch.
+type GetBatchParams struct {
+	// Page The page of results, starting from 1
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit How many analyses are listed per page of results
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// APIVersion API version to use for this request. If not specified, defaults to v1.
+	// Supported versions: v1. It must agree with the version of the path and of any
+	// vendor media type.
+	APIVersion *GetBatchParamsAPIVersion `json:"API-Version,omitempty"`
+}
+
+// GetBatchParamsAPIVersion defines parameters for GetBatch.
+type GetBatchParamsAPIVersion string
+
+// GetBatchEventsParams defines parameters for GetBatchEvents.
+type GetBatchEventsParams struct {
+	// APIVersion API version to use for this request. If not specified, defaults to v1.
+	// Supported versions: v1. It must agree with the version of the path and of any
+	// vendor media type.
+	APIVersion *GetBatchEventsParamsAPIVersion `json:"API-Version,omitempty"`
+
+	// LastEventID ID of the last event received, to resume a stream after reconnecting
+	LastEventID *int64 `json:"Last-Event-ID,omitempty"`
+}
+
+// GetBatchEventsParamsAPIVersion defines parameters for GetBatchEvents.
+type GetBatchEventsParamsAPIVersion string
+
+// RevokeTokenJSONRequestBody defines body for RevokeToken for application/json ContentType.
+type RevokeTokenJSONRequestBody RevokeTokenJSONBody
+
+// IssueTokenJSONRequestBody defines body for IssueToken for application/json ContentType.
+type IssueTokenJSONRequestBody IssueTokenJSONBody
+
 // AnalyzeURLJSONRequestBody defines body for AnalyzeURL for application/json ContentType.
 type AnalyzeURLJSONRequestBody AnalyzeURLJSONBody
 
+// AnalyzeBatchJSONRequestBody defines body for AnalyzeBatch for application/json ContentType.
+type AnalyzeBatchJSONRequestBody AnalyzeBatchJSONBody
+
 // Getter for additional properties for CacheDependencyCheck_Details. Returns the specified
 // element and whether it was found
 func (a CacheDependencyCheck_Details) Get(fieldName string) (value interface{}, found bool) {
@@ -1465,15 +2795,39 @@ func (a ReadinessResponse_Checks) MarshalJSON() ([]byte, error) {
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Generate a signing key
+	// (POST /v1/admin/keys)
+	GenerateSigningKey(w http.ResponseWriter, r *http.Request)
+	// List revoked tokens
+	// (GET /v1/admin/revocations)
+	ListRevocations(w http.ResponseWriter, r *http.Request)
+	// Revoke a token
+	// (POST /v1/admin/revocations)
+	RevokeToken(w http.ResponseWriter, r *http.Request)
+	// Issue a token
+	// (POST /v1/admin/tokens)
+	IssueToken(w http.ResponseWriter, r *http.Request)
 	// Get analysis result
 	// (GET /v1/analysis/{analysisId})
 	GetAnalysis(w http.ResponseWriter, r *http.Request, analysisId openapi_types.UUID, params GetAnalysisParams)
 	// Get real-time analysis progress
 	// (GET /v1/analysis/{analysisId}/events)
 	GetAnalysisEvents(w http.ResponseWriter, r *http.Request, analysisId openapi_types.UUID, params GetAnalysisEventsParams)
+	// List the pages of a crawl
+	// (GET /v1/analysis/{analysisId}/pages)
+	GetAnalysisPages(w http.ResponseWriter, r *http.Request, analysisId openapi_types.UUID, params GetAnalysisPagesParams)
 	// Analyze a web page
 	// (POST /v1/analyze)
 	AnalyzeURL(w http.ResponseWriter, r *http.Request, params AnalyzeURLParams)
+	// Analyze a batch of web pages
+	// (POST /v1/analyze/batch)
+	AnalyzeBatch(w http.ResponseWriter, r *http.Request, params AnalyzeBatchParams)
+	// Get the progress of a batch
+	// (GET /v1/batches/{batchId})
+	GetBatch(w http.ResponseWriter, r *http.Request, batchId openapi_types.UUID, params GetBatchParams)
+	// Get real-time batch progress
+	// (GET /v1/batches/{batchId}/events)
+	GetBatchEvents(w http.ResponseWriter, r *http.Request, batchId openapi_types.UUID, params GetBatchEventsParams)
 	// Health check
 	// (GET /v1/health)
 	HealthCheck(w http.ResponseWriter, r *http.Request)
@@ -1489,6 +2843,30 @@ type ServerInterface interface {
 
 type Unimplemented struct{}
 
+// Generate a signing key
+// (POST /v1/admin/keys)
+func (_ Unimplemented) GenerateSigningKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List revoked tokens
+// (GET /v1/admin/revocations)
+func (_ Unimplemented) ListRevocations(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke a token
+// (POST /v1/admin/revocations)
+func (_ Unimplemented) RevokeToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Issue a token
+// (POST /v1/admin/tokens)
+func (_ Unimplemented) IssueToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get analysis result
 // (GET /v1/analysis/{analysisId})
 func (_ Unimplemented) GetAnalysis(w http.ResponseWriter, r *http.Request, analysisId openapi_types.UUID, params GetAnalysisParams) {
@@ -1501,12 +2879,36 @@ func (_ Unimplemented) GetAnalysisEvents(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List the pages of a crawl
+// (GET /v1/analysis/{analysisId}/pages)
+func (_ Unimplemented) GetAnalysisPages(w http.ResponseWriter, r *http.Request, analysisId openapi_types.UUID, params GetAnalysisPagesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Analyze a web page
 // (POST /v1/analyze)
 func (_ Unimplemented) AnalyzeURL(w http.ResponseWriter, r *http.Request, params AnalyzeURLParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Analyze a batch of web pages
+// (POST /v1/analyze/batch)
+func (_ Unimplemented) AnalyzeBatch(w http.ResponseWriter, r *http.Request, params AnalyzeBatchParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the progress of a batch
+// (GET /v1/batches/{batchId})
+func (_ Unimplemented) GetBatch(w http.ResponseWriter, r *http.Request, batchId openapi_types.UUID, params GetBatchParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get real-time batch progress
+// (GET /v1/batches/{batchId}/events)
+func (_ Unimplemented) GetBatchEvents(w http.ResponseWriter, r *http.Request, batchId openapi_types.UUID, params GetBatchEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Health check
 // (GET /v1/health)
 func (_ Unimplemented) HealthCheck(w http.ResponseWriter, r *http.Request) {
@@ -1534,6 +2936,86 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// GenerateSigningKey operation middleware
+func (siw *ServerInterfaceWrapper) GenerateSigningKey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GenerateSigningKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRevocations operation middleware
+func (siw *ServerInterfaceWrapper) ListRevocations(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRevocations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeToken operation middleware
+func (siw *ServerInterfaceWrapper) RevokeToken(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// IssueToken operation middleware
+func (siw *ServerInterfaceWrapper) IssueToken(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IssueToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetAnalysis operation middleware
 func (siw *ServerInterfaceWrapper) GetAnalysis(w http.ResponseWriter, r *http.Request) {
 
@@ -1550,7 +3032,7 @@ func (siw *ServerInterfaceWrapper) GetAnalysis(w http.ResponseWriter, r *http.Re
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, PasetoAuthScopes, []string{})
+	ctx = context.WithValue(ctx, PasetoAuthScopes, []string{"analysis:read"})
 
 	r = r.WithContext(ctx)
 
@@ -1605,7 +3087,7 @@ func (siw *ServerInterfaceWrapper) GetAnalysisEvents(w http.ResponseWriter, r *h
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, PasetoAuthScopes, []string{})
+	ctx = context.WithValue(ctx, PasetoAuthScopes, []string{"analysis:read"})
 
 	r = r.WithContext(ctx)
 
@@ -1633,6 +3115,25 @@ func (siw *ServerInterfaceWrapper) GetAnalysisEvents(w http.ResponseWriter, r *h
 
 	}
 
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID int64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetAnalysisEvents(w, r, analysisId, params)
 	}))
@@ -1644,6 +3145,77 @@ func (siw *ServerInterfaceWrapper) GetAnalysisEvents(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
+// GetAnalysisPages operation middleware
+func (siw *ServerInterfaceWrapper) GetAnalysisPages(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "analysisId" -------------
+	var analysisId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "analysisId", chi.URLParam(r, "analysisId"), &analysisId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "analysisId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, PasetoAuthScopes, []string{"analysis:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAnalysisPagesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "API-Version" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("API-Version")]; found {
+		var APIVersion GetAnalysisPagesParamsAPIVersion
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "API-Version", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "API-Version", valueList[0], &APIVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "API-Version", Err: err})
+			return
+		}
+
+		params.APIVersion = &APIVersion
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAnalysisPages(w, r, analysisId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // AnalyzeURL operation middleware
 func (siw *ServerInterfaceWrapper) AnalyzeURL(w http.ResponseWriter, r *http.Request) {
 
@@ -1651,7 +3223,7 @@ func (siw *ServerInterfaceWrapper) AnalyzeURL(w http.ResponseWriter, r *http.Req
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, PasetoAuthScopes, []string{})
+	ctx = context.WithValue(ctx, PasetoAuthScopes, []string{"analysis:write"})
 
 	r = r.WithContext(ctx)
 
@@ -1690,6 +3262,197 @@ func (siw *ServerInterfaceWrapper) AnalyzeURL(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
+// AnalyzeBatch operation middleware
+func (siw *ServerInterfaceWrapper) AnalyzeBatch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, PasetoAuthScopes, []string{"analysis:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AnalyzeBatchParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "API-Version" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("API-Version")]; found {
+		var APIVersion AnalyzeBatchParamsAPIVersion
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "API-Version", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "API-Version", valueList[0], &APIVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "API-Version", Err: err})
+			return
+		}
+
+		params.APIVersion = &APIVersion
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AnalyzeBatch(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBatch operation middleware
+func (siw *ServerInterfaceWrapper) GetBatch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "batchId" -------------
+	var batchId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "batchId", chi.URLParam(r, "batchId"), &batchId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "batchId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, PasetoAuthScopes, []string{"analysis:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBatchParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "API-Version" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("API-Version")]; found {
+		var APIVersion GetBatchParamsAPIVersion
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "API-Version", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "API-Version", valueList[0], &APIVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "API-Version", Err: err})
+			return
+		}
+
+		params.APIVersion = &APIVersion
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBatch(w, r, batchId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBatchEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetBatchEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "batchId" -------------
+	var batchId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "batchId", chi.URLParam(r, "batchId"), &batchId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "batchId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, PasetoAuthScopes, []string{"analysis:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBatchEventsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "API-Version" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("API-Version")]; found {
+		var APIVersion GetBatchEventsParamsAPIVersion
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "API-Version", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "API-Version", valueList[0], &APIVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "API-Version", Err: err})
+			return
+		}
+
+		params.APIVersion = &APIVersion
+
+	}
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID int64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBatchEvents(w, r, batchId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // HealthCheck operation middleware
 func (siw *ServerInterfaceWrapper) HealthCheck(w http.ResponseWriter, r *http.Request) {
 
@@ -1851,15 +3614,39 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/admin/keys", wrapper.GenerateSigningKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/admin/revocations", wrapper.ListRevocations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/admin/revocations", wrapper.RevokeToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/admin/tokens", wrapper.IssueToken)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/analysis/{analysisId}", wrapper.GetAnalysis)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/analysis/{analysisId}/events", wrapper.GetAnalysisEvents)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/analysis/{analysisId}/pages", wrapper.GetAnalysisPages)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v1/analyze", wrapper.AnalyzeURL)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/analyze/batch", wrapper.AnalyzeBatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/batches/{batchId}", wrapper.GetBatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/batches/{batchId}/events", wrapper.GetBatchEvents)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/health", wrapper.HealthCheck)
 	})
@@ -1876,133 +3663,381 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9bXPbttLoX8HwfGhyriRTtuWmeqYf3Lw8yZw08cTuPc994owKkSsJDQnoAKBsNeP/",
-	"fgdvJEhCsqS4aZryS+uIeNld7C4Wi8Xupyhh+ZJRoFJE408R3OJ8mYH+mzI54YDT9UQAX5EE1I+iyHPM",
-	"19E4ujQ/IiIQZRLpllEvWuGs0C2TBSQf9UAJThb6J+Cc8WgcvYOUCKRGBY4KygEnCzzNIOpFGRZyortC",
-	"Go2j4/h41I+H/eHoahiPT+JxHP9v1IuExLIQ0Tgq6AJwJhfr6K4X/aeAojbPzyAEngPSH1DCKIVEEkaR",
-	"JDmwQn7mfEIyjue1GZ9hiadY1CabYZJB+llz3Xk/P3v77zdRL1IoCInz5eaRVsAFYTQaR8NBPIjNMGbV",
-	"Jim7oRvXU3/0lrKc++fzV2+unr85f/P0+b4grCoYSsTuZayKBPswlkf7JWMZgtsFLoT8zDXYwl9Tzj4+",
-	"KCcHOOvpw3LvYRxVLFWjaDx8EseD4xCH3fWiBeAUuF6g8yX5v6bJS/2j+i0FkXCylKbf+cUrZEdBhYAU",
-	"zRhHckEE4iCWjApFSpEsIMeaGrTIo/H7aDWMPvScttLcpRBYL9XfQnJC5waWJeY4B3kQOJIpiHyA/lOA",
-	"kAP0aqY1nlhCQmYE0h5KYYaLTArVZzUcXNPLYrlkXELqRhNjtBpeK6lqAE3UtIZkUS+iWJFXgdG3kNbQ",
-	"t/O4vnVqBNB3NNTYT3E6sTho4WFUAtV/4uUyIwlWNDj6TTDa3AkIXeGMpBOmySTq4vrKfESY4mwtiECu",
-	"lSeyKUhMMsVrV4Z3UV4IiaaApiBvACgaIUxTdBLHSEDCaKq6O9ZvTt+LciN4W2ZHS85WJNUybxh9krAU",
-	"ovFpHO/A6op4btqCZ2GMf3n3WnFHjmUYV/Xd4YmR6fPy6uoCMa7/f6lGCOCpJvRxvFpAiY6e1G65uvXh",
-	"+OVECELnmicIh3QyI5CldVR/Nm2Qa4NMm/DSLgB9V/DsO9MIWZFR3TwkN8zq4/uuNpkax3Y6FNc7X4aW",
-	"nC2BS2JYuwS/pQnSlKg/cYY06Mi1bAlaiVtziOe6nwY10KnEt9ntZZFj2lf2lNpJ7OyudWAgDpKvJ3gm",
-	"Qwrt0kiTUkw3mChWnDEOSPdRC/tIqTeOJaCM5ESa2cTjah5CJcyBR3cN2regVoxtWjRQ9kbw1upTZEVn",
-	"HKVYQl9vLG0lVv7Cpr9BIs1i1mf+CadON6M+8oWTceRtAHc9bdLOWEHTPRWg0y6T2gCVmJw77aPE0nwP",
-	"isgbVikq3QzdELlA0hfwV888aQlM7EtKcN6GiJzuqA4KAXwTfr8I4DvgpobYiFfCIQUqCc583d6Y1Ueu",
-	"NelBiHWy/y3L/jsQrOAJeHyiqIIlTDROe8p5ikm2Nj0ncJsAKAOiJgnPVAtHL9ciKA8vOICWCIEwtySG",
-	"VC3GMI6tGgCBlsBRiteeSASB8AXDwFAqkhYwNaZ4cqZ3ybrsHP+wo1KoKLmBHu889tlKjqrhGA1jp7AN",
-	"/jmhhQSPBKFpaxYRYyjHdF0OM0AXGahzt+RrhOeYUJRhqU3qGjXODiVFp0a+ZTXS4ifURyHOtg4U4JNy",
-	"vfY6RkngFGeT5hj+0cI0cc4x0yQoUGGG16dTu+9OM8iVfAkipOghBSlOJBLmbFo7eIQAqxsaqKBwu4RE",
-	"6TDDTyxJCs7bJ6zRzicQ54wqKF5hkmnHSdAVJCFfMo650nt+443nEOH7kFLgc6Y4NccKU4ppAgGFQSjC",
-	"aAY3Vh35VkoIUJ88nstqM6gNIp10eudvr3fC4q5dpLiQC8bJ77DvWQVul/pcLdlHaLh4n5tPSI2tTHEz",
-	"CjIttykZDjMOYoHWrOCmuTpbZWyuZEYJjycr9flrSiQwLVpggWyXtok/3NNV458xgi4bA3L9KLIZbe1Z",
-	"NUh7XbSnqlQbAf9Nffi2rwpyTDJzOBXihvEHQDyw2KVnbOfFrvmZzOoQgXKcKXaHVEFcrVQT6R2Xmwhk",
-	"exyOtHMhBZB2/qq9OdziXfrpfgLMwfE6oXpLPbciacYsfbZNz9bulPDcYweRotscvuXN4RdvD/AcW4po",
-	"QS6PKn4wtx32gPgMywCDKChF+2etICfq48RjISIh1BgnBtQmzV4wniPz0bq4W4urXbtiQ1fj96U410ZY",
-	"OXlrEPsD5hyvDevJBUs3DCqKqaYbo8i2qy5QLt5eXoWuUFqr1J6zIphQFNMGchuCN0U+BY7YzO5Auj0q",
-	"2yudRkmugImD/MUkziYJK4wJ0Dy8SJwhWs5gxi79aVsGDuGn1JrSYnqywJovhuq/28FdHO/Q5mSHNqc7",
-	"tBnt0ObsvjZBSsg8m5Q3nE2qP7Nrh15e/fza3fLVrvfUh1GI9zNCPwYoC7f2FLZhnSseci2RGek+7iEU",
-	"JwkIQaYZTMrJN8j0Vp3v/xZAbC9FijgkQFaa+9sw20u3UpcWnEQHyWd5tr2Xqq7ljlTdSyZ3GTKIDZFZ",
-	"gJYXeA7IfPM57rn5Cz1jubFMd6CX2yWeu3VvqHh3AUHS+noUJA2xwB9vd+jWmzpNHsz6WEi5nOzH0DPO",
-	"cm0oSsznIPUV1CMyQ/bENs1gm/3hhzfYkKEPe63gK3rB2ZyDEJ+/jNqzQ+VESFi2MX9qvlYuaN3M50T9",
-	"5Xe1jzjOb6+XkCTHEtJJwlQvNfbEhJe0Ft411aEvyuaruoSGXnp0aAiO/YKWwBOg0ix+jm+NVA7j+D6x",
-	"by8WoZNywv1W7J0LcblvvZqGIflPAYjoQ+aM6Ds3rhnPdYt6OywxB019HFBf/14ArQ2IbrBAtoc/+BYT",
-	"9yFXuGKsk1hs3nk2c6oVUzZrksktonX8auz8Be1FFhCD9ya5LDesxjawAK0FpqDNdS0UdQLuvK95PKMj",
-	"gD5bwh1algF2W9I60+zWJy04Dp8Tyuvrsom/1MORCB8LFQFEd6LpTjTdiaY70XQnmu5EU5+hbaFVW/iW",
-	"nfvALfl3eFfF1NYZzwuXrX/Q9woV35ZRvZIX0GubYnKhPdH2OkJ1Q479SUbkuoJ2ylgGmNqDGCRyUm6K",
-	"u05i+vlKPTg8oUlWpDCxynWvKWxfZPv65lh7Ihfs7o9/ErfjgEz8n22NCPXiiEvz/qRm3o92Y9ithp1k",
-	"zqpDj+y9ukB4KlhWSN1C9BCHDEuyArTEciF6+vqoLpmPazyvTp5ifHRkfxkkLG9bjDmhr4HO5SIaD0PM",
-	"Wl4tjN9rDD4EMHuKkwU8gyXQFGiyfqrYSxtBWfZ2Fo3fb7lTwOVp/sJrElp379yfllP1bex8ggg1iNX0",
-	"bwXiVt1tT++IGKu+Gr75dKSibfsVBdIXDGgUx3EetDfrbyw2nJSUDVtNrw5Lqhty3XY9Mbl4/Q2nJHde",
-	"NIckQlFOsox4AfMOz9PjQcXdRmdvOyW91JRqHJIqfDyzsqKpT9+CfqTshobtTZ8TLQABZjyQ1+qdloxl",
-	"2mcTsvKIFNv2Sn2Fg2Ycam+31EqagFZ7B6im8Ck9PB7d6zIgaQaTatCtYKi2HgBi07zf3zepOhnAgRi/",
-	"eXu1HevT4x3cJLsjrRvXsOaQsxWklUutCcG9AFjx3oEC2NwgOn3ghTWVs53sajrthK5uvMsiD+9lLQX5",
-	"/Xagw7OxzKpzHc/T0U4TuuP6JISnMRS1hhJLoFI7p1Q3vdX7MBCKKKYsoL+GSh3H9znjGspFS3jJ+B4H",
-	"1MgUQCG0fAGpDTF1aFs1g32EtbjfilatFB3MS8aaXjn9fl/juv3Lh7teFNjg9zgfHbDHbn0D+6dur1/x",
-	"/mfIvdkh3MVz/MXiOXraR+c8nJ1bsnNLNvAzSmezxFfP3MMGcHc6605nX2x3aijrEhRCU7IiaeHzD9HK",
-	"pMHMLlND51vouLfzLXS+hc630PkWOt/CX923UGZE6jbzbjP/Qqaolxur47qO674I120PM6hD+3YFHGcZ",
-	"WtSg7qO3/0KMZmvFDuqzf1zSCSI8frDYvP1X1HPJ2fzMe6EghprHqvHI9PItenIWD1HZBt24+E4TUqAY",
-	"YgncPPPbmRtcMri2K888hjbf61fxldVzFsdBJtgYnnRevXsNBieZDHQ7LrFPsJ5ztYS0zSsv0ug1oR+/",
-	"vfAihdVmN2UXwtWFcLU5ZgUUxJbw9U260amGzI6ASnH8i+i8zdqpShzZyPF4uFpy44X00ms2J/QF43l3",
-	"WaDmuMBzQsso84Z3BosJhVvpAevFl6mvSw4rwgoRblEmkirlZBgSvaU1Sre3agjoLtKsBhaHhPteMJZd",
-	"dh6rzmPVeaw6j9Wf5bF6p4N8t1oL+950dgEr39SVYLe4X9/ibnDsdovzVXtAu+X5a7oKudsjK2+hLqfy",
-	"jTkMvzLXnqFf3zm1B6vh5N5Y2e6Oobtj+OMUh4Ck4ESuL5MF5IbhfsKCJOeFXLRB1p9MQYlGujt1xsCp",
-	"OiwIyc3bM6DpkhGq7X+dI0w7OdQI1SIspFwaZ4oAydykU50J74VbvIvzy+dXb6Mmj5uf0aOLDEu10KiR",
-	"8+7SooaudPK757fJAtM56Mdwb5dgjh/iMVqdmvR4g2t6jjQ9wObLM+xjEssTIQrgJlWfGV+NA3SBaQIp",
-	"cnREM8Cy4CAG19QgMHaJ/Vang4wlOBt8WuJ1xnB6hxj3Pi6LaUaS6uvgkyBzqke7u6Y1Iuo+TSqa5Iwz",
-	"5pJ34kSfSW19l3/DFOkXr/YJKUeXZVJe7WQuHwHOiVwU00HC8iPMkwWRoMxmfiRWSf8Gpn37+pC3LfJz",
-	"dANT5GULRXKBpXuuKPRX7VrStLP5D4UNH4fUV0sIT1khx9e0X3sbrv5dvdvVX+2LTvPUHk3XKIMVZOpT",
-	"mepUr1TtrsB8rq4Dql9flzG8NoRXz3pN//EPdH7xCtkSOYTO1Y9XSgWpnwsBAgnIsWI/B6x5aJqi8pVm",
-	"XmSSLDPwG2hxgTkBMTbT/MPNgS7Np7UC65///OXda3SB5cID4Z//HKNfj1bDo1/RoyUnOeZr69J8bPqY",
-	"mkPNHl6pnzFaDX+1eSXRI5xpGinptQM8NUlg0dV6Cc1h/KywK5oOfN4YrIb/5zfB6K8mir7ceFgld01s",
-	"X1WLr+Y+10aKrSVUvt/1YS/hVsc/BQedaxXq13ZSI9nm1e5n9ICxaFKWFDlQCaWrzXzN2Fz1/YkD/qjZ",
-	"y/axehXl+DfGy6kITTioYSynONXT5hGrtIx+qevQsSG530IoQn+efkP9gJIyg29QbA0ckGEioX4OL4qQ",
-	"mKaYe+Pbelwao1//p2+5qK+4qP/WPFEfI8oEJbPZr7bRC45z7+uz52/+n/v0P5eX/QvOrDSO0fC/UM5S",
-	"+HGaseSjaXQpOUlk/4pjKpSw9R34Y5Tj2z6ew48nw9HJWRzH/+UAvyym5pG/MGM4MF3X/gXLSLIeu1pb",
-	"fcET9J2AbPad6fAOZsA58LKhMFAwTuaE9pUF2084E8L+YnpdALf3D6LsmOAcOP7x0eMeyknC2XLBKOh/",
-	"zoGpXUMh/uOjx7/qjSAjCVi3ltXuP7+6aulxtgRqSlUMGJ8f2U7iSLWtsiIENobzi1ehUn69SI2IlyQa",
-	"RyeDeHAS9SL9vlzBobSQe1R/9Mn99Sq9Ux/nIENmleQEViC01JkUO8oKwsjdjWRrc1cjlXR6L/ZLJfIq",
-	"jcbRf4M8r7751dfef8ul1nqh/ABFK01XIP1UCeFoFMOT0zjuw/EP0/7pMD3t4++HZ/3T07Oz0ej0NI7j",
-	"2OGgFrrCoFrfyDc1zUmkQuiejFB3Hxrl4o7jeM/M415KKS+eoErO/NR9r9KKGU7zMzPX8ljtSpV6Mqt2",
-	"uuTh6H+jevKqTRUOq2RVNv+Ul26qzKURerv1vrp4jY50g6i6T32v6xzp9VJSYbN+f6juRs1dp1qD8DOn",
-	"YePm8DiY12gx1C0Xx/qOZnGic1wsTvX90GKkbw0WZ9E4bqUCKjP7lIEYzbiPJ+GIjfdVgco3TKIXGys1",
-	"1bWhLphJB36GCyVDW2td1saMmyPadvUhP7QjLYajJiVPPPXbSDfjF8n008DVp65NedeLjMm+QQb+m8iX",
-	"xRQtWA7K+PZ1weEiMLxXBEbj03tFYNQWgdPDRUCAsDq1EgInFg8hAScbJeDYSMATIwHDYyMCIyMCJ0YE",
-	"hgeIwPFogwwE2SxuwDv8fuQxmmGDMXoN8juBpgXJ7O3uAjjsyHfV0XB7KvguM2CXGbB7gttlBuwyA3Zh",
-	"pV1mwC+QGbBdyqPczsr5kCg0n86KLNNrdxwfH1KUVufYLve3RkVa9bHM4Xe4eXkcNROCt+bemuw5Gtno",
-	"D5uU+8nIs2/8ZMuKcDOQyUIN7OIpK5xe2E/abY0cpT7bcK5jVp9/O17HDcSOtyHm/ztcPJhQ5OWd/swT",
-	"8ab1ctK5Da9hXMfrbDNeD2l3djnn/7Cc81tUkpAky2q8d9eLTuPTrkR2VyK7Szv1rZbIPh3u692dMT4l",
-	"aQp0Yizsxt7svtrUxO3KtQdtzu2qrqBYjRJI3USS2TsLW8ABcYuwJ0It2FvlYqLzDaNpW0PrIjtEFKoN",
-	"cxqfeFukjZGrVaasCN0u0Wg/PgDNjls0cz59lDIwSlCHIxBqCx3qI11lS7VqWda/eATT1SI9QwwlrMhS",
-	"PcNUfeFCH3fatDqu6pHXaaVGmxSUA04W7VrA+hzgfX0AasUtanmVe2ro6FkVz+lIqROjIBCWEvKl9JV1",
-	"C4c24V6Y+ArJkLY1NRHHfohl5fNuEy9Iugc0wrr6TV+4ftMWy8wTDXeZr53sfnzW+w93H3r+/QLIwO2a",
-	"xHPtgC/vZj+oQTdeEx/BCqxDLHhbfKn1cP9SSf1z3bSML9N3uBxwpnesChRnXKJiqfYzMbCxGGU/ITng",
-	"XOinoa6RiTuqFyJyAw30De/G22cDVncHvcMd9J95pSzhVhpu6xsGaFWz1lpBt2iUhr98bpWA+VjuA5H+",
-	"91hJtlqRa5piicfo07WvC6+jMbreyQi5jnro2oq46eUG1h9Kk818C1nY19HdNb2mFizHvx5c6iRrutec",
-	"D2aCsn00Rscj9YtVeqZH0CcyGAx2hG7UgE5T9OFJZjSZ+d1MoX9ubpamQQ2/9lXwbpidWLr7R/NJpdbq",
-	"fFQqJ3Ba4w/hpfjvxUtboVP2oQJuIfOsDdwobgF3YTrU7NXdYXvSgE0BMimdsUEI9ZWIW+Y2iGcaRHvl",
-	"qH74dF27RTGD6HsRB6PMLC513/V1dLcLDsO9Vr/hDGvD/317/Sufse6zM3WHx/tTV82whbo/BKhbv91Q",
-	"Pw41DvW7JPX7k90IetoAOwTxA8l5NfRuFB057VUz7Zv7a/sV5uVza0jp4L2mzbS/KbnFkvPMyneuVcOu",
-	"/N28UWMiZEPqYEKBsDaz9YsFO8MAXfkGn40PFa3wcz8k3A9ER3ArOS4/NGLSH70c9l+e6Yjq17qSkpvn",
-	"keOvI8dQR/5l4uPN8egtO9QGb5pwgL+NAfqh56po/sTS9UGxg7cTQSSEogZv0Q1M1cdgxJRXaatWW8u9",
-	"xvJLYZnf2vWrzO9luamz+G5rwE0vgoTlOfAEAkA/77uP6EsCfTq62xCd1hcLtixBp3AjJpagdcDfwI04",
-	"iNQznIlDwT5p01pBOFgnLJ8SiiXjJeiCKHQm9mrWs+P071qZ/JHEvtse/bfFE9NVg+uqwX291eDqrSyn",
-	"Nw7vewcoJAksbYhY4CLQbhaobPbZd947xHdvu/Y2taVL96pfEnp7xK/bvEqow5i7naw0OR4E8+EDYH62",
-	"K+YHxpx29cv/5vXLt3i5A2rAvlhT8PiW6bake/qBoW8722RDPovWzdeGXdw6W+koiH0vSd21mbfZt6/7",
-	"SqZ0rYLBAld288oLoa+gpiBvACga6S3jJI79tKqNW7tq4OraadPsZfhBO3wg3jEuwk3bMsrcnPaIp1gm",
-	"iKv67vDE5cXk1QViXP//0oZWN/FUE/o4XvnRFGpQG/ehWx+On46lpvOJ2yAnOmi7jurPpo17k5qawO4N",
-	"S7sA9F3Bs+9s9Dcpn7KmHpIbZvXxfVebTI1jOx2KaxcK8i2HgvyE01Ld9pEvnIwjz0+hVd9wT9UHt0vN",
-	"pfo5c+M0bD4101SYlkEJucgAC0X8GQexQGtWcPtMm3F7GsFzE/frxKU+fy0ELDAtWmCBbJe2sAz3VHx+",
-	"4FZQARqQ6/Fdm9E2RzuNtNdF630ddtDAPARFSPNDjklmltq+v/psxAOLXe4zOy92TWub1VGaDGcmv5CC",
-	"uFqpJtI7Lrd2JG7YBoZ7bgMBpJ3235vDLd7lrmczkFigjRGrEGKc/G7GLH13zX1id0p4m81BpOh2iW95",
-	"l/iFYstwkHrbhCJakMv1dnH8w57bRYpJtp5oIk3gNgFIm8flZ6qFI6NrEZSlFxxAR9aaNCK6i4mwGsax",
-	"NXhBx1+jFK890QkC4UuQgaE0mVvA1HjlydlpFdrmROr4hx21i2KarfR453HVVnJUDcdoGLsd3+CfE1pI",
-	"PzQyNG3NpGYM5Ziuy2EGyKqucitCGZZaI9WocXYoKTrt8i1rlxY/oT4KcfZdLxodcPy2V8EmUnhSLrVv",
-	"nlhfqw0mbkWRtrboBp/r+zgbtj/NIFdiJYiQoodsoi+XY6pmrYQAq79TQAWF26V5aGnYyEu+XVvN0c4n",
-	"V2EKLkwKileYZO2oWleRQUK+ZBxzpe78xhsNNjuyyTOYAp8zxaA5VphSTBMI6AlltaMZ3Fgt5HsuQoD6",
-	"5LmsptsMaoNIJ526+durm7C47xUHYW/zES5T5m2NqDU5ITfGzj5l+ZLDAqggK5fD1WVVJXJR5d8TayEh",
-	"r6XhM1dlamVMbaVWEG2ZxU/pJ4HzWkZQS38svHyzU307ZgIsrmmV0tTNnoPkJFF7vsn4BfbZks4c2bAD",
-	"QxG5Jq2mSab62fmFbLbNidUVYUVGhJcYt9RdVYr5Mu24J9z12q6mNoYpZdEuVvF9VUzi9DhY2eHYL7dw",
-	"EqyGMIzrxQpOR6FaAi7vf6Pu4/D49PtWUtZNtz6NdKrHgyfePY8jlJ/w289wm0iygskN4x+1N/6kFylm",
-	"UmfN39hUQ3IoHKPBaRgOL7f1QQMPR4Pj0Mh+ShWdJvnenaEqcOZqlbWSsN15OWDv5Uo/W+w2vnQ74jtI",
-	"9YPxMv5VcSmC2wUu7D3PbgQq0a4A8NfbTfezzSSsUzNxVH/C8jkzeSu6Nb/T4XP4a2uzX++xusMncax4",
-	"pr26WyyDrjh/lze6K87fcW/HvV1x/q7UWVfqrCt11pU664rzd5t5V5y/K87fcV1XnL8rzv/HV/AKPH+0",
-	"yNVW19wknuzpa+78ep1fr/Prddta59fruLfj3s6v1/n1Or9e59fr/HqdX6/bzDu/Xsd1Hdd1fr3Or/eF",
-	"/Xo1EW7F8Hql0pshvC+9MFsvePdSB7lWobsZWQG1qc3DiW9NkiHXzq6kzZPDc0JLxeOFx/OCUkLng2v6",
-	"i4AUTdeI8WQButY04wI9yshHQP8qpsApSBCPgwParN3AkVjozNQ6K7XNHxkKvX1tgXyg4FsXoJ8qod7k",
-	"C9UfPTdo6b7zJWknL17ltVtVwZYOBvZxIwRafNvz7xryGZh2i7dwkzZy8JR8UgrAX0TLrHbIuNFILXe4",
-	"InDj7akJsKLuYc79P5+XO6b6OpkqBdxKuF7bSZxW1a+/YMteUr6x2PElSPUmY7dNBXC61gnHdNocJDme",
-	"zUgyuKZa3wtt7iScSJI03MTeKxJr1ffMadXkItOnS/v8Q2zcs1rQmen9vYkV9g2utoQJFVK/CgvsVO8c",
-	"6g+0VVEmJ5o+997dUSYNJfe6u7NPiR7uKm3jpZ1ZjORhr9WCV3fPsMRTnXuimsxmi/ryV3ihhxa7Legu",
-	"i7knNqF1OnyIvd+3PMxTlj/0EvShD+RbefFPPYv/LW4Lu8X9+hZ3g8+3W5yv2jnaLc9f04tY2eKlI9HY",
-	"29+WL/Gv4/XbcNo57PTfHQ++ueNBZ8x2xmxnzHbGbLc4nTHbGbOdMftVG7OlVYke1cju5TJ7vPUOovSX",
-	"b7mE2CHBlTZTQ0WpXjNzZ7CCjC1zXX5At61VgxgfHeElGdzAtG/rAvBBCqujT5bGd0faaOZE4aPZs7ZC",
-	"tbpS7aID7bpYjfJTd7relMW7pQ5sni4/1729cBBe0SuXuqtdOrUsaFZWol0RjNplb6vBqhJo7dHMqlQX",
-	"d5imnlTqNfRGsmt49+Hu/wcAAP//OrsqjZ0HAQA=",
+	"H4sIAAAAAAAC/+y9SZfbNr44+lVweBed9KNkqQYPyrmLiu3ElXiKq9xJp+UjQSRUQooCFABUWfHx4r7F",
+	"O2//1u/T3S/yPxgJkqCGciWduNGLTlkEiR+A34Tf+CHJ6HJFCSKCJ6MPCXoPl6sCqb8JFROGYL6ZcMTW",
+	"OEPyR14ul5BtklFyoX8EmANCBVAjkzRZw6JUI7MFyq7VhzKYLdRPiDHKklHyBuWYA/lVxEBJGILZAs4K",
+	"lKRJAbmYqFdRnoySo8HRaW8w7A1PL4eD0fFgNBj8nKQJF1CUPBklJVkgWIjFJvmYJr+WqKzN8wJxDq8Q",
+	"UA9ARglBmcCUAIGXiJbiE+fjgjJ4VZvxCRRwBnltsjnEBco/aa6P3s9PXv34MkkTuQQu4HLV/aU1YhxT",
+	"koySYX/QH+jP6FOb5PSGdJ6neugdpZv7xdn5y8unL89ePn56KAjrCga3sJ2I5UYehFje3q8oLQB6v4Al",
+	"Fyj/vfBrxuj1nWJyALMe3y323g6jypUclIyGDweD/lEIwz6myQLBHDF1QGcr/A895Jn6Uf6WI54xvBL6",
+	"vbPX58B8BZQc5WBOGRALzAFDfEUJR3IB2QItoXwZkXKZjP6VrIfJu9RyK4VdcgGblfybC4bJlVriGyjQ",
+	"c7zEQv1fe/bHcAUzLDaAzoFYICDoNSJgVmbXSNjfsgIjIiq46AoxqN6vAWZBGQ4cIJgIdIVYHZI3aAkx",
+	"kQC2oHmDfi0RF9yfNoMEcIGLAizhNQJQAEoyFJ760a6ZOQrswQXKKMklrQlc7NoFzMG8LAoAryDu2ID7",
+	"ASg+pskKMrhE4laIIajEDR811Eb1wflcyR6+QhmeY5SnIEdzWBZyDylYD/tjclGuVpQJlNuv8ZF8AM4F",
+	"WJZcAHjFEAI3WCzUOu2UZtkrKBYAklz+G5LNmKwRySkDS5RjCOQ6+2O5Dw1MxHIFmg6SNCFwifSKembR",
+	"tZ0zINt36yjexOmPaWIJQ23kDOYTsx3ynxklAhH1J1ytCpwpTL33C6ekKd5nBZVsYyIgu0KiwYKxQEu4",
+	"ApQACBaUCyAWUIAl3Kj9niEACSw2v6HcZ8w5EhAXPBnZbwP97RFgSDCM1phcgYUQq9G9e5gIBgkSfQNS",
+	"P6PLe1xP23+/LEYgNAIwxGmxRup4hwPJc/rDoxTcLHC2sJoIBKtyVuAMwDxniPMkdUy0seY0WWoOnoyS",
+	"ywUCb988NyDzPdat+eckozlKRieDwR4cVB4fJmtY4HxCFabz+saf64d6Gq64jR4V3OZLLQg0Is8QmCFx",
+	"gxABpwpljwcDwDVxe1vQnN7fg67ZwYrRNc7vYtXmiMPopjY7g8TsNUOwA7/M14D5WgvB+OjevU7EOhmc",
+	"gJdUgG9oSfLAzlgQm9hhfgcZLYsc1GH8xF0pWRHGA4mRc8qWUIQ3Qj63pw+BfufZ5eVrQJn674X8QmCN",
+	"csLm+uwhq0kNLanRt1/fEnOOyZXiUJihfDLHqMjrS32hxwA7BugxYYRfIPC3khV/04MA5u41b5Eds/rr",
+	"fVObTH7HvHTbtX70OfqK0RViAiNeA78l4vIcyz9hARTowI5ssX23tuYnnqr3FKiBl9x6m689K5eQ9CTu",
+	"SmXVzG5HBz7EkGCbCZwLxLoVCEHBDcQSFeeUIUWSG3mwX0i5zaBAoMBLLPRs/MukrSc09r4FtURsPaKx",
+	"ZO8L3ll9SAzpjJIcCtSTj4JqovmFzn5BmdCHWZ/5a5hbpQP0gE+clAFPs/mYyilnOM8ROVAcY8LL+Rxn",
+	"GBEx4RldNW5Fl0opK2B2LWWTIxY9spNYtCqnOMQVg0QopWacWAY/umFYoHGiP1MXEy1w6pKiegxWiCnq",
+	"0eKkQT7HkXz+48nnG0sToAcqrMwp0kKmwsw6Wit6klaouZLUh9GTRfFJ7QMVPZ2Z5wqCuVEFAlT0klbq",
+	"kBpW3RWcwDx/4lFOYGKfcoLzNmjmZE/xWnLEutb3liO2x9rkJzrXlTGUIyIwLHzW0JjVX1xr0lstLDKD",
+	"z5kZvEGclixDHp7IXYECTQprpTmAznOIi41+c4LeZwjlqEEJT+QIu192RJAevmEIKYrgADKzxSjXd82B",
+	"YQOIgxViIIcbjySCQPiEoWFwjKQFTA0pHt5XWmeddo4e7ckUqp3s2I83Hvps3Y5q4AgMB1YB0utfYlIK",
+	"5G1BaNraDYNSsIRk4z7TB68LBDkCgm20PQkUUCDW3I37t92KyEY+ZzbSwifQAyHMNj4PxCbuvA7SygVi",
+	"BBaT5jf8q7oeYv1ZekiQoMIIr8yYRu7OCrSU9MUxFzyVngwBMwG4NmLW9PMQYHVFA5QEvV+hTKDc4BPN",
+	"spKxth3ndO8bvfUflQSuIS4kroa9NwItV5RBJvmeP7jzqsJ9t0+O2BWVmCqN5QIRSDIUYBiYAAjm6Maw",
+	"I19LCQHqb4/nZeoGtbFJ8S4T+U6Y3JVXE5ZiQRn+DR16V0HvV8pOpe5FdXJ6qh8B+W1EhPmKvkFtZTIM",
+	"zRniC7ChJdPDAWWgoFeYaOLxaKU+f42JBKYFC8iBeaWt4g8PNH36d4ygCVSDXL+KdC9bOUP1or1XlD3c",
+	"sY2APbT++bZFHC0hLrSxh/Mbyu5g4YHDtrPtf9g1u60+HczBEhYS3VEuIa5OqrnoPY8bc2DeuP2irUk2",
+	"sGhr/z0Yw826nd37awQZsriOiRKpZ4Yk9TedR65pKd5/Jzxz8622IgqHz1k4vPVkgGcolpsWxPKkwgft",
+	"FjcXRBlG1EaQjMGbIoAeV1cMXcl9c07rK8TlPyBQr6SAIeMDhwUlV5WlhyGunOXei4CXsyUWAuX9MZHs",
+	"JaMlqYbo4BuQYy4xIgezjfrZOgrNKHfRhQyB3xCj2kteX46KmiGTApPrANI/wVxgkgmACcwyxDmWGKgG",
+	"G3sVJdVqpQsYEfMYMgOn5nrWNf9AsiGCl9K9PgihxBK+n+RoJRYTFceD8jZUzyG7QlwAUi5niMnl6imt",
+	"57W9i0r0QP2jOo06UEe7gFLLm9g3WwC9lo+t+xPlaQgCTLKizOvznvgTD7snlgddFPRmy9x0RgXvi/cC",
+	"XKOVAHNGl2CGJLIH1nu633pNzFzHlOa7xl/su2Otez4FvMwWAHJDDL5N8tC9l9QyUVrIRPIJ3gWVHAeg",
+	"0VfkSIOU6iWFlDkS6jbmAzHcHwhaisliuG16WgoAwXQxnCpZh8mVgcH8S4MRwofjXWAIVpIMitCh/LhA",
+	"YoGY2eobxBAo0FyAkpiDSlXQEphKAlODpkCF8iAVymbYvWUw8hVwA/mYGCqsB9jMYcGRA3BGaYGg5qNO",
+	"NI/+1aCaBlYFcDtE/GmdQwVOohND/O1615IbaYJIRvNgDNjjBWQwE4gBO6ai6BvIgWCQ8IxqdQ+8vfym",
+	"99BSnJKmmvBWkHGUtzhutpC/B+PgCCU4gwUgcOnkiIPS2/6EL/BcTH7BQe1DK1iT7nn0A5CjrICsEh+P",
+	"9V2pd7lZIaOlpZJ4pXtSoNr5JxcKgO/OL8KajICHTy93t3O+p28f9757HZwM8yUU2aKbIsTCLkffQOxJ",
+	"GgBAjudzxBARbq+5P7dgZQDV00Qb0IPTMlQ7OoUzlu0oRBmp57ONQIAyCdgSsuvUyHa9Pfr4xyRwKpZu",
+	"p+NyMDjO5Hbb19QvaKoWOl0IsepJelxPzWJhTUGQu9AfE8259AAJLaHgmtAbUsEPmY6xkaejsV3xM7FA",
+	"G/XMeOLlk1SLWj4mN5jk9Ib3hkenR4DKk7jB3EbqmfC6GV0maRWjJ1eSpC4WrxZc6ga1NUKf51i0c+fj",
+	"YUiQCbwXiHAbAwadkv/ao1mNAS09Wu+ltDAwPCuFxuTqC1YMMp46vJO8r2RGLyQbN0RuLCRAg2VkGAFT",
+	"pXxPra6fgmu0MbPY9ySnSALLcjKyznsq9jjxLjpYoNBgmOm1tlTdGadFKVQo6BLoUSbCqUWdKrInIKy/",
+	"ka+qh2oJkuAcFK2PmB8gY3Bj+MuC5h0fVRqXioMAelwffPv00lMIlNmEclQzTWC1Am2iKOgV73tI+u3T",
+	"yyRNXr+6uAzFggY2vwmvJ5QmTvtoQf+y0mY9WD1tZYdqQAUsJuqe0P72pXzo6cv62851vOXDofUZPUZP",
+	"FkAcrR5tB3dxtMeY4z3GnOwx5nSPMfd3jQnuhFgWExd/37o9WZb/7PLFcxvUXBNr8sFpiG7cfay+s+i9",
+	"tnt2nXOFQ3YksHrT9uX797vqMtjBGLaaN/zfukwjHSaDFzBbYKJFjTJ1MAS5uWBKmLQRrALUo1Ep6pz7",
+	"JSfc/S0K72+XslElp0wYmpdaTav9qGWIjD6e1DNKBKUT6fKaMJRjhjKheFctojOTjhKt4xIkbii7diA0",
+	"Yp/fBXboIKsKYChDeK3map+qiWh1hpWS4eRWHAyTffHOjtwT7w7iWvt8MrgaLAoUvrEB/aymauq/wBO6",
+	"1GbqPfbLmoyeWspoSFLzeILz+nmUOA8Rye9vhFSjO8nzzkyRiiwPQ2h1j1K3UkUiKr7zCzwHxn0zK9A2",
+	"Y6SfnmQumu8OOsFz8prRK5Wx8MnHqNy8MnJToFXgHqSfVmY6NczHRInvE/s4eFpc4CUUKJ/IBNICKd6l",
+	"k8Nax26H6tu+oKB6JfTplbcLDbIxT8AKsQwRoY9+Cd8bM9ZgsJ1CQ0eFycRNeNh5vbEJartOq2kjxr+W",
+	"CGClAM6xCr9jNatpku5xwAyp3YcieA0ktQ+qW6B5I0n3snbf5QlXaHU8CCJTdSphPNXPm8ZlTwabGBC1",
+	"Ov9A08QAotfdRZVOXDWEgEkJ0jYVL/PncKnm4YxK9fpk+rbLMgiw35HWkWa/d/LSZDu2JYLFLjfEP+rh",
+	"KQ97iJTHITo3onMjOjeicyM6N6JzIzo3onMjOjeicyM6N6JzIzo3onMjOjeicyM6N6JzI2iurex5W8x4",
+	"t7TP/YbeVJWU6qTpFetp3jpQdu2beUwtp5Bu4xRnatIU5GvA0h0usNgkIY24wzT2WP6si4VxLDz3hdb4",
+	"51Re/6SaiQVv4A+YSV4gFmCOGRdKjxoTo/xwgNaIbWpGncqoxtUVSu9GCqaG9KfKR6KiuAUFkqobSrC7",
+	"jEotyzOqQIYAv8arlbEluWQ+9WAFM/0GFnxMpmrBvRwVcDP9KmgL4s5GAhi6giwvEOd9VXPAWgP1Hs6Q",
+	"vgXUP5BaM8dUrXsKTJaCVezlNn/lWSF1kjDXKuqYTKVCdG89vGcthPc+2L/O84/3tHEgZCx01/MaCh01",
+	"8ecZvdEpj8YSeAM39UP3tqIC0h7hEm7ArOYvOd3HZqg+UoPrdNAJWLUtla1qu82uAmYw2G60C5Gt1uJ8",
+	"y9V+5Kff8xXCIOEZOCfWwHTIFOZda5wCbWeaNxFDJFgE7436nVd7qBIe5SclYoMZozccsRSwkhBL6fp9",
+	"nlqjiT4J/VRyBTYmMhOhQD31RS83Tavp1DN3zUpcCEmA38E1vFAf1tSkgeG6NOGYzJGQViXLTqRDS4Gi",
+	"Bar8qr3z9oGrcujb5QUFhJKeLtw2JqZyG+LqZiff+UVrfIo+p40aZlN9bdabqBaqbaKIaDO+VnXGRGfs",
+	"hQhQ8ZRJA4eGg0EL01/gosCmqJrKYpF8ay6MNaSgMJfck5i75lSOkNg5BVPNtnyMPx4M9nBYogJlIqQW",
+	"Pr64APap3UtUoKWcHWrQ0qqgSgse++q0JqT/i1EqgLZ2gL9rneA5Ildi4dNkJXDtB2sb57QznCsdoEkj",
+	"UHgIzS2w8pgMymLhs/KRwqWp3Nyp3t0UEArMJGPil9qlDJwOBmDJwRdTH4rpl1LIuf1RdguJKdU2pEBS",
+	"xtRiwhQs/aPWZyzhqM64ZnGRP3t6qVm5O71Uo9i+F12jUPubejxIw+VCbTlYyRuqcn8+lnk4drofX93q",
+	"DhXUHg34wiSmcwCt8UJe9yXeFVDgtS6gaQw1dRXkyxreBYr2tf2sW3GxYa+SKwjZpb6WJ9/W9tor9fyM",
+	"M/lOCq7wGhGAsOKPkIOpusYpaz/ULHpqSgTK+8pUaQbGxL1MwawUY13YkIpFiAe5S2EXLNW+p1rFUjQt",
+	"cVIaFat6jh23y6jCRhU2qrBRhY0qbFRhowobVdiowv5FVdglfH+uVTwrcMw/h20Dc1T6otIXlb6o9EWl",
+	"Lyp9UemLSt9nrvR59rdA/Sn90LfLBSxrlLlGH5jk6L0brmKuzSOegqvflPQfE6oqXuuGQnCjhgMowJJy",
+	"oc5UTdHsErSjScnhimPY0Pm6M5Xq0iUyIMfRlJ1Tck3N//rgzA5gaEnXCtUzpYBgBhgSiCjkXSGGqVJi",
+	"6BqxMYFM13ZW0QcoD/Gtyou/M4CoCpbfFWkz8RPHtg/28sfa0TIIEmA/Vc/5QFyrBXNMMF+o/UBcr1OJ",
+	"Si7rRB+YkValLo0+7BPUsUc4lX+J0O+kh2VI1Tao04renf+mEOnA5Df1zuGZb/slMW2xrQeJILX1A3UU",
+	"sXlk9hBUcqtqFdQV6xkzAWMm4NYQsFVk0ZFF/w4seqsq5Lczq9SgG+TbBXZi9xZqtfBqbIRFUTuhOjJn",
+	"1WBW9coNpWenW6P/GrvqZJADs8YqPcKzzLtT0F24ZXaLuT9SbJ11iiwvSF9JriiYomC6tWCCV5i41O9G",
+	"JgLkE4LeCy+txLMKyacrhtaYljw8wjV62Z2lu3tUI9Z5n8BoZxw8NOQ5SusoraO0/jNI6xqDCm3pY5no",
+	"/AStEMkRyTaPpedKSb6ieDVPRv/aUn59/3RFrypS7qbqmX7UGcBEH0otaacCcWvCj8lOBFhvf/X5ZmP8",
+	"Sna1e8QbA+DpYDBYBuVavYN8h5jG3J9eSmr5mleGYj9xbRtXd4hoa0/QEhqTmiWzXt6hX9kDdRrLNvx+",
+	"pnaqIaGr9fjZTm5P/f0tiUrb3Y3EBoAAMt4S1+ovrSgtVEWrUGogFnxb+pDcUg7mDCHgmZ1voElWtOYO",
+	"OUWtfsPR6U5+i/MCTaqPbgVDjvUA4F3z7q5mgjlHt1zxy1eX21d9crRHGan9F60G11ZtpX/lBG1AsBMA",
+	"Q9577ADUzRbMC34HqAPKYxgNa6/lqsH7HPJwJ2opL83O1Di7zsYxa+9M7VxP95rQljOaEN6VOyenBHyF",
+	"iFA3I/ma0ld8GDABBBIa4F9DyY53+tAazEVRuEN8DwNq2xRYQuj4AlQbQuqQWNUfu0YbvjuxUI6S+5BJ",
+	"UVznKycPDs03bP/yTkp5GSUggzWeY34HdbW8qIF2ERq/iIu6bqu/2jfthoqgHYZ+xSoXuO1KVDFws9gA",
+	"3FHwp63u69iIwwp5VYEanxqcUQmOg4r9dKg7Py6qQiV7rt+Tn276xO/pf3hfHFUixZUSk1QNjX5bu827",
+	"2SS0tS64uwtaVi9fVt4ElOuFu27I6L3E5D0rWla8bXDiXuliITZZug5Y4MMhuo+F22Lhtli4LRZui4Xb",
+	"YuG2WLgtFm6Lhdti4bZYuC0WbouF22Lhtli4LRZui4XbYuG2HdmXZzo7xte2UuOg9HGnHSWf04z3/VD5",
+	"qxLniN/bJ86lmVFpDaGpb0R9F+NhbhMP09he39huTem73fTyFC5sN/hoRYxWxGhFjFbEaEX8y1oRH2vA",
+	"XgdjAaMzNDpDozM0qjFRjYlqTFRjohoTnaHRGRqdodEZGp2h0RkanaHRGRqdodEZGp2h0RkanaF/Lmdo",
+	"II/2AKq/RSrrEyjgDPJa8p67G/5bs1j/xGmmeru763JllOmqxpQE636cP2mUukqBgNeI6OsrFhz81DPV",
+	"+3rnub1vKG1/xRBXFQ5Lon/D2aKqXokzJPWWK5QDrOsPBizyTuPsTLFGRobokbcxbIcvpM4o3TjRcglJ",
+	"z8kb5ONy6EMMCbaZKBoOVB80FRkF1QmZxhig3lFGIFhwCuQe2nzJN/JR70x+DjTvN/Vc1P0lQtKVTMoF",
+	"XK729Z+EmJxUr8+sbyBeLeLV4ne4WmgWu4W/Kc9H91U9lnyIJR/+MFncYP8OFExyvMZ56eMP1pFSdWRW",
+	"SdOxYEnE3liwJBYsiQVLYsGSWLDkMyhY8muJShRV0SjM/0BVlAvK4FXEuoh1fxzWBdtzdED7ao0YLAqw",
+	"qEHdA6++B5QUG4kO8rF/XVKRBRW8djWvvk/S5MmrH18mafLi7Pzl5dOXZy8fPw26Umpmr4Yh9OIVeHh/",
+	"MARujItp0BigEGKFmESCA7ChXIXR4MLYSMuVxYMAChzfHwyCSNDpYjyreiQFHYzD/qA/SPY8Yn/DUmtq",
+	"CXGbc88J9xyT6+gi/M9zEZ5zXqJLGZrnNdlueqtXmCE+waTeL+h+u3vVczxHijAMIxPywx6VpKBcAUHB",
+	"2dvLZ5MXZz9NLl99//Tl5PLyuW9kvB++GWV0hTrqOUtwTVBUNe0Vg6Tumqsy30YS85K0+reMVlMh5M7I",
+	"bBFu9yuBYLYtnR55qTc/uJCswIgIf+84wPKIciBoClD/qg8geHwOfqEzf2VJhnsEXy1EsdndU0sgAkkH",
+	"APpZsK6wgW2GVNy7oF8BgwvKVyJH2KX5gAkElz24m3e5V805v+tC1lxhazea6uySCoCjwdFpbzDsDU8v",
+	"h8PR8WA0GPy8txy4RpsdDrhrtNGx0xxfEZRXR1fbheuT/grn/c3ipDf77p+/vPrn2bf3H/+4GfzG56+X",
+	"q+83xfuLB+XZj+z9r/9Yfv3y6Psz/F0InIoGbofTOx0nHnJ24NYWbNp96GmityaIeuuTvm7Pp/cvlVjF",
+	"kQpDBBB8jSBDLLC17rU+2ny3yo5f4Ff4u+OffzwX//zxdDF7Vtz/+adzkR39Y5Mvi19+vjjn/b4cyuCP",
+	"P8ih7OXj0xv44w/lc3yC5z90Ah3EAwn3LwKDrIB4WeN6CnyG1vQaAVwnicHsQXY0H6DeQ3h62jvJHs56",
+	"j+Ag692HJ/PT2TA/QsfznQRjN8LB5pA1bRNT6lNHiLKk+O92CsZ4paiMxHilQ52gz/EaEcR5txu065pl",
+	"bxmF+QJwmv1f5PrUfdF5fR684Kw/4YZjvxfka9JRLr3+MXjhzoIXXsf6GoFNobS4iB606EGLHrToQft3",
+	"edDeqIyOrSrHoZFXMVz4swpRiof75zvcDkdzPJw/tUc2Hs9f03XJrIysvJfyp81n5sD8k7ka36A1zTru",
+	"jL+L4V5bXvOtHx0c+lHfDvx7mHO19dYDfafdttrXcFsl5p53eM68AQATgOZzlIkULCkXysgntWjMuOhu",
+	"UByP7/bHVzf9NL7pn13X2V/v6bsNtXZ+Kp9BF5DT8F6spKhw2WEenkgRljO6WqG8D57UPYBjIn2DiAtQ",
+	"NDzBzol5jQjXl2tCb/r1ejSfhjO38dJUTprbeGi2+lm7MCR0lBf4imBy9T3atI+wywX5+uzi6Zvv/d7f",
+	"ZmHGiXaNNsoHparQYJWsptzur99+/fz88eT7p/+8UPZA9ePF+bcvz19+K3+dnD/5PVyXGioZ3tm5FI4Y",
+	"hgXmKAdP86PT0+Ejby1SWOH5xhbx0YjUAlQ7Ap8xMisf3Dydn71+lH379auzZ89uls9OfuRUDDP809fP",
+	"br7++qcfTq44PA8qPChjSBwKqn5Lgcr1cXqA9sG5AFjXzpIqpallhjlgSJSMqJ6+xUbVdmouSn+4L16s",
+	"H/zw6NGLk8XXm/nPb3sX5OlvX0/oz+Xx0Xzxww9vX70ki29Pf/j15fEPqMzLdb/f38m2nMfQO53a+t+F",
+	"60YUYtGzAXP99XCyM+s4xi/G+MXf7xLAUVYyLDYX2QItNcJ9DTnOzspQ7VD1CCiXJizFAhFh9eE5dWWm",
+	"5KK0/m2L/sB8iQnmQorMNQKI5CuKieB98JYjxnX394ySOb4qFX1zsBAryPlNDhARDNuydrOMbVYCUAYg",
+	"u6LkCCwgXyAOvph+fXZx/niiGPLbi6dvLqbpmLR+nHxz/vzp9Ms+OLMROeev1U1RcRzqrwkBQSmgc6ED",
+	"ibQTGNBSjIkuFXqzwAVSKzQOXQ6mJ0ePwCWl4AUkG2AUCz61FfmmXrLz1GQ7aynO1eYno2Qm97dCUekj",
+	"124jjgS1RzJDkCH2jUVtyVYvXyVpgNtevgJfvC6gUO64s/qBXZiDB0oLAk/fZwtIrvSKXrmYsC/B+kTz",
+	"4f6YnAGFLVb+a+LSq1M6CtNlsPT35XcQWUjHeg4sloE5gqJkiPfHRC9gZKNT1if9gmaw6H9YwU1BYf7x",
+	"X/0Pc0oFYh/fyROvxhlRFRo4JmNyqRWlZSlZAWRsoytJvV9NjRYjQfOKcwJ4BTHhJnSMlapEq14Qtyg8",
+	"JtMbNOvZqlM9uMJTAMscI5IhiU/fXbx6CTQUUm3QZnMp0M6fVEUgpx/GyTXOx8lobPW1cfJRoipHBcoE",
+	"d2FRlcRWARoK7WtiMTUKpqocyHVSh6BghgCjAuqyqWNSHaSr9Sa/oENbgKpeZhjFCEzrwU9T+TldvLMK",
+	"Z4MkH5NpLWpqqvVAqLZpCWYwu+6DM/uCi3jTkOsIuboWuYBiTGolh5e6DtxUD7enRlla0eoCCjOIlzMz",
+	"4itdY83Mogo3KsIE05PBCXDFgqeWVy2bxKcQrEl9H1UwxZzq2hJEQB3iJVVDKYjQDKgqKmcGM8BFuVpR",
+	"JhITy+FKmlxhsShnqpgJZNkCC1Xekd3j66zno1bbZn0GbtAMQM/4IJdvK6Bx9VTXvJTIumJ0jXNVzk7o",
+	"asaesAdwRksxGpNerSKT/HdVC0Y91QWubNXd2QYUaI0K+ejcxosoCq8FLenHzTq58tfnzsluku7VrLby",
+	"3hJuJOZWSECJrA0o/5NKAsAEzGT9Oh1QqeNgTweDirCqcsMF5roO3ZhAwOW9G64UKfzXfwEZQfEPvWJM",
+	"rhSrkCqE/LmUyMrREkoGabdFETHJAddHKllKIfCqQP4AJdXQFUZ8pKf5LzsHuNCPNnID/v73t2+eg9dQ",
+	"LDwQ/v73EZjeWw/vTcEXK4ZlewgTmvClfueZEhLNN85en/fMTyOwHlpZAr6AhToNKWTNB0ztRKBqJzY+",
+	"42HUvTXJ+z4W9tfD/+sXTskUfCGpxSmOVbTwl3r/kLcBwIhxXhaKhRoVTdd3pHOwkgQpdRs0MicmFoaK",
+	"vSVNx8SW3pSP1ojkSuvMMQSSMuWXpn5NyKkaKCejHFkEwARMz7IMrYR+rE0xY2LO0uDYDSZyO/pOWFve",
+	"XVXENEctcYzR8moBOFLmUG/dEkfHBBJQep8376WmdOQvug6aEpbTk8EAfA1zO6suVgnJmEy9L0zgCtvK",
+	"aqpQ7VRqSAXOpCCoP1T6eh/8aEvvkk0K1kOlj3MjCXy8PK8YgsSSM2Xa1Tout1V560diMQyTXGGMkUQG",
+	"ADWL/JIZXt0ztE6hESOnWblExGEGMk8LeiXf/ZoheK1YjnnHCCawhL9Q5qbCJGNIfsbQtFVj2tRsFCAt",
+	"Zera6kgThz+CS5L4NF0J9AIKj/54h5Jk1qDuVVz+Q/+ltgsSfoOYRRmpwdQKH03BjUJ3WyFTR0xhDiDg",
+	"QgY+pmCpgzNdcZ8x0WXvnTZiYyBXkMElUlqpJPYlLLTF3BDoryViG4mABgnccC51bBVSRa4m9sLhfS0F",
+	"U0ZL4VXN13isWZz61RQ+nspLPh+Tab1601QuSGLK+ZNmzSVbvUnf+lTZpVohJ8VV/EpOU6kP6FcCNZ36",
+	"WhjZhRm9Xg+f0dwyN3N0NZ0Rc8ceFdwp4NRIaPnqZkzWmBaaaLDgQOkbsDoGebSmbn3f3I6n8qLBca54",
+	"3amUdseDQZUIgrljKGPSwVFM6SWsTCcrRqVIdqwAvIECged4qeI7LCN31fLd/QxohZFRIQqLiZqeZmV2",
+	"jQRPwcoGltsMBqWnmeLUY1KnOz3cXb364EJLfC1oKx3TeJqutEq5gGsEOJJ4JRCYlfkVEvwrKd+VUmB1",
+	"FMgVS6+i/Ppj8saxNX0RkBgBBVIr76n/n6b1H9+gJcRSJpgKwvVnHAknnvhXAL1fQH1d0GvioFI5b3MV",
+	"rHE0oIW/4gthFs0FJDlkHrcxkCn+Nv2p50vJ3iuNYyNAKCd4Pp+aQd9IpK+ePnn68p/20U8XF73XjBp9",
+	"bQSGX4ElzdF/q5hoPehCMJyJ3iWDhEu51bPgj8ASvu/BK/Tfx8NTmfA0+MoCflHOdGlBrr9hwbSv9l7T",
+	"AmebkU2S6XGWgb9xVMz/pl94g+aIMcTcQK6hoAxfYdKThN3LGOXc/KLfeo2YwQzuXszgEjH43198mYIl",
+	"zhhdLShB6p9XiBbGbP/fX3w5tUSC1hJ3uWAILrm9hMlbKCBULHSRMybRla0NxYzJngsklKC/fQXm6jyk",
+	"GOFCCgP9+7QPLl0Vb+9aulJfUvkDMFsAxW9BBonUpz2Limu1Mb14+vjtm/PLf06ePT17Im0if58CjhQN",
+	"cn0hKnCGTACUueW8OL9s3WfoChFdYLpP2dU98xK/J8dWFScDF6Sz1+de5LH1mn5ME/lFuMLJKDnuD/rH",
+	"qqy9WCiTlNSRlRHpnq1xsKI84Jf5FhEpjRUXcdZleZ9eQcyUcDMyv5Eww/XuypE1S/MIYCmWPFM6ttds",
+	"mJvi99Oma2BqZBUW3FkA5HnJf3uWbkHHZNr0IEyd04dI6s6QzmHT9ikJqLoPmet0zSShn0+lkF8uIcn1",
+	"WTp9/Tz3tsfzmVTWT7WtR4OhvegiHZ7o3xLkjUD+pkVY9LdEf8uf2t/ysWVK+d6ygitDCbncxJO9kN4s",
+	"1PMN5xOXksdtc0ftGpZ7Vr9C6JFpsoZFiWpuneR1gSBHgKE5Q3wBNrS0KhVlJjtBaZuJc8w05vfKeyZn",
+	"gWmllRyYV5JGutLJYFgLsgmHCWgjmL4teMkR9ZWf6wEGZH/YtmVrT4FatPeKFnBs01p5CAp//RYIqcYV",
+	"cgOVG4GyO1h44LDtbPsfthQzxkSXV3nK1ZWLMu+kmove87hV0p164/aLtte6wKJf6EeHY7hZN4CmXYaf",
+	"l2ptAnJBlOHf9DddSVi7E3Ww9tkJx0NuuRUf005xF0sNx1LDv0+p4bbkekugoQyUA2ll1yQkdzBIjlqu",
+	"nXyCMhexO2L3H4XdZ16AgAmTM50dU8AxyRAgFLi4hLqiUAsdUGh/9OhAdc5liEMh0HIlGoqNtOPIx649",
+	"aF3UuJe2yT6ny8gDe1RZ8zzZ1obCl2/7A1HDrEcyH6su+I4eRcEXWcNfhTU4vGfWRdcDj7UFuQrKMeFk",
+	"O0lDL18hh7cr3ZtcEoELtYlyLjkR0jRbUUYrv/GjH1alSlp7AVX/evfxXeqxFmuRAdDdxPXFVsArLq+9",
+	"ijMm7+Q3KyNYIzT9KtRkUAa1cxd/fO1uG+dPuPFOMAS4wMqyrJ0Jgdj1fsuGJL/7xpu/ZUAafILOEWPu",
+	"P9eY+zZhvwkdZTTHRHNMNMdEc0zUSqNWGs0xEbsjdkdzTDTHRMEXWUM0x9zWHJMmp4PBgSzIlc+UaI7Y",
+	"xGGgf9nQQ3SsF9N4tCfTKaBArA/OTRQMo7MCLcEKMY654CkwiSY286B29wgBVtO8CSgJer/SAefquV8e",
+	"rYZXMolivwuIIfdJSeAa4kJSTn07bOFNebiUQYaLDfAHd16/zJd19miO2BWV6tMSypUSqNKrQkwbgjm6",
+	"AUtMSoF8th0C1N+ei2q6blAbm3QcOXTk0H8VDh3mS4cYpaWJt2415gGLdNoRg6lra3AAbai4jIDU8ZUN",
+	"SbDEeV6gmypBhgNs8kmn6t2JAWKqOf+YNMppGHMlgCrJ0MW42nzVlu3aK/uRaOMl4uJrmm8+4V4Wy4T8",
+	"BcqE1IcKVqKPdxr4Gp0Kt3UqhDRMiTXmC9pucqj21qjZXtdTdE4okJooWFCVwgOFSkElVKjIbh1RnYdV",
+	"FvNtoL89UnIEo7XUWWRo/OjePUwEgwSJvgFJ5fzaVNT3y2IEQiMAQ5wWa6QIfTiQQfH94ZHNMDDxuNBG",
+	"GcM8Z4j7ek9jzbW76gLJGtYGZL7Huhum2cGBpnmTztRhnLdpRnZUWDM0dUJUFv8MgRkSNwgRcKpcEcfB",
+	"G3tz+pAjojm7s/5/+qrNEYfRTW12BonZa9NvJLBw8zWbu9xCMJl70YlYtUT3wM5YEJvYYX6XqVRFDuow",
+	"fuKumI4GbTyQGGl4VnAj5HN7+tZFobQqytR/L0xl+eYaS1a01mcPWU1qaKnDIzM40CPj8h5VBmbYNWPH",
+	"6CzN7qvQ30pW/K1K5fR8JU2HS2NWf71vapPJ75iXbrvWeK+J95o/wb1GprcaLPI8LpKgqZ+THCMIYgRB",
+	"jCCIEQRRnEVxFiMIInZH7I4RBDGCIAq+yBpiBEGMIIgRBDGCIHLoyKF/5wgC7Wi3IQC70tnUoC1VnVTv",
+	"ei6tDPWqTbZvvI4YaJVSMj3/dblu3fe/KvN0/gRgPiY3DAuhndSVx9qUcbbVy23enBwkCWn6i8Cm5HAo",
+	"yEBB+/vEGGCiN0dFBCQjWVUtbaX91aMCnC3GVQ/U5XPVbr04+2ly+er7py8nl5fP/TbN98PtQV0L/bbz",
+	"n1ZlMKtprxgkolb56KCW+7b8/e5X2owBk3P9meHWTv3thZjqhN7e8Sq0wqCURack7Wr1vzVuwW/83wZA",
+	"P1MAuJqI5jANbK6o9le2gJyNDgFV+/oKMIHgsgd396Vqdr7/TMIouoqSVRJT8gPlnzX8xJ3971FcrCKi",
+	"2xHFzh7YHnZ3IOcWdNyNNSaEJIy7DQatirpxRFRHB1gzD9dbmNvX+mjz3So7foFf4e+Of/7xXPzzx9PF",
+	"7Flx/+efzkV29I9Nvix++fninPf7ciiDP/4gh7KXj09v4I8/lM/xCZ7/cFehS2kVuwSwuE340h4BM0nq",
+	"B864KmctarxlHI1mXTGMJobRxDCaGEYTw2hiGE283McwmhhGE8NoYhhNDKOJYTRRnMUwmhhGE7E7htHE",
+	"MJoYRhNZQwyj+X3DaI6j5I3k9Rcgr5fUL8gLMK8J0wNCIVQ4wB6REMagfu+D/es8/9hZ3PeNNmYjW+CX",
+	"l4VqqwwlIq8xLXmx8drp2k8GGkKJs+qZZ/0Z/as5peyraZtwCgpKjkwfZXOXRFyocDTVNkj3Q5Raiu+g",
+	"Xg/7Y3LR7JDKZQ9b1XRImanhFUOoquVgp7QNo6BqhpmrxRLZW7HZHrZZ8GA9TNIEyxU4JDRdzbwGpzXO",
+	"5eIr9Ls2CmE9DHbaD7kTS4J/LVGg35V3EBWEp6cD9PBkMOiho0ez3skwP+nBB8P7vZOT+/dPT09OBoPB",
+	"wK5Brr9aQYUqSdMx7y/I0URZKtNHcw3vblXD2VcUMyr/VE1r7QprmuJj+9ztgEFaXze0jxRv33dXvJlF",
+	"SGcbnspIgYwh2D1GRxPkpSaMZJQMT01da4m5aq0ko7ncLLnWBWQcqe0U895DJz0n1YO3l9+oB0sk4KQ9",
+	"fon5UnauTEZzWHB5VKqPXoWiH3Vsg5paWQ0n8p8TJ2P+9SGBmYE15MxS7yRpolwXcnxScsQU0qSJM/y9",
+	"UwAuqNzt168uLhOJCNV0fKI7dUuMkiYhKmAxUb02k9HRR71qaXJSPylQF0M1cnGUjI7TZHGcjE7TZHGS",
+	"jI7SZHGajAZpsrifjAbyZbEsJlUPQNmM/FS1HiTXxpBsglTNhA8l/leNxSdm4L8+uFtCy19nRIraIBfk",
+	"2jB1nTS7Gs6Yqh3jbWYiSdxO8pgSgjJX0IWWojlb9XNtqkFzIjOuPpOM8yD1hQ9Pm1t/7HVXfKrfBrqd",
+	"ZiVOk1FFGK3OjbUppeCjGVcuzUnG4E3DzfjEdG3WKqMcBfSoTyHck+2EOxwNjkYnw62EOxwNAoR7tDwZ",
+	"NkjXrUkfrcWcB2myhO8nOVqJxYSpXvy5QlvVRl7vhPzl5Mj+lGNuuhUrvNY/as1aYbj+QQquiUdEiiKq",
+	"R7QUE0kmMh6YlSSDirwUG/iYHspm7o6fdBL+oIF9w12Ef18T/kAT/uCTCf+0g/ADpHLysAHsaZhUMr4n",
+	"oUjC6Dep5QqLRTnrkHPfYvGsnIEFXSJ55r68vz21DHeKudPRyU4xd9qmlpNPFHMO//5AMae3X4dsIG50",
+	"t0rOWcl3F0LuuBPXjzSuP9S4PjzSyH6qkf1YI/vwFsh+dAC2D09btPng1MN3jYoj8ByJv3EwK3GR63vw",
+	"AjG0JwFUm62vPAs8F5NfMO/AftXn13Q+hhxcqOHfnV/IW0BWQKbbfFOxQAw4VPsUyjjaRRkng9HwaAdl",
+	"nIQUwKO9KMNtSIg63PLbFPL07ePed69rJGJ09n8f4z45nHGDk/5gCFTrb2Pi2Ibc9/fH7eOjJik+9DD7",
+	"f//v//d//+f/+d//+f/+93/+/z0R+ebmxjHyX1bbjbg1LNx5eWoi4Ic9o5J9hNz3nQpBW5dzAzNwQ/wL",
+	"pr7OBOw9Dr0bdjarMjUmubpi6AoKVF3Gr3RsOtQ6YQoYMnf7RkVGM5X/YmWe6OvO6hor7RAV/lC5O2QV",
+	"SaHSDHS4YeM+rbwdvyFG9e2/vpy64tdc1RPMBSaZAD5yAjUYzOV9AlBSrTbV5kL9GDIDJ8r9/X7gJVIE",
+	"8ygCimerexNkqsgjKZczbT7QU9r4zfYuKqsI1D9a7dUD6mgXUA29t9VMWj62QZQoT0MQmPb+tXlP/ImH",
+	"3RP72nXH3HRGBe+L9wJco5XQwmyGpFAJrPd0v/Va/T08pfmuiTr1gzptkG8KeJktAOSGGOTjub2FHrj3",
+	"7atDGCo5DkATTiRHGqRULymkdGLAA2K4PxD2ktI9vXItgOliOAVGqBgYzL80GCF8ON4Fhnctas7/4wIp",
+	"3UFv9Q1iCBRoLkBJzEGlqik5mEoCU4Omqlv+AunyqwVeYuEYjHwF3EA+JoYK64ZDo7MaAGeUFgiSVgh/",
+	"nWoaWBXA7RDxp3UOFTiJTgzxtyvUsMtXW+p7+XgBGcyEp4lVFH0DORBSqmdUR2MBZdCyFKcM/5rwVpBx",
+	"lLc4rtN0WrNCQgnOYKFb/Zuj8HTBSmj5ulVLdDWVrdDqOBJG76zEx2Nt0uxdblbIOCVSSbwmDbAGgK+/",
+	"BZwuAh4+vdzdzvmcUtiezGmJXRQhFnY5LmtRnaQBAOR4PkcMEeH2umaC1spnE9UrdTQwLUO1o1M4Y9mO",
+	"QpSRej7bCAQok4AtIbtOjWzX26OPf0wCp2LpdjouB4PjTG63fU39gqZqoVOp4PUkPa6nZrGwpiDIXeiP",
+	"ieZc1S2EUHBN6A2p4NdFqKG6uGhsV/xMLNBGPTNhsvJJqqaWjOMGk5ze8N7w6PQIqHvNDebWA2HcBjO6",
+	"dLcDcxNIUudjeOdjQNNL1pE2ZNHOnY+HIUEm8F4gwm0mCXT+yNcezWoMaLn89F5KRwDDs1JoTK6+YMUg",
+	"46nDO8n7Smb0QrJxQ+TGQgI0WEaGETBVxtOpdUum0tlmZrHvGbN1a1lORtZ5T+ia9KGrGaQ1L7RU3Rmn",
+	"RSmUi2sJ9CiTJ9GiTmt8aH7jG/mqeqiWwJNDkviszSL4UaVxKdsH0OP64Nunl55CoKKaKUe1gCCsVqAj",
+	"iAt6xfsekn779DJJtX3k3R7u0ja84UtoE/qXlTbrweppKztUA/9K2PK7yYeevqy/bXWxbR8Ora99Va4j",
+	"jlaPtoO7ONpjzPEeY072GHO6x5j7u8YEd6J272/dnizLV/aAtfOmVkzNGr1aKO/uY80c4LotoBuH7Ehg",
+	"9abtyw8ZHzoZw9ZIDP+3riiOjoCGFzBbYKJFjYrKYAhyc8GUMOkY9QpQj0ZrfqyccPe3KLy/nfspc66q",
+	"CUPzUqtptR+1DFlQLial1oFN7RIXcMdQjhnKhOJdtbywDJIMaR2XIHFD2bUDoZFBGcrOPyjmQ/X1xWuU",
+	"B4M/TF5cZaVhOLkVB2vaoLrxzo7cE+8O4lr7fDK4Gm0bC93YgH5WUzXrbsO99sta2aqyDJW5LXTGtzyY",
+	"QAystfG4+eSdWxLIvCwKdXZHg6MDAxa0diE5vFMjKjv2mX0I7C3rE03UJWOIiAkXaJWM1JZM/EgQLvBS",
+	"GQPNCiV5SkKWM3B9ubpiiPNk9PC0OogEk4l7IrdtjoRkLlfq6ltf0TfmkTEPmX36ZKdUY121+bev66ix",
+	"sKNtC/P/3T4orlgmcCM+OaKkvipJkfue1nBQX9X97lXdpQG6BnDrLqqfVqZSNcznBs0VtibYsuSWgLRD",
+	"tcVFUFC9Evq0f64N1mWegBViGSJC49QSvjemxMFgO5cMcSz/CN59GjPSnfN9vLtViQUZVFjgTEiygSvs",
+	"K1p+9JQbBLwwPL4lkw6Khb51gPVQRrMW+rruhbzZi7YZdeQF3HcB5Yfdb4HpEzPdXRRiOKWweuytvhkZ",
+	"nNygWc/eIO/9NDta/vBo/vz6rCdp/OQo8eJ13Yc1ncn/VHOAKsJuZAJoZ5gog4HGmmroyOYWgrdvz5+A",
+	"QlX/GYHjQLqivwQvUc+bth7GfEep9iXhNvayG9feVoP8cw2jmjcArI8smO4DafVnLdjT25IumPyN6QYp",
+	"5uHHAPPPIw/fx3F1FEHumspnuMkIY65+zNWPufoxVz+KvCjyPsNc/eODC2/zcj7HGVZ34oyuUDNrWaJv",
+	"AWV4EXSkBfTITn6naVFlKKmqpuo4xo1CjeMEVNX6HN9rgVPn9dVjsELMOHYC96hYQjqS+l+G1L+hbIbz",
+	"HBHQAxX55NSESFUkVKc/vn95jpY1V1rMCBUT7fIL2wn98KwAob+klZlMDasiGJ2+c/7EI+7AxPVC+oF5",
+	"2ylIeykzM+nf71rg1/LhHqtTH9lvac35/HW1p7vlolTg1M5Tc1U7W5lHdR7tzu4G6jeq0ES1WPW6DSHV",
+	"EQsLNVJHlPkWsAZcNcuX+sodLL7kiHWt/S1HbI/zlJ/oPM769cJZXOqz1gwtzUlvtbAogaIE+hNIoDdI",
+	"R0d5CC1ly/AuiyB/XSti3O7fcrD/6bhmmW7WSP5D6h2rB5NPqXqsAhWa2a/ODWZiYz/qCC6tJEx0wEPD",
+	"Z2mfAv30DrZ3WNte2zoGSZImGOV2IkF9HoRywAwuefvWgr29c2cdX1M+WKVhmE8E90wp/KFdc9ajCofb",
+	"xhzz8A727Ki1Z7ZWQKXOydkgJq668Ivnno+5ZfWqP2mgmu+grofcu1jj9l4dDTowTH5tIsNZCom9gWw1",
+	"QSlQz+5gp05qO8XxbzbiHL3PEMpRPgLC3z79MwfDwcnD0wf3BypY1qfNBvRbNsx+Sn6/mvgWe+UHArV3",
+	"y3t6B/s1aGGW4aOS3TRLaMtIeVPN6FgLLb9+UW3H6sFMzT37Rq1YUqWKV1D7NwJZKLF/b0bGkFQ6vGQS",
+	"vxOOfGQrMH36pp3WNo3ANb6Cyk0gqKtrDlerWtH8ESBIjEZP37yZPH718uXTx5fnr15O3jy9UFGfdu/q",
+	"i9hKnbWjkW8dwvfvMPLh99fZ1OiulyZ3prm1t25nVJxSiUWdaL7Ac2D0mVmBvkz2i4owJ/OJARH2fG9T",
+	"ZzCHuNhMFM+aWGbZKEMhRzTYafhW9A1DSN2LdA6SekXT+3AwqDrqrKTUhxsP/4NA+NcjDYO7ZLaAqanf",
+	"D++f3LrCYJowKNDW/XgDBdprO0JlFu8HiiyGZgyWWbTl5vog3JOwuRH3Y5nFeI38vMoshonvdjUUoR/k",
+	"Y7IEncN4SzXFNPmpJ+F4LsHoqf8PpdutYIbFpt4MblZm10g0eoq5Wm6uQFxt/iqNdJDuAuYNWkJMglmH",
+	"b+wmejNnkJhouiW8RgAKlcQZnv3RHpMHM/LahSu37IWUZmVRBI7BAXI/DRW4bJYFfA05EtTUBWw0+WqU",
+	"CfwWiUBtNFcs0DzZUS/wHlojk65yFd4FedvtXchFPlVDASL5imKDAAzBQtFKBYoNbwTlSlISV8n62HuP",
+	"C4bgUorDNbKDAJzRUtQz9O2H+mMyJmpypVzx0Zj0wJQLyATKpyMAXWFSyWNYSeqfmaErSL4CWJ2SYjNa",
+	"9thsvWoktunquZrBzj8d1Yct6VqrBxAQdKOiY78yEZMuu02CoVMX5WP5ZfcJm6FmVoFWExembhZjR80x",
+	"wXyB8q/AVJ8vn4IFLeytzdw+sPDT7dRXqw+CezZhrrEId0ECArEllkKGCyiQy8rTpyRPjZv9ZxugsAVk",
+	"kDGMVHLeFOfTPjizdMCQvRb5tRymzyEXPXWCvfMnUys+TZYGHxM5SCOiXM0Sc45ylfWqakJAXiVVarKf",
+	"lSo5Ne+Di3Im0XVm7jPQ7ZlbqUzWXhVww5UYN7PIRSKSc3+lho30wTMEmZghlcC/XOrxcmq5QBcYOyY+",
+	"2uC8QIBTbeNaMfpebs81QivNJKqrIl0hEmoB6pXV1GQWi2veTXHNSrMrIBcGgW1+kGnXx0vJvxweKL3D",
+	"x+RGNYIg9DUUT4K1NDER9092Zurctjrov7PYp0DvhZYkPb2JrchBdflVIxpd2i6eGvVSP3Q3kgTnIzAc",
+	"E/XzCBhuPyY5FHAEPoz9m/84GYHxXtbccZKCsVEc9Vv2w+qB0wf1s9A9Y5x8lOxQQnfkoLOSwgNPMnb9",
+	"lVqSi57HjU9G4OhU/mKUa/1GMPem3+/vCeSpD+SxA1Jt891voL7F69/1FOrnpl1tnLSW2S6Nud8Cj9Up",
+	"+Lkgk0qLqaOWHWD4/u+MXoP/ZPTaCuQKMhXAKFOD2zCeDlowvtYv1DwD+4P40AfxxDtlX9kKAqpyl53q",
+	"0IL0voLUKGPyhw/jWrqz/ohKYLagisIsqZ5COU4+7rOUYQ0lTvfb7Vp6VnsRD9oo8aYkqnB57c2993t4",
+	"5AN5/5D93gHqo8B+19Nu5Y9DtSD0vvn7w/22+MSH/oGDPgT4HXGF6tP77a8huaRu4mrK6ZYlQnJAo9Qo",
+	"/axxr4r5ZzH/LOafxfyzaNiOhu2Yfxbzz2L+Wcw/i/lnUeRFkRfzz2L+WST1mH+2d/5ZjF+L8Wsxfi0y",
+	"whi/FuPXYvza/vFrW8LHvFi2N3bUrmA2nZTbFcv2HNtz8NvQuBKhpiFNIwN4apJ/++rxNAXKyKKyglmO",
+	"2GhM7Ae9N+eYKRa88PquVL1hTN1eeAM3lj/r1GJOASV98BRmizFRn7SRVljYED+e2kL3mqvhjjYjCvo+",
+	"OLPSX0UmQYbGRA5UK9DqAiT8BjG33JPBCXCNMaemRcy0kd081ZN/Zb+DyZjYc5Pg2e7h/uchlzXap7Ls",
+	"eOcBTneERr02SdcxMuo/s+1wcA2KUOi8og8Vc6Fq8Uricrv6a4nYpgLJlJwN7OZwe/+hNhTP6I2WmLb7",
+	"j6Z3rYzrBE7EmnB2gGXT8AJwHQ06q5mGwLyDHs2K6h1T9UJrlGxxKwp19vrkvEgz7b8MGJ0dXXW3PtUf",
+	"R+3DHTSn/OOaow529dgzDSOP7qo56tEf1BW52Qj13lWJc8TvFegKZpseR6JcBbsXH9/f1sjvW/UV0APN",
+	"3qz7za46M+9Ap2MPnY5qF5OqikZjh2waqV8jI5QL2UjodrYF9B5z0ZHSffLx1lu7gleYuPZ/C8gnBL0X",
+	"luvKf9vW//Y3zXxsa2L1R+0sTtwPhjqPhh/vND3T8ZpQGzG/DVdTD/NbpTTUAcemXM9BJbM9lQpQee/d",
+	"dOpS7W5VHgrt3YpRo1Sn7DANAp1SGFAra+22Wj3jdrZr67hj/7ioWk3tuX7vyu+mr5HF4fd7Fe7vmkFK",
+	"hQ06NTKki7QIbnfCbfXyPsS4V8at+6RigYGLmt+FyfLOOmCBD4daMsXWm7H1Zmy9GVtvxtabsfVmbL0Z",
+	"W2/G1pux9WZsvRlbb8bWm7H1Zmy9GVtvxtabsfVmNYPZ6FY8jnbQzn391lQ7qHCnmnmnOT3dfZS+LqSx",
+	"URtCU9+I+m6PI69bsxsM3tm2PwQU47qlOzSisMEku40fu0c1UGgffHNm78MwqbG9vrHdmtJr+/Zuj+if",
+	"tqk9tkuN7VJju9TYLvWPaZeqeyXcUa/UbZXYL6QyCVeAkj0KlAfCjJul1o21XfIAKTdH9+6Fiq/f43ra",
+	"/vtl8QeVZ/+UwuyfmBhvHEAd2XuWXuyocCaL1pF1yNUMOZ/KqboaHw8Ggcju5vShTMXm7C498NNXbY44",
+	"jG7aOwFJVae6A7/M14D5WgvBpGLWiVi1KL7AzlgQm9hhfm/V0r6DXTEqaRsPJEYaFhzcCPncnj50BeMv",
+	"XwPK1H8vjK2nuUatbdbXZw9ZTXo3NQpsbqTVxCbKihTO3bRjtKWpO3XrbyUr/qYHNZIpmxmZjVn99b6p",
+	"TSa/Y16KZQhifsJnU4bA5yJ+HZRYYiCWGIglBmKJgSjOojiLJQZiiYFI6pHUY4vb2OI2triNLW5ji9so",
+	"gWKL29jiNra4jS1uY4vb2OI2triNLW5ji9vY4vbOWtzq21DsbxvrA8b6gPEOGesDxvqAsT7gwfUBZbm+",
+	"rtJSO7rc/qYIZkV5aN3K/MkBtIFnTn71Qc1eahLidbtZP2PK5MDJdl+kB6qsDZnjxKB78ExnpdlSGV88",
+	"G/ae3f9SPnmuyv/Zeb6wKSr3bI7UPT+pSL/hMvD8ycfkx2CJQrlp9qNm9+w+msoUgup6GKZkgkqRtYUD",
+	"BaWp7G6a2+JlO8v26bqLU9Wn15Tb0U+RZ4HlKodcQ6p+Ncwwk7cYoCJkCOIc6DRdZ6Sx31GY17grMjRH",
+	"zNkSMnMdMnBJvivRauO3ww3VF9TJCEgHEcbKgodXFnyn01AQF1/TfHNwOzH553sVkdrsIaaegBs0kw/9",
+	"MkBOq/MCjFW4kU0VtBnSklRsAor+zVD1xNa+sL/bC+7o/qBVcesKi0U5k7dGxVxlOpSCd+Jq73hqOc3K",
+	"JSJCrVjF07YdNNuhdh91xSd0IQ5bJkNZKz7ecnXHg531xNQakWp9zDIUOJinPfsQ/JEHc3LaAt1A3eML",
+	"unKgE3TDJwZp6oC/RDf8Vuhkyovc1Y5LCPubjC5nmEBBmQOdY7mcdqj0hfpdCazfc7O79teDj1wVSKHh",
+	"BK5aEe7yYU+z/4rqnTEEYJVpgWBeIM7BjNEbjtjvsQ5re1LwoQJlQl3o/otRKoCuSAH+nqSJVNfl95JR",
+	"NezjdlbQsCJtv5V562mWXPFW53HbUGEHVzWEmojKQmkPRj/ABRabJFQOpKMumDJNWJuoQPVacymYU6lw",
+	"S7UFC95IngUzKU/FwpY3hiQ3XdB/Q6rDLtvUKlqFZX8KpmaDpwpNNsZOJ62TjQogrhKPVEG8ilKQIcCv",
+	"8WplVBd3EVEPVjDTb2DBx2SqFtzLUQE306+ChbB4pfswdAWZwk+tDNpSaHoPZ6bze/0Dqa3xpKskT4Eh",
+	"CSuv5TZ/5SmyXlnY2WZM9lWtWnV7PPHgF4lN76DAYFVMSzqzZrW8sNN9CqZ5dRwNXKeDTsCqbakKdW0v",
+	"WFYBs7MKbiCzuM5I9ic//Z5fDSNIeG2etP8U5l1bmQu0kwa9iSom17xSyt89nT/IeFPATNNfSen6fZ5a",
+	"64VL31XFyeeycTgP83cd0U29Wl+zEhdCEuB3cA0v1Ic1NVkfn7rbjolyNVTV0ueqmjm3Rg35VVvwpw/c",
+	"NdkvSigoIJT0tBt3TIwfF3FleZXv/KLLXejK5o2ssKk2b+lNVAs19R+JrmFISWXlQixEgIqnTBo4NBwM",
+	"Wpj+AhcFNgZGZSNyXhu5lILCXPcnN4BasTQFU822fIw/Hgz2SMx0Qq/F/i8ugH1q9xIVSGqsAGrQ0ir8",
+	"rAWPfXVau1+0BOsSk+eqm6xPk5U5rZK73sa50hQ4Vy6qJo1A4SE0t8DKYzIoi4XPykcKl6Zyc6d6d1NA",
+	"KDCTjInn25KIdzoYgCUHX0x9KKZfSiHn9kcVbZKYUm1DCiRlTC0mTMHSP2p9xhKO6oxr5abkz15RDrNy",
+	"d3qpRrF9q/xYtcXb1ONBGrY3WYee5A2V6dvHMg/HTvfjq8GiEy5SgtqjAV+YxqEcQFu5SdY6SoE2Ya/1",
+	"FdfEotVVkC+D9Sl8haxVkmIrLgYKVISLJNRr1n9slVY/uDxClqGVQF3BfdYC6oZ9cs49Qyq/HIou/8L2",
+	"xPvjQRV8rdyiJm4j2XVlsPd7B3V45fayb4fdzcqHd7Dy+/uuvGYl2N+f28wGaDZkmJviep4qsLtggbfm",
+	"QDlAAkQzMNS8kaR7uQnusmJBRc4axzrqD3XXXdDPQ40rDJf1j8wvUJBWffjlurscvjsYm66t6UV6HVrd",
+	"qO1h2cYGPJ+Kb7wbbbNVlhzlvqVSc666Gb9m4WuYDptL+BiLMcRiDLEYQyzGEIsxxGIMMRgkFmOIxRhi",
+	"MYZYjCEWY4jFGKI4i8UYYjGGSOqR1D/rYgwxnyPmc8R8jsgIYz5HzOf4j8rnSJPTgx0/rlmHDqWZONr3",
+	"b/h6iC090EoJ3sLuFU9Wcfem0MusQEsp/Tjmgqc6Dz8TwEQd1BTfEGD1WkWgJOj9SscRqeeAZqoefOuq",
+	"e7q3ldow2klJ4Briop1XfqEHAIGWK8ogk1LZH9x5BzBfluer2PsVlXcWiawCEUgyFJBpKjpsjm7AEpOy",
+	"nmsfAtTfnotqum5QG5sULwlRNv5lZGOYL+2b1aZu3M20NpNuBKDMRACmk8fOfLZ7M9tTcntWW7mSZyOj",
+	"2XQspp/fZgVPCq7wGhEAOZiqjlrTVEdfyxZ/BjxDINMxcc3SZQdGF8MtP6tl3NS4KaWLb6qz9MwvKsrZ",
+	"9CpdplWQtfzimNistWkfWHcsJjl6b0KBdSC6vtaIyhmq5tyoL/fB0yrkHHMXiq66QuK5H9ZMwVS2ywPe",
+	"hk5TmzBmY7xtfLFKY6uVdJGzcS+6pGqQo05ForrqmqmiXDFzTSu0YdSketVivdV7iN/7oP44zz9OdbQb",
+	"FwzBZSPnrjX4nooklDungnmgiUhXs+mQV3VAlWP71xKV8kfilfKrr4ADVpIt2XGquF3Mj/vj8+OC8Qs6",
+	"P6JKz3Qkd9tsGpPgtF9ynEfw4cA/P/xBF/VjTS+Wwid5QqZRu+I/FatpB6GY1n//+rAt3jDdkcK1LcHp",
+	"3orhTMvA2lc+IZVod/bQu4am0w4x85KfFala5o2wiriveHgnS65z4VkpxpotULEIRbW7HotdsFSRvK1j",
+	"qySE3z81JkXFpKiYFBWTomJSVEyKiklRMSkqJkV9BklRal/OtYpnBY7557DdvDcqfVHpi0pfVPqi0heV",
+	"vqj0RaXvM1f6ahbSlqum7R0JWNYoA9x3i7jhvMwW9hFPwdVvSvqPCVVdSrSRHGoPCYACLCkXlT+oafne",
+	"Zbw9WHH8t6XOa4OyfZYCSpCtRMhvVPkpJ+qV38e3LeuuPiqp/EH2CN2//+BR78HJ0WnvZJCj3qOTk1kP",
+	"DR7Ms+H80QCiB3smlVcW67vO2B8ejU6GP/tZ6X4i83b7+KGwDP9d1QNq9viDoR7+kVC3zfr1vvvV6Uii",
+	"sQXxB/VsdPlvrxP/6Pg49aaU2qygAhbJ6NjLia99YWvURIXj+5ccmBmn34H1BvarILDFzxD0sqY2okC5",
+	"WhshHqCS4ZUnrMMBEesvxPoLDX2hYa3waXdP7JRKvt6IPjizAxha0rXSzDJ1X8YMMCTFnNS1Vohhqu7c",
+	"dI3YmECGTNuYkgiUh9Rsj4982KH3Vl03to+rcaBdg3321NyZFwiSKvChhgyI61vsHBPMF2o/ENfrVEcn",
+	"m70MfJ1ruFuv9zjjLqgN29w+rGHz0u+kh6FubYPeHaoW+hn/lUooVZfKRrITu7dQq4VXYyMsii0BLVk1",
+	"2HZp8ei5ayPe7bIkOhnkwKyxSo/wLPN+t1cKsq/5xRIpsURKLJESS6TEEimxREqMIY8x5LFESiyREkuk",
+	"xBIpsURKFGdRnMUSKbFESiT1SOqxREoskRJLpERGGBlhLJESS6TEEimxREoskRJlY5SNf7YSKTNbJsDW",
+	"SuFbi6W0KmXIhV6FRMEbpJOtDD90USt2yu3FQ/REpmaHrg6iUmzcxQIT/QWuC1OwHDGTHDOVq5iqF6dK",
+	"e5jKdWGiiLWeakPnKhXIfhbru4xxq9qA1u1JM8GG198iEct53L6cRxqK3ClbcYN+QE0NvD1DjNUC5OIr",
+	"8A1WJ82Yan8pO2ITw9BLnJQAG8xLAReQqeAshWNuP38tEdtU8JjiRYF9HO7KRfrQlQLlSMhLDlsh1gSx",
+	"AyJFUWGQjgadEWYhCN+14tQP1+lqoXW+6iIlhFtRMwyrqp9kceeWgeoPf/dA9aN9g8MPC1S/Nyvo1b1b",
+	"BX4fDQ+FyIW37QAHsmyB1yjX0d2OZctjWUA+Iei9S2qT/14xtMa0dCV0NGbKMG5FNS6ee6LiIJPR8Ghg",
+	"fzEpg6rWTkcY+f2HVYjncSOK82E9UPP+sBYteTJ0EZHDo8Or+Xxq6PkfGUp+1hlG3pTNMVg8BovfOli8",
+	"xgrq2FMxhg+BrNU6mwiNKKxFZ5uoshxl16gau9kraLpKXt4ROt3apBhBHyPoYwT9nyGCvsag9gmnf93A",
+	"QDVlEsPZYzh7DGeP4ewxnD2Gs0d7fwxnj+HsMZw9hrPHcPYYzh7FWQxnj+HskdQjqX/W4eyDkwNJ3nmO",
+	"CJUl/qQhoEbyNnpFzT03hoIAob/0eraoYVWkhNN3zp94xB2YuB6vF5i3QdYneyoz2hzZsUBdl2P36tRH",
+	"9ltacz5/Xe3pbrkoVfB156k5S6Aa3s2ja447+UYVWaQWq16vKgJL9XyhRrpwJ7v4Jlz+4lVp37tYfMkR",
+	"61r7W47YHucpP9F5nPXrhV1aY1Z/Za1Jb7WwKIGiBPoTSKA3iNOSZchD6JgqFVOlYqpUZHExVSqmSv3H",
+	"pUrtmSQgSbGZI/AtEl3B+4elCJhmmp2ZAhcqraF3IRf6VA0FiOQrig0SMAQLRS968gqgciVJiat2oupF",
+	"FazOR2PS0z37JjacZzoCkHR05gwEknwFpnaSKVjQIuea44JW9JD6hJrPfWY62hG5YoHSnoIFMj1J5aK5",
+	"WQrbmCr9GWQMI64ax+Lc5ENAixYM2dLy5Kq6CkyfQy56akN650+mhsWNCUMZwiruYoH05znAQlnhUJ4C",
+	"yEFBdayT6gALGTJUMCvnc8RQ3gcX5Uw1JzF9VOCYuMXotXG5CF0Ov9oQsxbdZSHn/poNbfXBMwSZmCEo",
+	"ZLn85VIBpyCQb94scIHqzVRxXiDAqQ43WDH6Xu7SNUI6MMmruE9XiGzL0dAYFzM17iZTo1J0CsiFOXiD",
+	"eHkqt4EhXi4RgA4DlBj2MdlfwFEH9DUUT4LpGZiI+ydJuiOk7lapJr9T5sjujAiB3gvNTnt6+1oBYcp+",
+	"UzFcLz7n4qkl+hojNxp6gvMRGI6JGjICNe45JjkUcAQ+jF143DgZgfFeiTbjJPUs5u7Vferi61dLVuhX",
+	"tpSw1yO1OqYHO+6jH1m+LR9+GOvYSfn3sXzoIijlLwP5ixc+KH8bpvUvjsCR/EULC/dSFVkpf3p4/FH+",
+	"6FRADVb7avHg53HyUbJ9eQBHf6oDGHYcQKMaf3vz7cbI35Vyr3/W/W8m/tNPO5fBrnMZts9lOBjsdTDD",
+	"I/9gjt3BVNN9+qH8bgj7R2xMUr8Qt+ozN+8tkgEZnk/nDVXudtGvGSXzAiuZMYErPFlXdagrvve4GgQ8",
+	"Kc63BDlIKSvZOgfroad7eBLT3tDNqCPfiNwBVM2Y3A3TJwYhOiUmHO1RPfZW3zReJDdo1jMxsuzeT7Oj",
+	"5Q+P5s+vz3qyb8qJXGu1X/bDWrTJ/1RzgCpNdmTu+DNMVK8xjSXV0JEN+wBv354/AYVqgDMCx4FIEn8J",
+	"ns/Fm7bRuOJuoiBLwq3q1o1rb6tB/rmGUc0bANZHFkz3gbT6s6Yr+kb9Dphq1v1OkGKIZLSBfR4hkj6O",
+	"q6MIclfV8Qs3GWEMo4xhlDGMMoZRRpEXRV4Mo4xhlJHUI6nHMMoYRhnDKGMYZQyjjBIohlHGMMoYRhnD",
+	"KCOLi2GUMYwyhlHeKoyyK4LRi6N8Y4dUgZQLBAux6AyblNWyGFogwvEaAT3YGNOVOqvZEMoB33CBlgAT",
+	"TTqYSlmRFaXyxJYrOWl/TC4X2Iu7NHqwPk8OlwjkaIVIjkhmi8gByOXCckwQ52BWCvNVJMs0VzzRzL5E",
+	"guFMChdGha5kr6CcQY6zhukqFK73TK3vsVxe8smFY/VmbSaG9Yfr3mNuNnVTc5ZLCLSwgtmiLpM/JCtK",
+	"i4ncHj0Nlv8dHp0O0gTnBZpUYYk8GT3QNnYJ0cmRYpPNEUea9dFSfua4qi/oDZFUK9m1rTp4cmr+nZd6",
+	"8yZq1OlA/c+VHbxGGwXZyYOPaVJALiZqXSjvLudqt9yUtTzqP/QqldqN+pjKesFlc1tgJvAaTW4ou1aM",
+	"9DhNJDJJO/4vdKYguS0cp/2TMBxcUGak5q0+PDztH4W+7NWvS159n+yh8qSJJrJkdHx/MOifpokLHkiG",
+	"svyZCTDYFytLsh9eWg3wDcox90NiJZYC9H4BS1M2cL8NcssuSei87XQvtAICZoxeIwZKwhDMFqYPw6fM",
+	"5J2onetxtShDKZ80h3+2T179+PKw0x0+HAz6R6HT3abyunOreObr2oj6eJ+qgi/ooNNOFbVi4z0Tp5z5",
+	"kiEJlITcqrQadRNgLWirzzcx1UnYwKGZIGDJpZZBvbl+pB01fDH3p5dmLPkasK/tW8u3wQfamo5+rGCX",
+	"qvASFwX27l12nSdH/VP3eVIuZ746HNCEtQCvl++t1uOFYld76u9vSa4JvSG7K1waAELFPxuqlQMFkxyv",
+	"cV76+IMRbxd/tawHFsWrudKUIvZG7P1jsPeWuFZ/qa7A1Z9pda658pdqb9SSpagAc4Zq+SfyJLWR2Vyb",
+	"5RT+Tmv9cEcJ5Jb22A2GHOsBwLvmfbBrUqud3mbFL19dbl/1ydGu6QMKcTckanBt1bawtTINhSDYCUCl",
+	"e+/aAahNJuYFvzmXm+043ase+J7LVYP3OeThTtTybw+719k4ZvlyfZ0np3tNWLuetHJw1OoUh+Ira8OQ",
+	"r6koBx8GTACBhAb4l73ypIeU1FYU7hDfw4DaNgWWEDq+ANWGkDokh/1LWnhziDsZOUrug5a9Nb5y8iA9",
+	"sK58+5d3voofhXkU5n+QKupd9iLWRaz7Q7DuYxAPw9C+WiMm07wXNah74NX3gJJiI9FBPvavS8ptWcFr",
+	"V6OMSMba8OLs/OXl05dnLx8/DbZeqXlCGr6xi1fg4f3BELgx2vGlzN/aKgyVd1THGe+NDda6Ecraxxky",
+	"BmSJBwEUsAavFhJ46TMNSq1Mt16uivugMansecT+hqXW1LJPiwa7uNrpxkyBmCkQMwVipkCMbIiRDZ9d",
+	"psDBoV2C0omMn5hAIdBy1Sw34cIrdM55kwO4l/aObXoU7ITThiIY27QTiBoCPbp1gFfkAJED/Eljmx7r",
+	"EBjT1Ku6c+4kjduFP8lNlHPJiZCm2S1BT3IJpwcnK0WXeXSZR5d5tBhFl3nE3oi90WUeXebRZR5d5tFl",
+	"Hl3mUZhHl3nEuoh10WUeXeZ/sMu8RsKtxLmvIceZyZtrJMo98zLYvMS4C5U/VmXFFXiNCOJb2glguW5g",
+	"x5mTFBTkSCC2xMQxHl6BzEpCMLnqj8lbLovZbwBl2QJxwaCgjIMvCnyNwPflDDGCBOJfBj+YUSIgJogB",
+	"vlAN5lVzeS4gEygPZbU9N0DeUV6bWdAkl0TdZQtVDz0zqCXXGiXtZcVzGJmsqzwmCwO97oTg1ffB+V99",
+	"f+tpt1gLu7iRhcfhiSOAvwiX6eYHtTKvfi+A2zMC+70DOQGUu5vcyrj/78fliFR/TqTKEcybkqUmSSxX",
+	"lVEkM7RFlrj05T2TrN34PYUKgvlGDoJZhlYCCAZltbr+mCh+r8oCgIxhgbOGmdhL0DZafapvq7rwkrpd",
+	"msxq3imzWtDp6X3ZREtTQkVpwphwAWU3loCkemOXfkeiShZRUvuz03dHqNA7eZDvjqumQnfoSut02unD",
+	"yO7WrRZ03T2BAs4gr02m3bf/BhdeKId5vwPd5zAPXE3onG7/iYNTx+8mS/x3dYLe9YV8Ky7+W+/i/xHe",
+	"wni4f77D7bD5xsP5UxtH4/H8Na2IlS7uDIla3/68bIl/Hatfx23ndrf/eD347K4HUZmNymxUZqMyGw8n",
+	"KrNRmY3K7J9amXVaJfiitu0lgWuIC6kwfrnVB+Hs5VucEFurymrvuFJTQw3Jn1PtM1ijgq6WiAij0koU",
+	"ZkUyUs16Va9e3Pf7efZztL73wezxx3tKaWZYrkehZ+2Eao3A222y2z3RG/3CP6qW1mbdLXaAZqpzhNeV",
+	"3zocuNel3DxM2p26XcVcUK4k1nGwxhBcqF3oXcgdca3dzcfcG4Gv6VOpHHfSzcLqZ+h9SY8OfEb3R4L5",
+	"EhOsHDGq8eBqd81bb8nyZRlF+H8GAFLkhMwkbQMA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+func toAnalysisResponse(a *domain.Analysis) AnalysisResponse {
+	resp := AnalysisResponse{
+		AnalysisId: &a.ID,
+		Status:     ptr(AnalysisResponseStatus(a.Status)),
+		Url:        &a.URL,
+		CreatedAt:  &a.CreatedAt,
+	}
+
+	// Analyses answered from the cache are already completed.
+	if !a.Status.IsTerminal() {
+		resp.EstimatedCompletionTime = ptr(a.Options.Timeout.String())
+	}
+
+	return resp
+}
+
+func toAnalysisResult(a *domain.Analysis) (AnalysisResult, error) {
+	result := AnalysisResult{
+		AnalysisId:  &a.ID,
+		Url:         &a.URL,
+		Status:      ptr(AnalysisResultStatusCompleted),
+		CreatedAt:   &a.CreatedAt,
+		CompletedAt: a.CompletedAt,
+		Duration:    ptr(a.Duration().Round(time.Millisecond).String()),
+	}
+
+	if a.Results != nil {
+		if err := convert(a.Results, &result.Results); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+func toAnalysisInProgress(a *domain.Analysis) AnalysisInProgress {
+	resp := AnalysisInProgress{
+		AnalysisId: &a.ID,
+		Status:     ptr(AnalysisInProgressStatusInProgress),
+		Progress:   &a.Progress,
+	}
+
+	if a.CurrentStep != "" {
+		resp.CurrentStep = &a.CurrentStep
+	}
+
+	if remaining := time.Until(a.CreatedAt.Add(a.Options.Timeout)); remaining > 0 {
+		resp.EstimatedCompletionTime = ptr(remaining.Round(time.Second).String())
+	}
+
+	return resp
+}
+
+func toAnalysisError(a *domain.Analysis) AnalysisError {
+	resp := AnalysisError{
+		AnalysisId: &a.ID,
+		Status:     ptr(AnalysisErrorStatusFailed),
+	}
+
+	if a.Error != nil {
+		resp.Error = &a.Error.Code
+		resp.ErrorMessage = &a.Error.Message
+		resp.HttpStatusCode = &a.Error.StatusCode
+
+		if a.Error.Details != "" {
+			resp.Details = &a.Error.Details
+		}
+	}
+
+	return resp
+}
+
+func toCrawlPageList(id uuid.UUID, pages []domain.CrawledPage, total, page, limit int) (CrawlPageList, error) {
+	list := CrawlPageList{
+		AnalysisId: id,
+		Pagination: toPagination(total, page, limit),
+	}
+
+	// Pages past the last one are listed as empty, not null.
+	if pages == nil {
+		pages = []domain.CrawledPage{}
+	}
+
+	if err := convert(pages, &list.Pages); err != nil {
+		return list, err
+	}
+
+	return list, nil
+}
+
+func toBatchResponse(b *domain.Batch, analyses []*domain.Analysis) (BatchResponse, error) {
+	resp := BatchResponse{
+		BatchId:   b.ID,
+		Status:    BatchResponseStatus(b.Status()),
+		CreatedAt: b.CreatedAt,
+	}
+
+	if b.SitemapURL != "" {
+		resp.SitemapUrl = &b.SitemapURL
+	}
+
+	if err := convert(b.Progress, &resp.Progress); err != nil {
+		return resp, err
+	}
+
+	if err := convert(toAnalysisResponses(analyses), &resp.Items); err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func toBatchStatus(b *domain.Batch, analyses []*domain.Analysis, total, page, limit int) (BatchStatus, error) {
+	resp := BatchStatus{
+		BatchId:    b.ID,
+		Status:     BatchStatusStatus(b.Status()),
+		CreatedAt:  b.CreatedAt,
+		Pagination: toPagination(total, page, limit),
+	}
+
+	if b.SitemapURL != "" {
+		resp.SitemapUrl = &b.SitemapURL
+	}
+
+	if err := convert(b.Progress, &resp.Progress); err != nil {
+		return resp, err
+	}
+
+	if err := convert(toAnalysisResponses(analyses), &resp.Items); err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// toAnalysisResponses maps analyses, listing none as empty rather than null.
+func toAnalysisResponses(analyses []*domain.Analysis) []AnalysisResponse {
+	resp := make([]AnalysisResponse, 0, len(analyses))
+	for _, a := range analyses {
+		resp = append(resp, toAnalysisResponse(a))
+	}
+
+	return resp
+}
+
+func toPagination(total, page, limit int) Pagination {
+	totalPages := (total + limit - 1) / limit
+
+	return Pagination{
+		Page:        &page,
+		Limit:       &limit,
+		TotalCount:  &total,
+		TotalPages:  &totalPages,
+		HasNext:     ptr(page < totalPages),
+		HasPrevious: ptr(page > 1),
+	}
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/architeacher/svc-web-analyzer/internal/auth"
+	"github.com/architeacher/svc-web-analyzer/internal/ratelimit"
+)
+
+const errCodeRateLimitExceeded = "rate_limit_exceeded"
+
+// batchPathSuffix ends the path of the batch submissions, whatever the base URL of the router.
+const batchPathSuffix = "/analyze/batch"
+
+// Rate limit headers of the throttled operations.
+const (
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+)
+
+// RateLimiter takes tokens from the buckets of the clients.
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error)
+}
+
+// RateLimits are the budgets of the clients of the operations secured with PasetoAuth.
+type RateLimits struct {
+	// Analyze is the budget of the analysis submissions, which are expensive, Batch that of the
+	// batch submissions, each queueing up to domain.MaxBatchItems analyses, and Read that of the
+	// other operations.
+	Analyze ratelimit.Limit
+	Batch   ratelimit.Limit
+	Read    ratelimit.Limit
+}
+
+// NewRateLimitMiddleware throttles the operations secured with PasetoAuth, per token subject
+// or, for anonymous requests, per client IP. Every response reports the bucket of the client in
+// the X-RateLimit-Limit, X-RateLimit-Remaining and X-RateLimit-Reset headers, the latter in
+// seconds; exhausted clients are answered with 429 Too Many Requests and Retry-After.
+//
+// It must run after the authentication middleware. When the limiter fails, requests are let
+// through rather than rejected.
+func NewRateLimitMiddleware(limiter RateLimiter, limits RateLimits, logger *slog.Logger) MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Context().Value(PasetoAuthScopes) == nil {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			budget, limit := "read", limits.Read
+
+			switch {
+			case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, batchPathSuffix):
+				budget, limit = "batch", limits.Batch
+			case r.Method == http.MethodPost:
+				budget, limit = "analyze", limits.Analyze
+			}
+
+			res, err := limiter.Allow(r.Context(), budget+":"+principal(r), limit)
+			if err != nil {
+				logger.WarnContext(r.Context(), "rate limiter unavailable, request not throttled",
+					slog.String("error", err.Error()))
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			w.Header().Set(headerRateLimitLimit, strconv.Itoa(res.Limit))
+			w.Header().Set(headerRateLimitRemaining, strconv.Itoa(res.Remaining))
+			w.Header().Set(headerRateLimitReset, strconv.Itoa(int(math.Ceil(res.Reset.Seconds()))))
+
+			if !res.Allowed {
+				renderError(w, r, &APIError{Status: http.StatusTooManyRequests, Code: errCodeRateLimitExceeded,
+					Message: "Too many requests. Please try again later", RetryAfter: res.RetryAfter})
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// principal identifies the client of r, by its token subject or its IP address. The address is
+// that of the peer, unless relayed by a trusted proxy, so that forged forwarding headers do not
+// buy clients fresh buckets.
+func principal(r *http.Request) string {
+	if claims, ok := auth.ClaimsFromContext(r.Context()); ok && claims.Subject != "" {
+		return "sub:" + claims.Subject
+	}
+
+	return "ip:" + clientIP(r)
+}
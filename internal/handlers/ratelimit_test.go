@@ -0,0 +1,190 @@
+package handlers_test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/auth"
+	"github.com/architeacher/svc-web-analyzer/internal/handlers"
+	"github.com/architeacher/svc-web-analyzer/internal/middleware"
+	"github.com/architeacher/svc-web-analyzer/internal/ratelimit"
+)
+
+// recordingLimiter records the buckets requests are charged to.
+type recordingLimiter struct {
+	*ratelimit.MemoryLimiter
+
+	mu   sync.Mutex
+	keys []string
+}
+
+func (l *recordingLimiter) Allow(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+	l.mu.Lock()
+	l.keys = append(l.keys, key)
+	l.mu.Unlock()
+
+	return l.MemoryLimiter.Allow(ctx, key, limit)
+}
+
+func newRateLimitedHandler(t *testing.T, limiter handlers.RateLimiter, trustedProxies ...string) http.Handler {
+	t.Helper()
+
+	realIP, err := middleware.RealIP(trustedProxies...)
+	if err != nil {
+		t.Fatalf("RealIP() error = %v", err)
+	}
+
+	limits := handlers.RateLimits{
+		Analyze: ratelimit.Limit{Requests: 2, Period: time.Hour},
+		Batch:   ratelimit.Limit{Requests: 1, Period: time.Hour},
+		Read:    ratelimit.Limit{Requests: 5, Period: time.Hour},
+	}
+	rateLimit := handlers.NewRateLimitMiddleware(limiter, limits, slog.New(slog.DiscardHandler))
+
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	return realIP(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), handlers.PasetoAuthScopes, []string{})
+		rateLimit(ok).ServeHTTP(w, r.WithContext(ctx))
+	}))
+}
+
+func TestRateLimitPrincipal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		trusted      []string
+		method       string
+		remoteAddr   string
+		forwardedFor string
+		subject      string
+		want         string
+	}{
+		{
+			name:       "anonymous client",
+			method:     http.MethodGet,
+			remoteAddr: "203.0.113.7:1000",
+			want:       "read:ip:203.0.113.7",
+		},
+		{
+			name:         "forged forwarding header",
+			method:       http.MethodPost,
+			remoteAddr:   "203.0.113.7:1000",
+			forwardedFor: "192.0.2.1",
+			want:         "analyze:ip:203.0.113.7",
+		},
+		{
+			name:         "client behind a trusted proxy",
+			trusted:      []string{"10.0.0.0/8"},
+			method:       http.MethodPost,
+			remoteAddr:   "10.0.0.2:1000",
+			forwardedFor: "192.0.2.66, 198.51.100.1",
+			want:         "analyze:ip:198.51.100.1",
+		},
+		{
+			name:         "token subject",
+			trusted:      []string{"10.0.0.0/8"},
+			method:       http.MethodGet,
+			remoteAddr:   "10.0.0.2:1000",
+			forwardedFor: "198.51.100.1",
+			subject:      "ci",
+			want:         "read:sub:ci",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter := &recordingLimiter{MemoryLimiter: ratelimit.NewMemory()}
+
+			r := httptest.NewRequest(tt.method, "/v1/analyze", nil)
+			r.RemoteAddr = tt.remoteAddr
+
+			if tt.forwardedFor != "" {
+				r.Header.Set("X-Forwarded-For", tt.forwardedFor)
+			}
+
+			if tt.subject != "" {
+				r = r.WithContext(auth.WithClaims(r.Context(), &auth.Claims{Subject: tt.subject}))
+			}
+
+			newRateLimitedHandler(t, limiter, tt.trusted...).ServeHTTP(httptest.NewRecorder(), r)
+
+			if !slices.Equal(limiter.keys, []string{tt.want}) {
+				t.Errorf("charged buckets = %q, want %q", limiter.keys, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateLimitIgnoresForgedHeaders(t *testing.T) {
+	t.Parallel()
+
+	handler := newRateLimitedHandler(t, ratelimit.NewMemory())
+
+	for i, forwardedFor := range []string{"192.0.2.1", "192.0.2.2", "192.0.2.3"} {
+		r := httptest.NewRequest(http.MethodPost, "/v1/analyze", nil)
+		r.RemoteAddr = "203.0.113.7:1000"
+		r.Header.Set("X-Forwarded-For", forwardedFor)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		want := http.StatusAccepted
+		if i >= 2 {
+			want = http.StatusTooManyRequests
+		}
+
+		if w.Code != want {
+			t.Fatalf("request %d: status = %d, want %d", i+1, w.Code, want)
+		}
+	}
+}
+
+func TestRateLimitBudgets(t *testing.T) {
+	t.Parallel()
+
+	limiter := &recordingLimiter{MemoryLimiter: ratelimit.NewMemory()}
+	handler := newRateLimitedHandler(t, limiter)
+
+	requests := []struct {
+		method     string
+		path       string
+		wantStatus int
+	}{
+		{method: http.MethodPost, path: "/v1/analyze/batch", wantStatus: http.StatusAccepted},
+		// A batch queues many analyses: the next one waits for the batch budget to refill.
+		{method: http.MethodPost, path: "/v1/analyze/batch", wantStatus: http.StatusTooManyRequests},
+		{method: http.MethodPost, path: "/v1/analyze", wantStatus: http.StatusAccepted},
+		{method: http.MethodGet, path: "/v1/batches/7b2a4e8e-6f0c-4d0e-9d0b-5d8f6b1c2a3e", wantStatus: http.StatusAccepted},
+		{method: http.MethodPost, path: "/api/v1/analyze/batch", wantStatus: http.StatusTooManyRequests},
+	}
+
+	for i, req := range requests {
+		r := httptest.NewRequest(req.method, req.path, nil)
+		r.RemoteAddr = "203.0.113.7:1000"
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		if w.Code != req.wantStatus {
+			t.Fatalf("request %d, %s %s: status = %d, want %d", i+1, req.method, req.path, w.Code, req.wantStatus)
+		}
+	}
+
+	want := []string{"batch:ip:203.0.113.7", "batch:ip:203.0.113.7", "analyze:ip:203.0.113.7", "read:ip:203.0.113.7",
+		"batch:ip:203.0.113.7"}
+	if !slices.Equal(limiter.keys, want) {
+		t.Errorf("charged buckets = %q, want %q", limiter.keys, want)
+	}
+}
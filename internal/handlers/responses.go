@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	apiVersionHeader = "API-Version"
+	contentTypeJSON  = "application/json"
+)
+
+// Error codes returned in ErrorResponse.Error.
+const (
+	errCodeInvalidRequest       = "invalid_request"
+	errCodeInvalidURL           = "invalid_url"
+	errCodeMissingField         = "missing_required_field"
+	errCodeInvalidOptions       = "invalid_options"
+	errCodeAnalysisNotFound     = "analysis_not_found"
+	errCodeCrawlNotFound        = "crawl_not_found"
+	errCodeBatchNotFound        = "batch_not_found"
+	errCodeInvalidSitemap       = "invalid_sitemap"
+	errCodeBlockedTarget        = "blocked_target"
+	errCodeInternalServer       = "internal_server_error"
+	errCodeStreamingUnsupported = "streaming_unsupported"
+	errCodeServiceUnavailable   = "service_unavailable"
+)
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", contentTypeJSON)
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// convert maps src onto dst, a generated model sharing the same JSON representation.
+// The generated models inline nested schemas as anonymous structs, which makes
+// building them field by field impractical.
+func convert(src, dst any) error {
+	raw, err := json.Marshal(src)
+	if err != nil {
+		return fmt.Errorf("marshaling %T: %w", src, err)
+	}
+
+	if err := json.Unmarshal(raw, dst); err != nil {
+		return fmt.Errorf("unmarshaling into %T: %w", dst, err)
+	}
+
+	return nil
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// RouterOptions configures NewRouter.
+type RouterOptions struct {
+	// BaseURL is prefixed to every route of the specification.
+	BaseURL string
+	// RouterMiddlewares are mounted on the base router, so they also see unmatched routes.
+	RouterMiddlewares []func(http.Handler) http.Handler
+	// Middlewares are applied to every operation, the last one being the outermost.
+	Middlewares []MiddlewareFunc
+	// APIVersions are the versions negotiated by NewVersionMiddleware, the first being the
+	// default. Only APIVersionV1 is served when empty.
+	APIVersions []string
+}
+
+// NewRouter mounts the server implementation on a chi router using the generated routes. The
+// API version of every request is negotiated after the router middlewares ran. Unmatched routes
+// and methods, like every failure, are answered with an ErrorResponse.
+func NewRouter(si ServerInterface, opts RouterOptions) http.Handler {
+	r := chi.NewRouter()
+	r.Use(opts.RouterMiddlewares...)
+	r.Use(NewVersionMiddleware(opts.APIVersions...))
+	r.NotFound(notFound)
+	r.MethodNotAllowed(methodNotAllowed)
+
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:          opts.BaseURL,
+		BaseRouter:       r,
+		Middlewares:      opts.Middlewares,
+		ErrorHandlerFunc: errorHandler,
+	})
+}
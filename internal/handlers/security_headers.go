@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"maps"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Security headers set on the responses.
+const (
+	HeaderContentTypeOptions      = "X-Content-Type-Options"
+	HeaderFrameOptions            = "X-Frame-Options"
+	HeaderXSSProtection           = "X-XSS-Protection"
+	HeaderStrictTransportSecurity = "Strict-Transport-Security"
+	HeaderContentSecurityPolicy   = "Content-Security-Policy"
+	HeaderReferrerPolicy          = "Referrer-Policy"
+	HeaderPermissionsPolicy       = "Permissions-Policy"
+)
+
+// Routes of the event streams of the analyses and of the batches.
+const (
+	EventsRoutePattern      = "/v1/analysis/{analysisId}/events"
+	BatchEventsRoutePattern = "/v1/batches/{batchId}/events"
+)
+
+// DefaultSecurityHeaders returns the security headers documented in the specification.
+func DefaultSecurityHeaders() map[string]string {
+	return map[string]string{
+		HeaderContentTypeOptions:      "nosniff",
+		HeaderFrameOptions:            "DENY",
+		HeaderXSSProtection:           "1; mode=block",
+		HeaderStrictTransportSecurity: "max-age=31536000; includeSubDomains",
+		HeaderContentSecurityPolicy:   "default-src 'self'",
+		HeaderReferrerPolicy:          "strict-origin-when-cross-origin",
+		HeaderPermissionsPolicy:       "camera=(), microphone=(), geolocation=()",
+	}
+}
+
+// securityHeaders holds the headers of every route, and those overridden per route.
+type securityHeaders struct {
+	headers map[string]string
+	routes  map[string]map[string]string
+}
+
+// SecurityHeadersOption configures the security headers middleware.
+type SecurityHeadersOption func(*securityHeaders)
+
+// WithSecurityHeader sets the value of a header, one of the Header constants, on every route.
+// An empty value omits it.
+func WithSecurityHeader(name, value string) SecurityHeadersOption {
+	return func(s *securityHeaders) {
+		s.headers[name] = value
+	}
+}
+
+// WithRouteSecurityHeader sets the value of a header on the route matching pattern, a path of
+// the specification such as EventsRoutePattern. An empty value omits it.
+func WithRouteSecurityHeader(pattern, name, value string) SecurityHeadersOption {
+	return func(s *securityHeaders) {
+		if s.routes[pattern] == nil {
+			s.routes[pattern] = make(map[string]string)
+		}
+
+		s.routes[pattern][name] = value
+	}
+}
+
+// NewSecurityHeadersMiddleware sets the security headers on every response,
+// DefaultSecurityHeaders unless configured otherwise. The event streams are not documents and
+// load nothing, so their Content-Security-Policy denies everything by default.
+//
+// It is meant to be mounted among the router middlewares, so that unmatched routes, rejected
+// versions and preflight requests are covered too. Since the route is only known once the
+// router matched it, the headers of the overridden routes are set when the response is written.
+func NewSecurityHeadersMiddleware(opts ...SecurityHeadersOption) func(http.Handler) http.Handler {
+	s := &securityHeaders{
+		headers: DefaultSecurityHeaders(),
+		routes: map[string]map[string]string{
+			EventsRoutePattern:      {HeaderContentSecurityPolicy: "default-src 'none'; frame-ancestors 'none'"},
+			BatchEventsRoutePattern: {HeaderContentSecurityPolicy: "default-src 'none'; frame-ancestors 'none'"},
+		},
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	// Resolve the headers of each overridden route once.
+	routes := make(map[string]map[string]string, len(s.routes))
+	for pattern, overrides := range s.routes {
+		headers := maps.Clone(s.headers)
+		maps.Copy(headers, overrides)
+		routes[pattern] = headers
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			setHeaders(w.Header(), s.headers)
+
+			next.ServeHTTP(&securityHeadersWriter{ResponseWriter: w, r: r, routes: routes}, r)
+		})
+	}
+}
+
+func setHeaders(h http.Header, headers map[string]string) {
+	for name, value := range headers {
+		if value == "" {
+			h.Del(name)
+		} else {
+			h.Set(name, value)
+		}
+	}
+}
+
+// securityHeadersWriter sets the headers of the matched route before the response is written.
+type securityHeadersWriter struct {
+	http.ResponseWriter
+	r       *http.Request
+	routes  map[string]map[string]string
+	applied bool
+}
+
+func (w *securityHeadersWriter) apply() {
+	if w.applied {
+		return
+	}
+
+	w.applied = true
+
+	if headers, ok := w.routes[routePattern(w.r, w.routes)]; ok {
+		setHeaders(w.Header(), headers)
+	}
+}
+
+func (w *securityHeadersWriter) WriteHeader(status int) {
+	w.apply()
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *securityHeadersWriter) Write(b []byte) (int, error) {
+	w.apply()
+
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *securityHeadersWriter) Flush() {
+	w.apply()
+
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *securityHeadersWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// routePattern returns the key of routes matching the route of r. The route pattern carries the
+// base URL of the router, if any, so patterns are matched as suffixes.
+func routePattern(r *http.Request, routes map[string]map[string]string) string {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil {
+		return ""
+	}
+
+	route := rctx.RoutePattern()
+	if _, ok := routes[route]; ok {
+		return route
+	}
+
+	for pattern := range routes {
+		if strings.HasSuffix(route, pattern) {
+			return pattern
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,229 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/architeacher/svc-web-analyzer/internal/handlers"
+	"github.com/architeacher/svc-web-analyzer/internal/middleware"
+)
+
+const eventsCSP = "default-src 'none'; frame-ancestors 'none'"
+
+var pathParamPattern = regexp.MustCompile(`\{[^}]+\}`)
+
+// streamingServer answers the event streams of the analyses, checking that they can be flushed.
+type streamingServer struct {
+	handlers.Unimplemented
+}
+
+func (streamingServer) GetAnalysisEvents(w http.ResponseWriter, _ *http.Request, _ openapi_types.UUID, _ handlers.GetAnalysisEventsParams) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+}
+
+func newSecuredRouter(opts ...handlers.SecurityHeadersOption) http.Handler {
+	return handlers.NewRouter(streamingServer{}, handlers.RouterOptions{
+		RouterMiddlewares: []func(http.Handler) http.Handler{
+			handlers.NewSecurityHeadersMiddleware(opts...),
+			handlers.Recoverer,
+			middleware.CORS(middleware.CORSOptions{
+				AllowedOrigins: []string{"https://app.example.com"},
+				AllowedMethods: []string{http.MethodGet, http.MethodPost},
+				AllowedHeaders: []string{"Content-Type", "Authorization"},
+			}),
+		},
+	})
+}
+
+func assertSecurityHeaders(t *testing.T, h http.Header, csp string) {
+	t.Helper()
+
+	for name, value := range handlers.DefaultSecurityHeaders() {
+		if name == handlers.HeaderContentSecurityPolicy {
+			value = csp
+		}
+
+		if got := h.Get(name); got != value {
+			t.Errorf("%s = %q, want %q", name, got, value)
+		}
+	}
+}
+
+func TestSecurityHeadersOnEveryOperation(t *testing.T) {
+	t.Parallel()
+
+	swagger, err := handlers.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger() error = %v", err)
+	}
+
+	router := newSecuredRouter()
+
+	for path, item := range swagger.Paths.Map() {
+		for method := range item.Operations() {
+			t.Run(method+" "+path, func(t *testing.T) {
+				t.Parallel()
+
+				target := pathParamPattern.ReplaceAllString(path, "7b2a4e8e-6f0c-4d0e-9d0b-5d8f6b1c2a3e")
+
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, httptest.NewRequest(method, target, nil))
+
+				csp := handlers.DefaultSecurityHeaders()[handlers.HeaderContentSecurityPolicy]
+				if path == handlers.EventsRoutePattern || path == handlers.BatchEventsRoutePattern {
+					csp = eventsCSP
+				}
+
+				assertSecurityHeaders(t, w.Header(), csp)
+			})
+		}
+	}
+}
+
+func TestSecurityHeadersOutsideOperations(t *testing.T) {
+	t.Parallel()
+
+	defaultCSP := handlers.DefaultSecurityHeaders()[handlers.HeaderContentSecurityPolicy]
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		headers    map[string]string
+		wantStatus int
+		wantCSP    string
+	}{
+		{
+			name:       "unmatched route",
+			method:     http.MethodGet,
+			path:       "/v1/unknown",
+			wantStatus: http.StatusNotFound,
+			wantCSP:    defaultCSP,
+		},
+		{
+			name:       "unserved method",
+			method:     http.MethodDelete,
+			path:       "/v1/analyze",
+			wantStatus: http.StatusMethodNotAllowed,
+			wantCSP:    defaultCSP,
+		},
+		{
+			name:       "unsupported version",
+			method:     http.MethodGet,
+			path:       "/v1/health",
+			headers:    map[string]string{"API-Version": "v9"},
+			wantStatus: http.StatusBadRequest,
+			wantCSP:    defaultCSP,
+		},
+		{
+			name:   "cors preflight",
+			method: http.MethodOptions,
+			path:   "/v1/analyze",
+			headers: map[string]string{
+				"Origin":                        "https://app.example.com",
+				"Access-Control-Request-Method": http.MethodPost,
+			},
+			wantStatus: http.StatusNoContent,
+			wantCSP:    defaultCSP,
+		},
+		{
+			name:       "event stream",
+			method:     http.MethodGet,
+			path:       "/v1/analysis/7b2a4e8e-6f0c-4d0e-9d0b-5d8f6b1c2a3e/events",
+			wantStatus: http.StatusOK,
+			wantCSP:    eventsCSP,
+		},
+	}
+
+	router := newSecuredRouter()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(tt.method, tt.path, nil)
+			for name, value := range tt.headers {
+				r.Header.Set(name, value)
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+
+			assertSecurityHeaders(t, w.Header(), tt.wantCSP)
+		})
+	}
+}
+
+func TestSecurityHeadersOptions(t *testing.T) {
+	t.Parallel()
+
+	router := newSecuredRouter(
+		handlers.WithSecurityHeader(handlers.HeaderXSSProtection, ""),
+		handlers.WithSecurityHeader(handlers.HeaderFrameOptions, "SAMEORIGIN"),
+		handlers.WithRouteSecurityHeader("/v1/health", handlers.HeaderContentSecurityPolicy, "default-src 'none'"),
+		handlers.WithRouteSecurityHeader(handlers.EventsRoutePattern, handlers.HeaderContentSecurityPolicy, ""),
+	)
+
+	tests := []struct {
+		path    string
+		want    map[string]string
+		omitted []string
+	}{
+		{
+			path:    "/v1/health",
+			want:    map[string]string{handlers.HeaderFrameOptions: "SAMEORIGIN", handlers.HeaderContentSecurityPolicy: "default-src 'none'"},
+			omitted: []string{handlers.HeaderXSSProtection},
+		},
+		{
+			path:    "/v1/analysis/7b2a4e8e-6f0c-4d0e-9d0b-5d8f6b1c2a3e/events",
+			want:    map[string]string{handlers.HeaderFrameOptions: "SAMEORIGIN"},
+			omitted: []string{handlers.HeaderXSSProtection, handlers.HeaderContentSecurityPolicy},
+		},
+		{
+			path: "/v1/unknown",
+			want: map[string]string{
+				handlers.HeaderFrameOptions:          "SAMEORIGIN",
+				handlers.HeaderContentSecurityPolicy: handlers.DefaultSecurityHeaders()[handlers.HeaderContentSecurityPolicy],
+			},
+			omitted: []string{handlers.HeaderXSSProtection},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			for name, value := range tt.want {
+				if got := w.Header().Get(name); got != value {
+					t.Errorf("%s = %q, want %q", name, got, value)
+				}
+			}
+
+			for _, name := range tt.omitted {
+				if values := w.Header().Values(name); len(values) > 0 {
+					t.Errorf("%s = %q, want it omitted", name, values)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,325 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	errCodeInvalidResponse = "invalid_response"
+
+	contentTypeEventStream = "text/event-stream"
+)
+
+// bodyFieldErrors are the errors of the invalid fields of the request bodies, by top-level
+// field. The other fields are reported as invalid_request.
+var bodyFieldErrors = map[string]struct{ code, message string }{
+	"url":     {errCodeInvalidURL, "The provided URL is not valid"},
+	"options": {errCodeInvalidOptions, "Invalid analysis options provided"},
+}
+
+var defineFormatsOnce sync.Once
+
+// defineFormats registers the string formats of the specification kin-openapi does not
+// validate by itself.
+func defineFormats() {
+	defineFormatsOnce.Do(func() {
+		openapi3.DefineStringFormatValidator("uri", openapi3.NewCallbackValidator(func(value string) error {
+			u, err := url.Parse(value)
+			if err != nil {
+				return err
+			}
+
+			if !u.IsAbs() {
+				return errors.New("not an absolute URI")
+			}
+
+			return nil
+		}))
+	})
+}
+
+type validator struct {
+	spec      *openapi3.T
+	paths     []string
+	responses bool
+}
+
+// ValidationOption configures the validation middleware.
+type ValidationOption func(*validator)
+
+// WithResponseValidation validates the responses of the operations too. Responses that do not
+// match the specification are replaced with 500 Internal Server Error, so that spec drift fails
+// the integration tests; event streams are not validated. It buffers every response, and is
+// meant for development and tests.
+func WithResponseValidation() ValidationOption {
+	return func(v *validator) {
+		v.responses = true
+	}
+}
+
+// NewValidationMiddleware validates the parameters and bodies of the requests against spec,
+// the specification returned by GetSwagger, rejecting invalid requests with 400 Bad Request.
+// Security requirements are left to the authentication middlewares, and defaults are not set
+// on the request bodies.
+func NewValidationMiddleware(spec *openapi3.T, opts ...ValidationOption) MiddlewareFunc {
+	defineFormats()
+
+	v := &validator{spec: spec}
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	for path := range spec.Paths.Map() {
+		v.paths = append(v.paths, path)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodySize)
+
+			input, ok := v.requestInput(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+				renderError(w, r, err)
+
+				return
+			}
+
+			// The body was read, and replaced, by the validation.
+			r.Body = input.Request.Body
+
+			if !v.responses {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			rec := &responseRecorder{ResponseWriter: w}
+			next.ServeHTTP(rec, r)
+
+			if rec.streaming {
+				return
+			}
+
+			if !rec.wroteHeader {
+				rec.status = http.StatusOK
+			}
+
+			err := openapi3filter.ValidateResponse(r.Context(), &openapi3filter.ResponseValidationInput{
+				RequestValidationInput: input,
+				Status:                 rec.status,
+				Header:                 w.Header(),
+				Body:                   io.NopCloser(bytes.NewReader(rec.body.Bytes())),
+				Options:                &openapi3filter.Options{IncludeResponseStatus: true},
+			})
+			if err != nil {
+				renderError(w, r, &APIError{
+					Status:  http.StatusInternalServerError,
+					Code:    errCodeInvalidResponse,
+					Message: "The response does not match the specification",
+					Details: err.Error(),
+					Err:     err,
+				})
+
+				return
+			}
+
+			w.WriteHeader(rec.status)
+			_, _ = w.Write(rec.body.Bytes())
+		})
+	}
+}
+
+// requestInput returns the validation input of r, whose route is that of the specification
+// matching the chi route pattern. The route pattern carries the base URL of the router, if
+// any, so paths are matched as suffixes.
+func (v *validator) requestInput(r *http.Request) (*openapi3filter.RequestValidationInput, bool) {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil {
+		return nil, false
+	}
+
+	pattern := rctx.RoutePattern()
+
+	pathItem := v.spec.Paths.Value(pattern)
+	if pathItem == nil {
+		for _, path := range v.paths {
+			if strings.HasSuffix(pattern, path) {
+				pattern, pathItem = path, v.spec.Paths.Value(path)
+
+				break
+			}
+		}
+	}
+
+	if pathItem == nil {
+		return nil, false
+	}
+
+	operation := pathItem.GetOperation(r.Method)
+	if operation == nil {
+		return nil, false
+	}
+
+	params := make(map[string]string, len(rctx.URLParams.Keys))
+	for i, key := range rctx.URLParams.Keys {
+		params[key] = rctx.URLParams.Values[i]
+	}
+
+	// The vendor media types negotiating the API version are JSON documents.
+	req := r
+	if mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err == nil &&
+		mediaTypeVersionPattern.MatchString(mediaType) {
+		req = r.Clone(r.Context())
+		req.Header.Set("Content-Type", contentTypeJSON)
+	}
+
+	return &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: params,
+		Route: &routers.Route{
+			Spec:      v.spec,
+			Path:      pattern,
+			PathItem:  pathItem,
+			Method:    r.Method,
+			Operation: operation,
+		},
+		Options: &openapi3filter.Options{
+			AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+			SkipSettingDefaults: true,
+		},
+	}, true
+}
+
+// validationError returns the response of the request validation errors, nil for other
+// errors.
+func validationError(err error) *APIError {
+	var reqErr *openapi3filter.RequestError
+	if !errors.As(err, &reqErr) {
+		return nil
+	}
+
+	apiErr := &APIError{Status: http.StatusBadRequest, Err: err}
+
+	var schemaErr *openapi3.SchemaError
+
+	switch {
+	case reqErr.Parameter != nil:
+		apiErr.Code, apiErr.Message = errCodeInvalidParameter, "A parameter of the request is not valid"
+		if errors.Is(reqErr.Err, openapi3filter.ErrInvalidRequired) {
+			apiErr.Code, apiErr.Message = errCodeMissingParameter, "A required parameter is missing"
+		}
+
+		apiErr.Details = fmt.Sprintf("The %s parameter %q is not valid: %s",
+			reqErr.Parameter.In, reqErr.Parameter.Name, reasonOf(reqErr))
+	case reqErr.RequestBody != nil && errors.As(reqErr.Err, &schemaErr):
+		field := strings.Join(schemaErr.JSONPointer(), ".")
+
+		if schemaErr.SchemaField == "required" {
+			apiErr.Code, apiErr.Message = errCodeMissingField, "Required field is missing"
+			apiErr.Details = fmt.Sprintf("The '%s' field is required", field)
+
+			break
+		}
+
+		apiErr.Code, apiErr.Message = errCodeInvalidRequest, "The request body is not valid"
+		if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+			if e, ok := bodyFieldErrors[pointer[0]]; ok {
+				apiErr.Code, apiErr.Message = e.code, e.message
+			}
+		}
+
+		apiErr.Details = fmt.Sprintf("The '%s' field is not valid: %s", field, schemaErr.Reason)
+	default:
+		apiErr.Code, apiErr.Message = errCodeInvalidRequest, "The request could not be processed"
+		apiErr.Details = reasonOf(reqErr)
+	}
+
+	return apiErr
+}
+
+// reasonOf returns why the validation failed, without the request details prefixing the
+// errors of kin-openapi.
+func reasonOf(reqErr *openapi3filter.RequestError) string {
+	var schemaErr *openapi3.SchemaError
+	if errors.As(reqErr.Err, &schemaErr) {
+		return schemaErr.Reason
+	}
+
+	if reqErr.Err != nil {
+		if reqErr.Reason != "" {
+			return reqErr.Reason + ": " + reqErr.Err.Error()
+		}
+
+		return reqErr.Err.Error()
+	}
+
+	return reqErr.Reason
+}
+
+// responseRecorder buffers a response until it is validated. Event streams are written
+// through as they go.
+type responseRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	streaming   bool
+	body        bytes.Buffer
+}
+
+func (rec *responseRecorder) WriteHeader(status int) {
+	if rec.wroteHeader {
+		return
+	}
+
+	rec.wroteHeader, rec.status = true, status
+
+	if mediaType, _, _ := mime.ParseMediaType(rec.Header().Get("Content-Type")); mediaType == contentTypeEventStream {
+		rec.streaming = true
+		rec.ResponseWriter.WriteHeader(status)
+	}
+}
+
+func (rec *responseRecorder) Write(b []byte) (int, error) {
+	if !rec.wroteHeader {
+		rec.WriteHeader(http.StatusOK)
+	}
+
+	if rec.streaming {
+		return rec.ResponseWriter.Write(b)
+	}
+
+	return rec.body.Write(b)
+}
+
+func (rec *responseRecorder) Flush() {
+	if !rec.streaming {
+		return
+	}
+
+	if flusher, ok := rec.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (rec *responseRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
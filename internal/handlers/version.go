@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// APIVersionV1 is the version of the operations of the specification.
+	APIVersionV1 = "v1"
+
+	errCodeUnsupportedVersion = "unsupported_api_version"
+	errCodeConflictingVersion = "conflicting_api_version"
+)
+
+var (
+	pathVersionPattern      = regexp.MustCompile(`^v[0-9]+$`)
+	mediaTypeVersionPattern = regexp.MustCompile(`^application/vnd\.web-analyzer\.(v[0-9]+)\+json$`)
+)
+
+type apiVersionKey struct{}
+
+// APIVersionFromContext returns the API version negotiated for the request, APIVersionV1 when
+// the request did not go through the version middleware.
+func APIVersionFromContext(ctx context.Context) string {
+	if v, ok := ctx.Value(apiVersionKey{}).(string); ok {
+		return v
+	}
+
+	return APIVersionV1
+}
+
+// versionSource is a strategy by which a request names its API version.
+type versionSource struct {
+	name     string
+	versions []string
+}
+
+// NewVersionMiddleware negotiates the API version of each request among supported, the first
+// of which is the default. The version is taken, in order of precedence, from:
+//
+//   - the first path segment naming a version, as in /v1/analyze;
+//   - the API-Version header;
+//   - a vendor media type, application/vnd.web-analyzer.v1+json, as the Content-Type, or
+//     among the media types of the Accept header, the first supported one winning.
+//
+// Requests naming different versions through several strategies, or an unsupported version,
+// are rejected with 400 Bad Request. The negotiated version is echoed in the API-Version
+// response header and exposed by APIVersionFromContext, so that the handlers of another
+// version can be mounted side by side.
+func NewVersionMiddleware(supported ...string) func(http.Handler) http.Handler {
+	if len(supported) == 0 {
+		supported = []string{APIVersionV1}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			version, err := negotiateVersion(r, supported)
+
+			w.Header().Set(apiVersionHeader, version)
+
+			if err != nil {
+				writeError(w, r, http.StatusBadRequest, err.code, err.message, err.details)
+
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), apiVersionKey{}, version)))
+		})
+	}
+}
+
+// versionError is a negotiation failure, rendered as an ErrorResponse.
+type versionError struct {
+	code    string
+	message string
+	details string
+}
+
+// negotiateVersion returns the version of r. On failure, it returns the default version along
+// with the error, for the response header.
+func negotiateVersion(r *http.Request, supported []string) (string, *versionError) {
+	sources := []versionSource{
+		{name: "path", versions: pathVersions(r.URL.Path)},
+		{name: apiVersionHeader + " header", versions: headerVersions(r.Header.Get(apiVersionHeader))},
+		{name: "Content-Type", versions: mediaTypeVersions(r.Header.Get("Content-Type"))},
+	}
+
+	var (
+		version string
+		from    string
+	)
+
+	for _, source := range sources {
+		if len(source.versions) == 0 {
+			continue
+		}
+
+		v := source.versions[0]
+
+		switch {
+		case version == "":
+			version, from = v, source.name
+		case v != version:
+			return supported[0], &versionError{
+				code:    errCodeConflictingVersion,
+				message: "Conflicting API versions",
+				details: fmt.Sprintf("The %s names %s while the %s names %s", from, version, source.name, v),
+			}
+		}
+	}
+
+	// The Accept header lists the versions the client takes, any of which fits.
+	if accepted := mediaTypeVersions(r.Header.Get("Accept")); len(accepted) > 0 {
+		switch {
+		case version == "":
+			version = accepted[0]
+
+			for _, v := range accepted {
+				if slices.Contains(supported, v) {
+					version = v
+
+					break
+				}
+			}
+		case !slices.Contains(accepted, version):
+			return supported[0], &versionError{
+				code:    errCodeConflictingVersion,
+				message: "Conflicting API versions",
+				details: fmt.Sprintf("The %s names %s while the Accept header names %s",
+					from, version, strings.Join(accepted, ", ")),
+			}
+		}
+	}
+
+	if version == "" {
+		return supported[0], nil
+	}
+
+	if !slices.Contains(supported, version) {
+		return supported[0], &versionError{
+			code:    errCodeUnsupportedVersion,
+			message: "Unsupported API version",
+			details: fmt.Sprintf("API version %s is not supported, supported versions: %s",
+				version, strings.Join(supported, ", ")),
+		}
+	}
+
+	return version, nil
+}
+
+func pathVersions(path string) []string {
+	for _, segment := range strings.Split(path, "/") {
+		if pathVersionPattern.MatchString(segment) {
+			return []string{segment}
+		}
+	}
+
+	return nil
+}
+
+// headerVersions accepts "v1" as well as "1".
+func headerVersions(value string) []string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return nil
+	}
+
+	if !strings.HasPrefix(value, "v") {
+		value = "v" + value
+	}
+
+	return []string{value}
+}
+
+// mediaTypeVersions returns the versions of the vendor media types of a Content-Type or Accept
+// header, in order, skipping those with a zero quality.
+func mediaTypeVersions(value string) []string {
+	var versions []string
+
+	for _, item := range strings.Split(value, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(item))
+		if err != nil || refused(params["q"]) {
+			continue
+		}
+
+		if m := mediaTypeVersionPattern.FindStringSubmatch(mediaType); m != nil {
+			versions = append(versions, m[1])
+		}
+	}
+
+	return versions
+}
+
+// refused reports whether the quality q of a media type, such as "0" or "0.000", excludes it.
+func refused(q string) bool {
+	if q == "" {
+		return false
+	}
+
+	quality, err := strconv.ParseFloat(q, 64)
+
+	return err == nil && quality == 0
+}
@@ -0,0 +1,194 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/handlers"
+)
+
+func TestVersionMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		supported   []string
+		path        string
+		headers     map[string]string
+		wantStatus  int
+		wantVersion string
+		wantCode    string
+	}{
+		{
+			name:        "default version",
+			path:        "/health",
+			wantStatus:  http.StatusOK,
+			wantVersion: "v1",
+		},
+		{
+			name:        "path",
+			path:        "/v1/analyze",
+			wantStatus:  http.StatusOK,
+			wantVersion: "v1",
+		},
+		{
+			name:        "api-version header",
+			path:        "/analyze",
+			headers:     map[string]string{"API-Version": "v2"},
+			supported:   []string{"v1", "v2"},
+			wantStatus:  http.StatusOK,
+			wantVersion: "v2",
+		},
+		{
+			name:        "api-version header without the prefix",
+			path:        "/analyze",
+			headers:     map[string]string{"API-Version": " 2 "},
+			supported:   []string{"v1", "v2"},
+			wantStatus:  http.StatusOK,
+			wantVersion: "v2",
+		},
+		{
+			name:        "content type",
+			path:        "/analyze",
+			headers:     map[string]string{"Content-Type": "application/vnd.web-analyzer.v2+json; charset=utf-8"},
+			supported:   []string{"v1", "v2"},
+			wantStatus:  http.StatusOK,
+			wantVersion: "v2",
+		},
+		{
+			name:        "accept picks the first supported version",
+			path:        "/analyze",
+			headers:     map[string]string{"Accept": "application/vnd.web-analyzer.v3+json, application/vnd.web-analyzer.v2+json, application/json"},
+			supported:   []string{"v1", "v2"},
+			wantStatus:  http.StatusOK,
+			wantVersion: "v2",
+		},
+		{
+			name:        "accept skips refused media types",
+			path:        "/analyze",
+			headers:     map[string]string{"Accept": "application/vnd.web-analyzer.v2+json;q=0.0, application/vnd.web-analyzer.v1+json;q=0.5"},
+			supported:   []string{"v1", "v2"},
+			wantStatus:  http.StatusOK,
+			wantVersion: "v1",
+		},
+		{
+			name:        "accept without vendor media types",
+			path:        "/analyze",
+			headers:     map[string]string{"Accept": "application/json, text/event-stream"},
+			wantStatus:  http.StatusOK,
+			wantVersion: "v1",
+		},
+		{
+			name:        "malformed accept entries are skipped",
+			path:        "/analyze",
+			headers:     map[string]string{"Accept": "application/vnd.web-analyzer.v2+json;;;=, application/vnd.web-analyzer.v1+json"},
+			wantStatus:  http.StatusOK,
+			wantVersion: "v1",
+		},
+		{
+			name:        "accept including the named version",
+			path:        "/v1/analyze",
+			headers:     map[string]string{"Accept": "application/vnd.web-analyzer.v2+json, application/vnd.web-analyzer.v1+json"},
+			supported:   []string{"v1", "v2"},
+			wantStatus:  http.StatusOK,
+			wantVersion: "v1",
+		},
+		{
+			name:        "accept only naming an unsupported version",
+			path:        "/analyze",
+			headers:     map[string]string{"Accept": "application/vnd.web-analyzer.v9+json"},
+			wantStatus:  http.StatusBadRequest,
+			wantVersion: "v1",
+			wantCode:    "unsupported_api_version",
+		},
+		{
+			name:        "unsupported path version",
+			path:        "/v2/analyze",
+			wantStatus:  http.StatusBadRequest,
+			wantVersion: "v1",
+			wantCode:    "unsupported_api_version",
+		},
+		{
+			name:        "path and header conflict",
+			path:        "/v1/analyze",
+			headers:     map[string]string{"API-Version": "v2"},
+			supported:   []string{"v1", "v2"},
+			wantStatus:  http.StatusBadRequest,
+			wantVersion: "v1",
+			wantCode:    "conflicting_api_version",
+		},
+		{
+			name:        "header and content type conflict",
+			path:        "/analyze",
+			headers:     map[string]string{"API-Version": "v1", "Content-Type": "application/vnd.web-analyzer.v2+json"},
+			supported:   []string{"v1", "v2"},
+			wantStatus:  http.StatusBadRequest,
+			wantVersion: "v1",
+			wantCode:    "conflicting_api_version",
+		},
+		{
+			name:        "accept excluding the named version",
+			path:        "/v1/analyze",
+			headers:     map[string]string{"Accept": "application/vnd.web-analyzer.v2+json"},
+			supported:   []string{"v1", "v2"},
+			wantStatus:  http.StatusBadRequest,
+			wantVersion: "v1",
+			wantCode:    "conflicting_api_version",
+		},
+		{
+			name:        "matching strategies",
+			path:        "/v2/analyze",
+			headers:     map[string]string{"API-Version": "2", "Accept": "application/vnd.web-analyzer.v2+json"},
+			supported:   []string{"v1", "v2"},
+			wantStatus:  http.StatusOK,
+			wantVersion: "v2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var negotiated string
+
+			handler := handlers.NewVersionMiddleware(tt.supported...)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				negotiated = handlers.APIVersionFromContext(r.Context())
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			for name, value := range tt.headers {
+				r.Header.Set(name, value)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body)
+			}
+
+			if got := w.Header().Get("API-Version"); got != tt.wantVersion {
+				t.Errorf("API-Version = %q, want %q", got, tt.wantVersion)
+			}
+
+			if tt.wantCode == "" {
+				if negotiated != tt.wantVersion {
+					t.Errorf("APIVersionFromContext() = %q, want %q", negotiated, tt.wantVersion)
+				}
+
+				return
+			}
+
+			var body handlers.ErrorResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decoding the error: %v", err)
+			}
+
+			if body.Error == nil || *body.Error != tt.wantCode {
+				t.Errorf("error = %v, want %q", body.Error, tt.wantCode)
+			}
+		})
+	}
+}
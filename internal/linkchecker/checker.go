@@ -0,0 +1,324 @@
+// Package linkchecker verifies the accessibility of the links found on a page.
+package linkchecker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/netguard"
+)
+
+const (
+	defaultWorkers      = 16
+	defaultPerHostLimit = 4
+	defaultTimeout      = 5 * time.Second
+	defaultMaxRedirects = 10
+	defaultUserAgent    = "web-analyzer-linkchecker/1.0 (+https://github.com/architeacher/svc-web-analyzer)"
+
+	// fallbackBodyLimit bounds how much of a GET fallback response is drained.
+	fallbackBodyLimit = 64 << 10
+)
+
+// Result is the outcome of checking a single link.
+type Result struct {
+	URL        string
+	StatusCode int
+	// ErrorCode is empty for accessible links.
+	ErrorCode ErrorCode
+	// Error is a human readable description of ErrorCode.
+	Error string
+}
+
+// Accessible reports whether the link could be reached.
+func (r Result) Accessible() bool {
+	return r.ErrorCode == ""
+}
+
+// Option configures a Checker.
+type Option func(*Checker)
+
+// WithWorkers sets the number of links checked concurrently.
+func WithWorkers(workers int) Option {
+	return func(c *Checker) {
+		if workers > 0 {
+			c.workers = workers
+		}
+	}
+}
+
+// WithPerHostLimit caps the number of concurrent requests sent to a single host.
+func WithPerHostLimit(limit int) Option {
+	return func(c *Checker) {
+		if limit > 0 {
+			c.perHostLimit = limit
+		}
+	}
+}
+
+// WithTimeout sets the time budget of a single link, including the GET fallback.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Checker) {
+		if timeout > 0 {
+			c.timeout = timeout
+		}
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Checker) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithGuard sets the guard checking the addresses links are checked at, on every redirect. By
+// default, only public addresses are reachable, so that the links of a page cannot probe the
+// internal network.
+func WithGuard(guard *netguard.Guard) Option {
+	return func(c *Checker) {
+		c.guard = guard
+	}
+}
+
+// WithClient overrides the HTTP client used to check links, along with its protection of the
+// internal network.
+func WithClient(client *http.Client) Option {
+	return func(c *Checker) {
+		c.client = client
+	}
+}
+
+// Checker checks links through a bounded worker pool with per-host concurrency caps.
+type Checker struct {
+	client       *http.Client
+	guard        *netguard.Guard
+	workers      int
+	perHostLimit int
+	timeout      time.Duration
+	userAgent    string
+}
+
+// New creates a Checker.
+func New(opts ...Option) *Checker {
+	c := &Checker{
+		workers:      defaultWorkers,
+		perHostLimit: defaultPerHostLimit,
+		timeout:      defaultTimeout,
+		userAgent:    defaultUserAgent,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.client == nil {
+		c.client = netguard.NewClient(c.guard, defaultMaxRedirects, func(t *http.Transport) {
+			t.MaxConnsPerHost = c.perHostLimit
+			t.MaxIdleConnsPerHost = c.perHostLimit
+		})
+	}
+
+	return c
+}
+
+// Check verifies every unique link and returns one result per unique link, in first-seen order.
+func (c *Checker) Check(ctx context.Context, links []*url.URL) []Result {
+	targets := dedupe(links)
+	results := make([]Result, len(targets))
+
+	if len(targets) == 0 {
+		return results
+	}
+
+	jobs := make(chan int)
+	hosts := newHostLimiter(c.perHostLimit)
+
+	var wg sync.WaitGroup
+	for range min(c.workers, len(targets)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				results[i] = c.checkWithLimit(ctx, hosts, targets[i])
+			}
+		}()
+	}
+
+	for _, i := range interleaveByHost(targets) {
+		jobs <- i
+	}
+	close(jobs)
+
+	wg.Wait()
+
+	return results
+}
+
+func (c *Checker) checkWithLimit(ctx context.Context, hosts *hostLimiter, target *url.URL) Result {
+	release, err := hosts.acquire(ctx, target.Host)
+	if err != nil {
+		return failure(target.String(), 0, classify(err))
+	}
+	defer release()
+
+	return c.check(ctx, target)
+}
+
+func (c *Checker) check(ctx context.Context, target *url.URL) Result {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	rawURL := target.String()
+
+	status, err := c.request(ctx, http.MethodHead, rawURL)
+	if err != nil {
+		return failure(rawURL, 0, classify(err))
+	}
+
+	// Plenty of servers reject or mishandle HEAD, so any error status gets a second chance with GET.
+	if status >= http.StatusBadRequest {
+		status, err = c.request(ctx, http.MethodGet, rawURL)
+		if err != nil {
+			return failure(rawURL, 0, classify(err))
+		}
+	}
+
+	if status >= http.StatusBadRequest {
+		return Result{
+			URL:        rawURL,
+			StatusCode: status,
+			ErrorCode:  ErrCodeHTTPStatus,
+			Error:      http.StatusText(status),
+		}
+	}
+
+	return Result{URL: rawURL, StatusCode: status}
+}
+
+func (c *Checker) request(ctx context.Context, method, rawURL string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", errInvalidURL, err)
+	}
+
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// Draining a bounded amount allows the connection to be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, fallbackBodyLimit))
+
+	return resp.StatusCode, nil
+}
+
+func failure(rawURL string, status int, code ErrorCode) Result {
+	return Result{
+		URL:        rawURL,
+		StatusCode: status,
+		ErrorCode:  code,
+		Error:      code.Description(),
+	}
+}
+
+// dedupe drops duplicate links, ignoring fragments and the case of scheme and host.
+func dedupe(links []*url.URL) []*url.URL {
+	seen := make(map[string]struct{}, len(links))
+	unique := make([]*url.URL, 0, len(links))
+
+	for _, link := range links {
+		if link == nil {
+			continue
+		}
+
+		normalized := *link
+		normalized.Fragment = ""
+		normalized.RawFragment = ""
+		normalized.Scheme = strings.ToLower(normalized.Scheme)
+		normalized.Host = strings.ToLower(normalized.Host)
+
+		key := normalized.String()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		unique = append(unique, &normalized)
+	}
+
+	return unique
+}
+
+// interleaveByHost orders the link indexes round-robin across hosts, so that workers are
+// not all parked on the limiter of the host with the most links.
+func interleaveByHost(targets []*url.URL) []int {
+	var (
+		hosts  []string
+		byHost = make(map[string][]int)
+	)
+
+	for i, target := range targets {
+		if _, ok := byHost[target.Host]; !ok {
+			hosts = append(hosts, target.Host)
+		}
+
+		byHost[target.Host] = append(byHost[target.Host], i)
+	}
+
+	order := make([]int, 0, len(targets))
+	for len(order) < len(targets) {
+		for _, host := range hosts {
+			if queue := byHost[host]; len(queue) > 0 {
+				order = append(order, queue[0])
+				byHost[host] = queue[1:]
+			}
+		}
+	}
+
+	return order
+}
+
+type hostLimiter struct {
+	limit int
+
+	mu    sync.Mutex
+	hosts map[string]chan struct{}
+}
+
+func newHostLimiter(limit int) *hostLimiter {
+	return &hostLimiter{
+		limit: limit,
+		hosts: make(map[string]chan struct{}),
+	}
+}
+
+func (l *hostLimiter) acquire(ctx context.Context, host string) (func(), error) {
+	l.mu.Lock()
+	sem, ok := l.hosts[host]
+	if !ok {
+		sem = make(chan struct{}, l.limit)
+		l.hosts[host] = sem
+	}
+	l.mu.Unlock()
+
+	select {
+	case sem <- struct{}{}:
+		return func() { <-sem }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+var errInvalidURL = errors.New("invalid url")
@@ -0,0 +1,239 @@
+package linkchecker_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/linkchecker"
+	"github.com/architeacher/svc-web-analyzer/internal/netguard"
+)
+
+// newChecker returns a Checker allowed to reach the loopback test servers.
+func newChecker(t *testing.T, opts ...linkchecker.Option) *linkchecker.Checker {
+	t.Helper()
+
+	guard, err := netguard.New("127.0.0.0/8", "::1")
+	if err != nil {
+		t.Fatalf("netguard.New() error = %v", err)
+	}
+
+	return linkchecker.New(append([]linkchecker.Option{linkchecker.WithGuard(guard)}, opts...)...)
+}
+
+func mustParse(t *testing.T, rawURL string) *url.URL {
+	t.Helper()
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatalf("url.Parse(%q) error = %v", rawURL, err)
+	}
+
+	return u
+}
+
+func TestCheckerCheck(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/missing", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/head-rejected", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/loop", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/loop", http.StatusFound)
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/ok", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	// A listener closed right away leaves a port nothing listens on.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+
+	closedURL := "http://" + ln.Addr().String() + "/"
+	_ = ln.Close()
+
+	tests := []struct {
+		name       string
+		url        string
+		wantStatus int
+		wantCode   linkchecker.ErrorCode
+	}{
+		{name: "accessible", url: srv.URL + "/ok", wantStatus: http.StatusOK},
+		{name: "followed redirect", url: srv.URL + "/redirect", wantStatus: http.StatusOK},
+		{name: "head rejected falls back to get", url: srv.URL + "/head-rejected", wantStatus: http.StatusOK},
+		{name: "error status", url: srv.URL + "/missing", wantStatus: http.StatusNotFound, wantCode: linkchecker.ErrCodeHTTPStatus},
+		{name: "redirect loop", url: srv.URL + "/loop", wantCode: linkchecker.ErrCodeTooManyRedirects},
+		{name: "timeout", url: srv.URL + "/slow", wantCode: linkchecker.ErrCodeTimeout},
+		{name: "connection refused", url: closedURL, wantCode: linkchecker.ErrCodeConnectionRefused},
+	}
+
+	checker := newChecker(t, linkchecker.WithTimeout(500*time.Millisecond))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			results := checker.Check(context.Background(), []*url.URL{mustParse(t, tt.url)})
+			if len(results) != 1 {
+				t.Fatalf("Check() returned %d results, want 1", len(results))
+			}
+
+			got := results[0]
+			if got.StatusCode != tt.wantStatus || got.ErrorCode != tt.wantCode {
+				t.Errorf("Check() = {status %d, code %q, error %q}, want {status %d, code %q}",
+					got.StatusCode, got.ErrorCode, got.Error, tt.wantStatus, tt.wantCode)
+			}
+
+			if got.Accessible() != (tt.wantCode == "") {
+				t.Errorf("Accessible() = %t, want %t", got.Accessible(), tt.wantCode == "")
+			}
+
+			if !got.Accessible() && got.Error == "" {
+				t.Error("Error is empty for an inaccessible link")
+			}
+		})
+	}
+}
+
+func TestCheckerBlocksInternalAddresses(t *testing.T) {
+	t.Parallel()
+
+	var hits atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	results := linkchecker.New().Check(context.Background(), []*url.URL{mustParse(t, srv.URL+"/")})
+
+	if got := results[0].ErrorCode; got != linkchecker.ErrCodeBlockedTarget {
+		t.Errorf("ErrorCode = %q, want %q", got, linkchecker.ErrCodeBlockedTarget)
+	}
+
+	if hits.Load() != 0 {
+		t.Errorf("the blocked server received %d requests", hits.Load())
+	}
+}
+
+func TestCheckerDeduplicatesInOrder(t *testing.T) {
+	t.Parallel()
+
+	var hits atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	links := []*url.URL{
+		mustParse(t, srv.URL+"/b"),
+		mustParse(t, srv.URL+"/a#section"),
+		mustParse(t, srv.URL+"/b#top"),
+		nil,
+		mustParse(t, srv.URL+"/a"),
+	}
+
+	results := newChecker(t).Check(context.Background(), links)
+
+	want := []string{srv.URL + "/b", srv.URL + "/a"}
+	if len(results) != len(want) {
+		t.Fatalf("Check() returned %d results, want %d", len(results), len(want))
+	}
+
+	for i, result := range results {
+		if result.URL != want[i] {
+			t.Errorf("results[%d].URL = %q, want %q", i, result.URL, want[i])
+		}
+	}
+
+	if hits.Load() != int32(len(want)) {
+		t.Errorf("the server received %d requests, want %d", hits.Load(), len(want))
+	}
+}
+
+func TestCheckerPerHostLimit(t *testing.T) {
+	t.Parallel()
+
+	const limit = 2
+
+	var inFlight, peak atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	links := make([]*url.URL, 0, 10)
+	for _, path := range []string{"/1", "/2", "/3", "/4", "/5", "/6", "/7", "/8", "/9", "/10"} {
+		links = append(links, mustParse(t, srv.URL+path))
+	}
+
+	results := newChecker(t, linkchecker.WithWorkers(8), linkchecker.WithPerHostLimit(limit)).
+		Check(context.Background(), links)
+
+	for _, result := range results {
+		if !result.Accessible() {
+			t.Errorf("%s: ErrorCode = %q, want accessible", result.URL, result.ErrorCode)
+		}
+	}
+
+	if got := peak.Load(); got > limit {
+		t.Errorf("peak concurrent requests to the host = %d, want at most %d", got, limit)
+	}
+}
+
+func TestCheckerCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := newChecker(t).Check(ctx, []*url.URL{mustParse(t, "http://127.0.0.1:1/")})
+
+	if got := results[0].ErrorCode; got != linkchecker.ErrCodeCanceled {
+		t.Errorf("ErrorCode = %q, want %q", got, linkchecker.ErrCodeCanceled)
+	}
+}
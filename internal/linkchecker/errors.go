@@ -0,0 +1,102 @@
+package linkchecker
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"syscall"
+
+	"github.com/architeacher/svc-web-analyzer/internal/netguard"
+)
+
+// ErrorCode identifies why a link is inaccessible.
+type ErrorCode string
+
+const (
+	ErrCodeHTTPStatus        ErrorCode = "http_error"
+	ErrCodeDNS               ErrorCode = "dns_error"
+	ErrCodeTLS               ErrorCode = "tls_error"
+	ErrCodeTimeout           ErrorCode = "timeout"
+	ErrCodeConnectionRefused ErrorCode = "connection_refused"
+	ErrCodeConnectionReset   ErrorCode = "connection_reset"
+	ErrCodeHostUnreachable   ErrorCode = "host_unreachable"
+	ErrCodeTooManyRedirects  ErrorCode = "too_many_redirects"
+	ErrCodeInvalidURL        ErrorCode = "invalid_url"
+	ErrCodeCanceled          ErrorCode = "canceled"
+	ErrCodeNetwork           ErrorCode = "network_error"
+	ErrCodeBlockedTarget     ErrorCode = "blocked_target"
+)
+
+var descriptions = map[ErrorCode]string{
+	ErrCodeHTTPStatus:        "HTTP error",
+	ErrCodeDNS:               "DNS lookup failed",
+	ErrCodeTLS:               "TLS handshake failed",
+	ErrCodeTimeout:           "Connection timeout",
+	ErrCodeConnectionRefused: "Connection refused",
+	ErrCodeConnectionReset:   "Connection reset by peer",
+	ErrCodeHostUnreachable:   "Host unreachable",
+	ErrCodeTooManyRedirects:  "Too many redirects",
+	ErrCodeInvalidURL:        "Invalid URL",
+	ErrCodeCanceled:          "Check canceled",
+	ErrCodeNetwork:           "Network error",
+	ErrCodeBlockedTarget:     "Not a public address",
+}
+
+// Description returns a human readable description of the code.
+func (c ErrorCode) Description() string {
+	if d, ok := descriptions[c]; ok {
+		return d
+	}
+
+	return string(c)
+}
+
+// classify maps a transport error to an ErrorCode.
+func classify(err error) ErrorCode {
+	var (
+		dnsErr      *net.DNSError
+		netErr      net.Error
+		certErr     *tls.CertificateVerificationError
+		recordErr   tls.RecordHeaderError
+		unknownAuth x509.UnknownAuthorityError
+		hostnameErr x509.HostnameError
+		invalidErr  x509.CertificateInvalidError
+	)
+
+	switch {
+	case errors.Is(err, netguard.ErrBlocked):
+		return ErrCodeBlockedTarget
+	case errors.Is(err, errInvalidURL):
+		return ErrCodeInvalidURL
+	case errors.Is(err, netguard.ErrTooManyRedirects):
+		return ErrCodeTooManyRedirects
+	case errors.Is(err, context.Canceled):
+		return ErrCodeCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrCodeTimeout
+	case errors.As(err, &dnsErr):
+		if dnsErr.IsTimeout {
+			return ErrCodeTimeout
+		}
+
+		return ErrCodeDNS
+	case errors.As(err, &certErr),
+		errors.As(err, &recordErr),
+		errors.As(err, &unknownAuth),
+		errors.As(err, &hostnameErr),
+		errors.As(err, &invalidErr):
+		return ErrCodeTLS
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return ErrCodeConnectionRefused
+	case errors.Is(err, syscall.ECONNRESET):
+		return ErrCodeConnectionReset
+	case errors.Is(err, syscall.EHOSTUNREACH), errors.Is(err, syscall.ENETUNREACH):
+		return ErrCodeHostUnreachable
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return ErrCodeTimeout
+	default:
+		return ErrCodeNetwork
+	}
+}
@@ -0,0 +1,138 @@
+package linkchecker
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/netguard"
+)
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+
+	dial := func(err error) error {
+		return &url.Error{Op: "Head", URL: "https://example.com/", Err: &net.OpError{Op: "dial", Net: "tcp", Err: err}}
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want ErrorCode
+	}{
+		{
+			name: "blocked target",
+			err:  dial(&netguard.BlockedError{Host: "internal.example", Addr: netip.MustParseAddr("10.0.0.1")}),
+			want: ErrCodeBlockedTarget,
+		},
+		{
+			name: "invalid url",
+			err:  fmt.Errorf("%w: %w", errInvalidURL, errors.New("missing host")),
+			want: ErrCodeInvalidURL,
+		},
+		{
+			name: "too many redirects",
+			err:  &url.Error{Op: "Get", URL: "https://example.com/", Err: netguard.ErrTooManyRedirects},
+			want: ErrCodeTooManyRedirects,
+		},
+		{
+			name: "canceled",
+			err:  &url.Error{Op: "Head", URL: "https://example.com/", Err: context.Canceled},
+			want: ErrCodeCanceled,
+		},
+		{
+			name: "deadline exceeded",
+			err:  &url.Error{Op: "Head", URL: "https://example.com/", Err: context.DeadlineExceeded},
+			want: ErrCodeTimeout,
+		},
+		{
+			name: "dns not found",
+			err:  dial(&net.DNSError{Err: "no such host", Name: "missing.example", IsNotFound: true}),
+			want: ErrCodeDNS,
+		},
+		{
+			name: "dns timeout",
+			err:  dial(&net.DNSError{Err: "i/o timeout", Name: "slow.example", IsTimeout: true}),
+			want: ErrCodeTimeout,
+		},
+		{
+			name: "unknown certificate authority",
+			err:  &url.Error{Op: "Head", URL: "https://example.com/", Err: x509.UnknownAuthorityError{}},
+			want: ErrCodeTLS,
+		},
+		{
+			name: "certificate verification",
+			err: &url.Error{Op: "Head", URL: "https://example.com/", Err: &tls.CertificateVerificationError{
+				Err: x509.HostnameError{Certificate: &x509.Certificate{}, Host: "example.com"},
+			}},
+			want: ErrCodeTLS,
+		},
+		{
+			name: "plain http answer to tls",
+			err:  &url.Error{Op: "Head", URL: "https://example.com/", Err: tls.RecordHeaderError{Msg: "first record does not look like a TLS handshake"}},
+			want: ErrCodeTLS,
+		},
+		{
+			name: "connection refused",
+			err:  dial(os.NewSyscallError("connect", syscall.ECONNREFUSED)),
+			want: ErrCodeConnectionRefused,
+		},
+		{
+			name: "connection reset",
+			err:  &url.Error{Op: "Head", URL: "https://example.com/", Err: &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}},
+			want: ErrCodeConnectionReset,
+		},
+		{
+			name: "host unreachable",
+			err:  dial(os.NewSyscallError("connect", syscall.EHOSTUNREACH)),
+			want: ErrCodeHostUnreachable,
+		},
+		{
+			name: "network unreachable",
+			err:  dial(os.NewSyscallError("connect", syscall.ENETUNREACH)),
+			want: ErrCodeHostUnreachable,
+		},
+		{
+			name: "network timeout",
+			err:  dial(os.ErrDeadlineExceeded),
+			want: ErrCodeTimeout,
+		},
+		{
+			name: "other",
+			err:  errors.New("unexpected EOF"),
+			want: ErrCodeNetwork,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := classify(tt.err); got != tt.want {
+				t.Errorf("classify(%v) = %q, want %q", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorCodeDescription(t *testing.T) {
+	t.Parallel()
+
+	for code, want := range descriptions {
+		if got := code.Description(); got != want {
+			t.Errorf("%q.Description() = %q, want %q", code, got, want)
+		}
+	}
+
+	if got := ErrorCode("custom").Description(); got != "custom" {
+		t.Errorf("Description() of an unknown code = %q, want the code itself", got)
+	}
+}
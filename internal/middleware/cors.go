@@ -0,0 +1,209 @@
+package middleware
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// CORSOptions configures CORS.
+type CORSOptions struct {
+	// AllowedOrigins are the origins allowed to call the API, such as https://app.example.com.
+	// "*" allows any origin, and a "*." host prefix any subdomain, as in https://*.example.com;
+	// origins without a scheme match both http and https.
+	AllowedOrigins []string
+	// AllowedMethods and AllowedHeaders are the methods and request headers preflight requests
+	// may ask for.
+	AllowedMethods []string
+	AllowedHeaders []string
+	// ExposedHeaders are the response headers readable by the browser scripts.
+	ExposedHeaders []string
+	// AllowCredentials lets browsers send cookies and Authorization headers along.
+	AllowCredentials bool
+	// MaxAge is how long browsers may cache the result of a preflight request.
+	MaxAge time.Duration
+}
+
+// CORS answers the cross-origin requests of browsers from the allowed origins. Preflight
+// requests for routes registered on the router, which has to be a chi router, are answered with
+// 204 No Content; the other preflight requests go through, to be answered with 404 or 405.
+func CORS(opts CORSOptions) func(http.Handler) http.Handler {
+	c := &cors{
+		opts:           opts,
+		allowedMethods: strings.Join(opts.AllowedMethods, ", "),
+		exposedHeaders: strings.Join(opts.ExposedHeaders, ", "),
+		maxAge:         strconv.Itoa(int(opts.MaxAge.Seconds())),
+	}
+
+	for _, origin := range opts.AllowedOrigins {
+		if origin == "*" {
+			c.anyOrigin = true
+
+			continue
+		}
+
+		c.origins = append(c.origins, parseOriginPattern(origin))
+	}
+
+	for _, header := range opts.AllowedHeaders {
+		c.allowedHeaders = append(c.allowedHeaders, http.CanonicalHeaderKey(header))
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if origin == "" {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				c.preflight(w, r, next)
+
+				return
+			}
+
+			w.Header().Add("Vary", "Origin")
+
+			if c.allowsOrigin(origin) {
+				c.setOrigin(w, origin)
+
+				if c.exposedHeaders != "" {
+					w.Header().Set("Access-Control-Expose-Headers", c.exposedHeaders)
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+type cors struct {
+	opts           CORSOptions
+	anyOrigin      bool
+	origins        []originPattern
+	allowedMethods string
+	allowedHeaders []string
+	exposedHeaders string
+	maxAge         string
+}
+
+func (c *cors) preflight(w http.ResponseWriter, r *http.Request, next http.Handler) {
+	method := r.Header.Get("Access-Control-Request-Method")
+
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil || rctx.Routes == nil || !rctx.Routes.Match(chi.NewRouteContext(), method, r.URL.Path) {
+		next.ServeHTTP(w, r)
+
+		return
+	}
+
+	w.Header().Add("Vary", "Origin")
+	w.Header().Add("Vary", "Access-Control-Request-Method")
+	w.Header().Add("Vary", "Access-Control-Request-Headers")
+
+	// A disallowed request is answered without the CORS headers, so that the browser blocks it.
+	if c.allowsOrigin(r.Header.Get("Origin")) && slices.Contains(c.opts.AllowedMethods, method) &&
+		c.allowsHeaders(r.Header.Get("Access-Control-Request-Headers")) {
+		c.setOrigin(w, r.Header.Get("Origin"))
+		w.Header().Set("Access-Control-Allow-Methods", c.allowedMethods)
+
+		if requested := r.Header.Get("Access-Control-Request-Headers"); requested != "" {
+			w.Header().Set("Access-Control-Allow-Headers", requested)
+		}
+
+		if c.opts.MaxAge > 0 {
+			w.Header().Set("Access-Control-Max-Age", c.maxAge)
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// setOrigin allows origin. The origin is echoed rather than "*" when credentials are allowed,
+// since browsers refuse "*" along with credentials.
+func (c *cors) setOrigin(w http.ResponseWriter, origin string) {
+	if c.anyOrigin && !c.opts.AllowCredentials {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+	} else {
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+	}
+
+	if c.opts.AllowCredentials {
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+}
+
+func (c *cors) allowsOrigin(origin string) bool {
+	if c.anyOrigin {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" {
+		return false
+	}
+
+	host := strings.ToLower(u.Host)
+
+	for _, pattern := range c.origins {
+		if pattern.matches(u.Scheme, host) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *cors) allowsHeaders(requested string) bool {
+	for _, header := range strings.Split(requested, ",") {
+		header = strings.TrimSpace(header)
+		if header != "" && !slices.Contains(c.allowedHeaders, http.CanonicalHeaderKey(header)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// originPattern is an allowed origin, whose host may start with a "*." wildcard.
+type originPattern struct {
+	scheme string
+	host   string
+	suffix string
+}
+
+func parseOriginPattern(origin string) originPattern {
+	var p originPattern
+
+	host := strings.ToLower(strings.TrimSuffix(origin, "/"))
+	if scheme, rest, ok := strings.Cut(host, "://"); ok {
+		p.scheme, host = scheme, rest
+	}
+
+	if strings.HasPrefix(host, "*.") {
+		p.suffix = host[1:]
+	} else {
+		p.host = host
+	}
+
+	return p
+}
+
+func (p originPattern) matches(scheme, host string) bool {
+	if p.scheme != "" && p.scheme != scheme {
+		return false
+	}
+
+	if p.suffix != "" {
+		return strings.HasSuffix(host, p.suffix) && len(host) > len(p.suffix)
+	}
+
+	return host == p.host
+}
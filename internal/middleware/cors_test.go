@@ -0,0 +1,296 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/architeacher/svc-web-analyzer/internal/middleware"
+)
+
+func newCORSRouter(opts middleware.CORSOptions) http.Handler {
+	r := chi.NewRouter()
+	r.Use(middleware.CORS(opts))
+
+	ok := func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }
+
+	r.Post("/v1/analyze", ok)
+	r.Get("/v1/analysis/{analysisId}", ok)
+	r.Delete("/v1/analysis/{analysisId}", ok)
+
+	return r
+}
+
+func TestCORSPreflight(t *testing.T) {
+	t.Parallel()
+
+	base := middleware.CORSOptions{
+		AllowedOrigins: []string{"https://app.example.com", "https://*.example.org", "partner.example.net"},
+		AllowedMethods: []string{http.MethodGet, http.MethodPost},
+		AllowedHeaders: []string{"Content-Type", "Authorization"},
+		MaxAge:         10 * time.Minute,
+	}
+
+	tests := []struct {
+		name           string
+		opts           *middleware.CORSOptions
+		path           string
+		origin         string
+		method         string
+		requestHeaders string
+		wantStatus     int
+		wantOrigin     string
+		wantHeaders    string
+		wantMethods    string
+		wantMaxAge     string
+		wantCreds      string
+	}{
+		{
+			name:           "allowed",
+			path:           "/v1/analyze",
+			origin:         "https://app.example.com",
+			method:         http.MethodPost,
+			requestHeaders: "content-type, Authorization",
+			wantStatus:     http.StatusNoContent,
+			wantOrigin:     "https://app.example.com",
+			wantHeaders:    "content-type, Authorization",
+			wantMethods:    "GET, POST",
+			wantMaxAge:     "600",
+		},
+		{
+			name:        "route with parameters",
+			path:        "/v1/analysis/42",
+			origin:      "https://app.example.com",
+			method:      http.MethodGet,
+			wantStatus:  http.StatusNoContent,
+			wantOrigin:  "https://app.example.com",
+			wantMethods: "GET, POST",
+			wantMaxAge:  "600",
+		},
+		{
+			name:        "subdomain wildcard",
+			path:        "/v1/analyze",
+			origin:      "https://ci.build.example.org",
+			method:      http.MethodPost,
+			wantStatus:  http.StatusNoContent,
+			wantOrigin:  "https://ci.build.example.org",
+			wantMethods: "GET, POST",
+			wantMaxAge:  "600",
+		},
+		{
+			name:       "wildcard does not match the apex",
+			path:       "/v1/analyze",
+			origin:     "https://example.org",
+			method:     http.MethodPost,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "wildcard does not match another scheme",
+			path:       "/v1/analyze",
+			origin:     "http://ci.example.org",
+			method:     http.MethodPost,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:        "origin without a scheme matches http",
+			path:        "/v1/analyze",
+			origin:      "http://partner.example.net",
+			method:      http.MethodPost,
+			wantStatus:  http.StatusNoContent,
+			wantOrigin:  "http://partner.example.net",
+			wantMethods: "GET, POST",
+			wantMaxAge:  "600",
+		},
+		{
+			name:       "disallowed origin",
+			path:       "/v1/analyze",
+			origin:     "https://evil.example.com",
+			method:     http.MethodPost,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "opaque origin",
+			path:       "/v1/analyze",
+			origin:     "null",
+			method:     http.MethodPost,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "disallowed method of the route",
+			path:       "/v1/analysis/42",
+			origin:     "https://app.example.com",
+			method:     http.MethodDelete,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:           "disallowed header",
+			path:           "/v1/analyze",
+			origin:         "https://app.example.com",
+			method:         http.MethodPost,
+			requestHeaders: "Content-Type, X-Debug",
+			wantStatus:     http.StatusNoContent,
+		},
+		{
+			name:       "method the route does not serve",
+			path:       "/v1/analyze",
+			origin:     "https://app.example.com",
+			method:     http.MethodGet,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:       "unknown route",
+			path:       "/v1/unknown",
+			origin:     "https://app.example.com",
+			method:     http.MethodGet,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:        "any origin",
+			opts:        &middleware.CORSOptions{AllowedOrigins: []string{"*"}, AllowedMethods: []string{http.MethodPost}},
+			path:        "/v1/analyze",
+			origin:      "https://anything.example",
+			method:      http.MethodPost,
+			wantStatus:  http.StatusNoContent,
+			wantOrigin:  "*",
+			wantMethods: "POST",
+		},
+		{
+			name: "any origin with credentials",
+			opts: &middleware.CORSOptions{
+				AllowedOrigins:   []string{"*"},
+				AllowedMethods:   []string{http.MethodPost},
+				AllowCredentials: true,
+			},
+			path:        "/v1/analyze",
+			origin:      "https://anything.example",
+			method:      http.MethodPost,
+			wantStatus:  http.StatusNoContent,
+			wantOrigin:  "https://anything.example",
+			wantMethods: "POST",
+			wantCreds:   "true",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := base
+			if tt.opts != nil {
+				opts = *tt.opts
+			}
+
+			r := httptest.NewRequest(http.MethodOptions, tt.path, nil)
+			r.Header.Set("Origin", tt.origin)
+			r.Header.Set("Access-Control-Request-Method", tt.method)
+
+			if tt.requestHeaders != "" {
+				r.Header.Set("Access-Control-Request-Headers", tt.requestHeaders)
+			}
+
+			w := httptest.NewRecorder()
+			newCORSRouter(opts).ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+
+			for name, want := range map[string]string{
+				"Access-Control-Allow-Origin":      tt.wantOrigin,
+				"Access-Control-Allow-Methods":     tt.wantMethods,
+				"Access-Control-Allow-Headers":     tt.wantHeaders,
+				"Access-Control-Max-Age":           tt.wantMaxAge,
+				"Access-Control-Allow-Credentials": tt.wantCreds,
+			} {
+				if got := w.Header().Get(name); got != want {
+					t.Errorf("%s = %q, want %q", name, got, want)
+				}
+			}
+
+			if w.Code == http.StatusNoContent {
+				want := []string{"Origin", "Access-Control-Request-Method", "Access-Control-Request-Headers"}
+				if got := w.Header().Values("Vary"); !reflect.DeepEqual(got, want) {
+					t.Errorf("Vary = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCORSActualRequests(t *testing.T) {
+	t.Parallel()
+
+	router := newCORSRouter(middleware.CORSOptions{
+		AllowedOrigins:   []string{"https://app.example.com"},
+		AllowedMethods:   []string{http.MethodGet, http.MethodPost},
+		ExposedHeaders:   []string{"X-Request-Id", "Retry-After"},
+		AllowCredentials: true,
+	})
+
+	tests := []struct {
+		name        string
+		method      string
+		origin      string
+		wantOrigin  string
+		wantExposed string
+		wantVary    bool
+	}{
+		{
+			name:        "allowed origin",
+			method:      http.MethodGet,
+			origin:      "https://app.example.com",
+			wantOrigin:  "https://app.example.com",
+			wantExposed: "X-Request-Id, Retry-After",
+			wantVary:    true,
+		},
+		{
+			name:     "disallowed origin",
+			method:   http.MethodGet,
+			origin:   "https://evil.example.com",
+			wantVary: true,
+		},
+		{
+			name:   "same origin request",
+			method: http.MethodGet,
+		},
+		{
+			// Without Access-Control-Request-Method, OPTIONS is an actual request.
+			name:        "options without a requested method",
+			method:      http.MethodOptions,
+			origin:      "https://app.example.com",
+			wantOrigin:  "https://app.example.com",
+			wantExposed: "X-Request-Id, Retry-After",
+			wantVary:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(tt.method, "/v1/analysis/42", nil)
+			if tt.origin != "" {
+				r.Header.Set("Origin", tt.origin)
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+
+			if got := w.Header().Get("Access-Control-Allow-Origin"); got != tt.wantOrigin {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", got, tt.wantOrigin)
+			}
+
+			if got := w.Header().Get("Access-Control-Expose-Headers"); got != tt.wantExposed {
+				t.Errorf("Access-Control-Expose-Headers = %q, want %q", got, tt.wantExposed)
+			}
+
+			if got := w.Header().Get("Vary") == "Origin"; got != tt.wantVary {
+				t.Errorf("Vary = %q, want Origin: %t", w.Header().Get("Vary"), tt.wantVary)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Package middleware provides the HTTP middlewares mounted in front of the generated router.
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+type requestErrorKey struct{}
+
+// RecordError attaches err to the line RequestLogger logs for the request of ctx, which is then
+// logged at the error level. It does nothing for requests not served through RequestLogger.
+func RecordError(ctx context.Context, err error) {
+	if recorded, ok := ctx.Value(requestErrorKey{}).(*error); ok {
+		*recorded = err
+	}
+}
+
+// RequestLogger logs one structured line per request once it has been served.
+func RequestLogger(logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ww := chimiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			start := time.Now()
+
+			var recorded error
+
+			defer func() {
+				level := slog.LevelInfo
+				attrs := []slog.Attr{
+					slog.String("request_id", chimiddleware.GetReqID(r.Context())),
+					slog.String("method", r.Method),
+					slog.String("path", r.URL.Path),
+					slog.Int("status", ww.Status()),
+					slog.Int("bytes", ww.BytesWritten()),
+					slog.Duration("duration", time.Since(start)),
+					slog.String("remote_addr", r.RemoteAddr),
+				}
+
+				if recorded != nil {
+					level = slog.LevelError
+					attrs = append(attrs, slog.String("error", recorded.Error()))
+				}
+
+				logger.LogAttrs(r.Context(), level, "request served", attrs...)
+			}()
+
+			next.ServeHTTP(ww, r.WithContext(context.WithValue(r.Context(), requestErrorKey{}, &recorded)))
+		})
+	}
+}
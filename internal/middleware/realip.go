@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// RealIP sets the remote address of the requests relayed by the trusted proxies, IP addresses
+// or CIDR ranges, to the address of the client they relay for. It is taken from the
+// X-Forwarded-For chain, read from the right and skipping the proxies, so that the addresses
+// prepended by the client itself are ignored, or else from X-Real-IP. The headers of the other
+// peers are ignored, since any client can set them; without trusted proxies, they always are.
+func RealIP(trustedProxies ...string) (func(http.Handler) http.Handler, error) {
+	trusted, err := parsePrefixes(trustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(next http.Handler) http.Handler {
+		if len(trusted) == 0 {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if addr, ok := relayedFor(r, trusted); ok {
+				r.RemoteAddr = addr.String()
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}, nil
+}
+
+// relayedFor returns the address of the client the request was relayed for, when the peer is
+// a trusted proxy.
+func relayedFor(r *http.Request, trusted []netip.Prefix) (netip.Addr, bool) {
+	peer, err := netip.ParseAddrPort(r.RemoteAddr)
+	if err != nil || !contains(trusted, peer.Addr().Unmap()) {
+		return netip.Addr{}, false
+	}
+
+	var hops []string
+	for _, value := range r.Header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+
+	var client netip.Addr
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			// Nothing left of a malformed hop can be trusted.
+			break
+		}
+
+		client = addr.Unmap()
+		if !contains(trusted, client) {
+			return client, true
+		}
+	}
+
+	// Every hop was a proxy: the leftmost is as close to the client as it gets.
+	if client.IsValid() {
+		return client, true
+	}
+
+	if addr, err := netip.ParseAddr(strings.TrimSpace(r.Header.Get("X-Real-IP"))); err == nil {
+		return addr.Unmap(), true
+	}
+
+	return netip.Addr{}, false
+}
+
+func contains(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parsePrefixes parses IP addresses and CIDR ranges.
+func parsePrefixes(entries []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(entries))
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		if strings.Contains(entry, "/") {
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
+			}
+
+			prefixes = append(prefixes, prefix.Masked())
+
+			continue
+		}
+
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
+		}
+
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+
+	return prefixes, nil
+}
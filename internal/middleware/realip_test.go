@@ -0,0 +1,151 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/middleware"
+)
+
+func TestRealIP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		trusted    []string
+		remoteAddr string
+		forwarded  []string
+		realIP     string
+		want       string
+	}{
+		{
+			name:       "no trusted proxies",
+			remoteAddr: "203.0.113.7:4242",
+			forwarded:  []string{"198.51.100.1"},
+			realIP:     "198.51.100.2",
+			want:       "203.0.113.7:4242",
+		},
+		{
+			name:       "untrusted peer",
+			trusted:    []string{"10.0.0.0/8"},
+			remoteAddr: "203.0.113.7:4242",
+			forwarded:  []string{"198.51.100.1"},
+			want:       "203.0.113.7:4242",
+		},
+		{
+			name:       "trusted peer",
+			trusted:    []string{"10.0.0.0/8"},
+			remoteAddr: "10.1.2.3:4242",
+			forwarded:  []string{"198.51.100.1"},
+			want:       "198.51.100.1",
+		},
+		{
+			name:       "trusted peer given as an address",
+			trusted:    []string{"10.1.2.3"},
+			remoteAddr: "10.1.2.3:4242",
+			forwarded:  []string{"198.51.100.1"},
+			want:       "198.51.100.1",
+		},
+		{
+			name:       "address prepended by the client",
+			trusted:    []string{"10.0.0.0/8"},
+			remoteAddr: "10.1.2.3:4242",
+			forwarded:  []string{"192.0.2.66, 198.51.100.1"},
+			want:       "198.51.100.1",
+		},
+		{
+			name:       "chain of proxies",
+			trusted:    []string{"10.0.0.0/8", "172.16.0.0/12"},
+			remoteAddr: "10.1.2.3:4242",
+			forwarded:  []string{"192.0.2.66, 198.51.100.1", "172.16.0.5"},
+			want:       "198.51.100.1",
+		},
+		{
+			name:       "every hop is a proxy",
+			trusted:    []string{"10.0.0.0/8"},
+			remoteAddr: "10.1.2.3:4242",
+			forwarded:  []string{"10.9.9.9, 10.8.8.8"},
+			want:       "10.9.9.9",
+		},
+		{
+			name:       "malformed hop",
+			trusted:    []string{"10.0.0.0/8"},
+			remoteAddr: "10.1.2.3:4242",
+			forwarded:  []string{"198.51.100.1, unknown"},
+			realIP:     "198.51.100.2",
+			want:       "198.51.100.2",
+		},
+		{
+			name:       "x-real-ip",
+			trusted:    []string{"10.0.0.0/8"},
+			remoteAddr: "10.1.2.3:4242",
+			realIP:     "198.51.100.2",
+			want:       "198.51.100.2",
+		},
+		{
+			name:       "ipv6 peer and client",
+			trusted:    []string{"fd00::/8"},
+			remoteAddr: "[fd00::1]:4242",
+			forwarded:  []string{"2001:db8::7"},
+			want:       "2001:db8::7",
+		},
+		{
+			name:       "ipv4-mapped client",
+			trusted:    []string{"10.0.0.0/8"},
+			remoteAddr: "10.1.2.3:4242",
+			forwarded:  []string{"::ffff:198.51.100.1"},
+			want:       "198.51.100.1",
+		},
+		{
+			name:       "trusted peer without headers",
+			trusted:    []string{"10.0.0.0/8"},
+			remoteAddr: "10.1.2.3:4242",
+			want:       "10.1.2.3:4242",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			realIP, err := middleware.RealIP(tt.trusted...)
+			if err != nil {
+				t.Fatalf("RealIP() error = %v", err)
+			}
+
+			var got string
+
+			handler := realIP(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got = r.RemoteAddr
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+
+			for _, value := range tt.forwarded {
+				r.Header.Add("X-Forwarded-For", value)
+			}
+
+			if tt.realIP != "" {
+				r.Header.Set("X-Real-IP", tt.realIP)
+			}
+
+			handler.ServeHTTP(httptest.NewRecorder(), r)
+
+			if got != tt.want {
+				t.Errorf("RemoteAddr = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRealIPInvalidProxies(t *testing.T) {
+	t.Parallel()
+
+	for _, entry := range []string{"10.0.0.0/33", "proxy.internal", "10.0.0"} {
+		if _, err := middleware.RealIP(entry); err == nil {
+			t.Errorf("RealIP(%q) error = nil, want an error", entry)
+		}
+	}
+}
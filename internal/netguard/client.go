@@ -0,0 +1,53 @@
+package netguard
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	// ErrTooManyRedirects is returned by the clients of NewClient redirected more times than
+	// they follow.
+	ErrTooManyRedirects = errors.New("too many redirects")
+	// ErrSchemeNotAllowed is returned by the clients of NewClient redirected to a URL other
+	// than an http or https one.
+	ErrSchemeNotAllowed = errors.New("only http and https URLs may be requested")
+)
+
+// TransportOption customises the transport of the clients of NewClient.
+type TransportOption func(*http.Transport)
+
+// NewClient returns an HTTP client dialing through guard, or through a guard blocking every
+// non-public address when it is nil. It connects directly, since a proxy would connect to the
+// targets itself, past the guard, and follows at most maxRedirects redirects, to http and
+// https URLs only.
+func NewClient(guard *Guard, maxRedirects int, opts ...TransportOption) *http.Client {
+	if guard == nil {
+		// Without an allowlist, the guard cannot fail to be created.
+		guard, _ = New()
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = guard.DialContext
+
+	for _, opt := range opts {
+		opt(transport)
+	}
+
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > maxRedirects {
+				return fmt.Errorf("%w: stopped after %d redirects", ErrTooManyRedirects, maxRedirects)
+			}
+
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return fmt.Errorf("%w: redirected to %s", ErrSchemeNotAllowed, req.URL.Redacted())
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,254 @@
+// Package netguard keeps the outgoing requests to user-supplied URLs away from the internal
+// network, against server-side request forgery.
+package netguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+const defaultDialTimeout = 10 * time.Second
+
+// ErrBlocked is returned when a host resolves only to blocked addresses.
+var ErrBlocked = errors.New("blocked target")
+
+// BlockedError is the error of a dial to a host resolving only to blocked addresses.
+type BlockedError struct {
+	Host string
+	Addr netip.Addr
+}
+
+func (e *BlockedError) Error() string {
+	if e.Host == e.Addr.String() {
+		return fmt.Sprintf("%s: %s is not a public address", ErrBlocked, e.Addr)
+	}
+
+	return fmt.Sprintf("%s: %s resolves to %s, which is not a public address", ErrBlocked, e.Host, e.Addr)
+}
+
+func (e *BlockedError) Is(target error) bool {
+	return target == ErrBlocked
+}
+
+// blockedPrefixes are the address ranges not reachable from the Internet: private networks,
+// loopback, link-local (including the 169.254.169.254 metadata endpoint of the cloud
+// providers), unique local IPv6 addresses, and the unspecified, shared, reserved, discard,
+// documentation and multicast ranges. The IPv6 ranges embedding IPv4 addresses, NAT64,
+// 6to4, Teredo and the deprecated IPv4-compatible addresses, are blocked as a whole, since
+// they may relay to any IPv4 address, private ones included.
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("127.0.0.0/8"),
+	netip.MustParsePrefix("169.254.0.0/16"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("224.0.0.0/4"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	// The unspecified and loopback addresses, among the IPv4-compatible ones.
+	netip.MustParsePrefix("::/96"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001::/32"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	netip.MustParsePrefix("2002::/16"),
+	netip.MustParsePrefix("fc00::/7"),
+	netip.MustParsePrefix("fe80::/10"),
+	netip.MustParsePrefix("ff00::/8"),
+}
+
+// Blocked reports whether addr is in a blocked range. IPv4-mapped IPv6 addresses are checked
+// as the IPv4 address they map.
+func Blocked(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Resolver looks up the addresses of hosts, as a net.Resolver does.
+type Resolver interface {
+	LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error)
+}
+
+// Guard dials public addresses only, unless allowed otherwise.
+type Guard struct {
+	hosts    []string
+	suffixes []string
+	prefixes []netip.Prefix
+	resolver Resolver
+	dialer   *net.Dialer
+}
+
+// New creates a Guard. The allowlist entries are the hosts and addresses reachable even though
+// they are blocked, such as staging hosts: host names, "*." domain wildcards matching their
+// subdomains, IP addresses and CIDR ranges.
+func New(allowlist ...string) (*Guard, error) {
+	g := &Guard{
+		resolver: net.DefaultResolver,
+		dialer:   &net.Dialer{Timeout: defaultDialTimeout, KeepAlive: 30 * time.Second},
+	}
+
+	for _, entry := range allowlist {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+
+		switch {
+		case entry == "":
+			continue
+		case strings.Contains(entry, "/"):
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowlist range %q: %w", entry, err)
+			}
+
+			g.prefixes = append(g.prefixes, prefix.Masked())
+		case strings.HasPrefix(entry, "*."):
+			g.suffixes = append(g.suffixes, entry[1:])
+		default:
+			if addr, err := netip.ParseAddr(entry); err == nil {
+				g.prefixes = append(g.prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+
+				continue
+			}
+
+			g.hosts = append(g.hosts, strings.TrimSuffix(entry, "."))
+		}
+	}
+
+	return g, nil
+}
+
+// WithResolver returns a copy of the guard resolving hosts with r rather than with the system
+// resolver.
+func (g *Guard) WithResolver(r Resolver) *Guard {
+	clone := *g
+	clone.resolver = r
+
+	return &clone
+}
+
+// DialContext resolves the host of addr and connects to the first of its allowed addresses,
+// so that the address checked is the address dialed. Hosts resolving only to blocked addresses
+// fail with a BlockedError. It is meant as the DialContext of an http.Transport, whose every
+// connection, redirects included, it then checks.
+func (g *Guard) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var addrs []netip.Addr
+
+	if ip, err := netip.ParseAddr(host); err == nil {
+		addrs = []netip.Addr{ip}
+	} else {
+		addrs, err = g.resolver.LookupNetIP(ctx, "ip", host)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	allowedHost := g.allowsHost(host)
+
+	var (
+		blocked netip.Addr
+		lastErr error
+	)
+
+	for _, ip := range addrs {
+		ip = ip.Unmap()
+
+		if !allowedHost && !g.allowsAddr(ip) {
+			blocked = ip
+
+			continue
+		}
+
+		conn, err := g.dialer.DialContext(ctx, network, net.JoinHostPort(ip.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+
+		lastErr = err
+	}
+
+	if lastErr != nil {
+		return nil, lastErr
+	}
+
+	return nil, &BlockedError{Host: host, Addr: blocked}
+}
+
+// Check resolves host and fails with a BlockedError when any of its addresses is blocked. It
+// is meant for failing early the requests of clients dialing on their own; since they resolve
+// host again, their connections are to go through DialContext, or a Proxy, all the same.
+func (g *Guard) Check(ctx context.Context, host string) error {
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+	if g.allowsHost(host) {
+		return nil
+	}
+
+	var addrs []netip.Addr
+
+	if ip, err := netip.ParseAddr(host); err == nil {
+		addrs = []netip.Addr{ip}
+	} else {
+		addrs, err = g.resolver.LookupNetIP(ctx, "ip", host)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, ip := range addrs {
+		if ip = ip.Unmap(); !g.allowsAddr(ip) {
+			return &BlockedError{Host: host, Addr: ip}
+		}
+	}
+
+	return nil
+}
+
+func (g *Guard) allowsHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+
+	for _, h := range g.hosts {
+		if host == h {
+			return true
+		}
+	}
+
+	for _, suffix := range g.suffixes {
+		if strings.HasSuffix(host, suffix) && len(host) > len(suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *Guard) allowsAddr(addr netip.Addr) bool {
+	for _, prefix := range g.prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return !Blocked(addr)
+}
@@ -0,0 +1,218 @@
+package netguard_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/netguard"
+)
+
+func TestBlocked(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{addr: "93.184.215.14", want: false},
+		{addr: "2606:2800:21f:cb07:6820:80da:af6b:8b2c", want: false},
+		{addr: "10.1.2.3", want: true},
+		{addr: "127.0.0.1", want: true},
+		{addr: "169.254.169.254", want: true},
+		{addr: "100.64.0.1", want: true},
+		{addr: "192.0.2.10", want: true},
+		{addr: "198.51.100.10", want: true},
+		{addr: "203.0.113.10", want: true},
+		{addr: "255.255.255.255", want: true},
+		{addr: "::", want: true},
+		{addr: "::1", want: true},
+		// IPv4-compatible 127.0.0.1.
+		{addr: "::7f00:1", want: true},
+		// IPv4-mapped 10.0.0.1.
+		{addr: "::ffff:10.0.0.1", want: true},
+		{addr: "::ffff:93.184.215.14", want: false},
+		// NAT64 of 127.0.0.1 and 169.254.169.254.
+		{addr: "64:ff9b::7f00:1", want: true},
+		{addr: "64:ff9b::a9fe:a9fe", want: true},
+		{addr: "64:ff9b:1::a00:1", want: true},
+		// 6to4 of 10.0.0.1.
+		{addr: "2002:a00:1::1", want: true},
+		// Teredo.
+		{addr: "2001:0:4136:e378:8000:63bf:3fff:fdd2", want: true},
+		{addr: "100::1", want: true},
+		{addr: "2001:db8::1", want: true},
+		{addr: "fd00::1", want: true},
+		{addr: "fe80::1", want: true},
+		{addr: "ff02::1", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			t.Parallel()
+
+			if got := netguard.Blocked(netip.MustParseAddr(tt.addr)); got != tt.want {
+				t.Errorf("Blocked(%s) = %t, want %t", tt.addr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGuardCheck(t *testing.T) {
+	t.Parallel()
+
+	guard, err := netguard.New("staging.internal", "*.corp.example", "10.1.0.0/16", "fd00::1")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	tests := []struct {
+		host        string
+		wantBlocked bool
+	}{
+		{host: "93.184.215.14"},
+		{host: "staging.internal"},
+		{host: "STAGING.internal."},
+		{host: "build.corp.example"},
+		{host: "10.1.2.3"},
+		{host: "[fd00::1]"},
+		{host: "10.2.0.1", wantBlocked: true},
+		{host: "[fd00::2]", wantBlocked: true},
+		{host: "64:ff9b::a01:203", wantBlocked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			t.Parallel()
+
+			err := guard.Check(context.Background(), tt.host)
+			if got := errors.Is(err, netguard.ErrBlocked); got != tt.wantBlocked {
+				t.Errorf("Check(%s) = %v, want blocked: %t", tt.host, err, tt.wantBlocked)
+			}
+		})
+	}
+}
+
+func TestNewInvalidAllowlist(t *testing.T) {
+	t.Parallel()
+
+	if _, err := netguard.New("10.0.0.0/33"); err == nil {
+		t.Error("New() error = nil, want an error for an invalid range")
+	}
+}
+
+// newRedirectServer redirects /hops/n to /hops/n-1, down to /hops/0, and /to?url= to url.
+func newRedirectServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/to" {
+			http.Redirect(w, r, r.URL.Query().Get("url"), http.StatusFound)
+
+			return
+		}
+
+		n, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/hops/"))
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		if n > 0 {
+			http.Redirect(w, r, "/hops/"+strconv.Itoa(n-1), http.StatusFound)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	srv := newRedirectServer(t)
+	_, port, _ := net.SplitHostPort(srv.Listener.Addr().String())
+
+	// The loopback server is reachable as localhost only, not by its address.
+	guard, err := netguard.New("localhost")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	base := "http://localhost:" + port
+
+	tests := []struct {
+		name    string
+		guard   *netguard.Guard
+		url     string
+		wantErr error
+	}{
+		{name: "no redirect", guard: guard, url: base + "/hops/0"},
+		{name: "as many redirects as followed", guard: guard, url: base + "/hops/3"},
+		{name: "too many redirects", guard: guard, url: base + "/hops/4", wantErr: netguard.ErrTooManyRedirects},
+		{name: "redirect to another scheme", guard: guard, url: base + "/to?url=ftp://localhost/", wantErr: netguard.ErrSchemeNotAllowed},
+		{name: "redirect to a blocked address", guard: guard, url: base + "/to?url=" + srv.URL, wantErr: netguard.ErrBlocked},
+		{name: "without a guard", url: srv.URL + "/hops/0", wantErr: netguard.ErrBlocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := netguard.NewClient(tt.guard, 3)
+
+			resp, err := client.Get(tt.url)
+			if err == nil {
+				defer resp.Body.Close()
+			}
+
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Get(%s) error = %v, want none", tt.url, err)
+				}
+
+				if resp.StatusCode != http.StatusOK {
+					t.Errorf("Get(%s) status = %d, want %d", tt.url, resp.StatusCode, http.StatusOK)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Get(%s) error = %v, want %v", tt.url, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewClientTransportOptions(t *testing.T) {
+	t.Parallel()
+
+	client := netguard.NewClient(nil, 0, func(transport *http.Transport) {
+		transport.MaxConnsPerHost = 4
+	})
+
+	transport, ok := client.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Transport = %T, want *http.Transport", client.Transport)
+	}
+
+	if transport.MaxConnsPerHost != 4 {
+		t.Errorf("MaxConnsPerHost = %d, want 4", transport.MaxConnsPerHost)
+	}
+
+	if transport.Proxy != nil {
+		t.Error("Proxy is set, want direct connections")
+	}
+}
@@ -0,0 +1,169 @@
+package netguard
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// hopHeaders are the headers of a connection, not forwarded by a proxy.
+var hopHeaders = []string{
+	"Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Proxy-Connection",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// Proxy is a forward HTTP proxy dialing through a guard, for the clients resolving and dialing
+// hosts on their own, such as browsers. Unlike a Check ahead of their requests, which a host
+// rebinding its name may pass and still be dialed at a blocked address, the proxy checks the
+// very address it connects to. It forwards plain HTTP requests and tunnels CONNECT ones.
+type Proxy struct {
+	guard     *Guard
+	transport *http.Transport
+}
+
+// NewProxy returns a proxy dialing through guard, or through a guard blocking every non-public
+// address when it is nil.
+func NewProxy(guard *Guard) *Proxy {
+	if guard == nil {
+		guard, _ = New()
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = guard.DialContext
+	// The responses are relayed as encoded by the targets.
+	transport.DisableCompression = true
+
+	return &Proxy{guard: guard, transport: transport}
+}
+
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodConnect {
+		p.tunnel(w, r)
+
+		return
+	}
+
+	if r.URL.Scheme != "http" || r.URL.Host == "" {
+		http.Error(w, "only absolute http URLs are proxied", http.StatusBadRequest)
+
+		return
+	}
+
+	out := r.Clone(r.Context())
+	out.RequestURI = ""
+	removeHopHeaders(out.Header)
+
+	resp, err := p.transport.RoundTrip(out)
+	if err != nil {
+		proxyError(w, err)
+
+		return
+	}
+	defer resp.Body.Close()
+
+	removeHopHeaders(resp.Header)
+
+	for key, values := range resp.Header {
+		w.Header()[key] = values
+	}
+
+	w.WriteHeader(resp.StatusCode)
+	_, _ = io.Copy(w, resp.Body)
+}
+
+// tunnel connects the client to the host of r, then relays their bytes both ways.
+func (p *Proxy) tunnel(w http.ResponseWriter, r *http.Request) {
+	target, err := p.guard.DialContext(r.Context(), "tcp", r.Host)
+	if err != nil {
+		proxyError(w, err)
+
+		return
+	}
+	defer target.Close()
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "tunnels are not supported", http.StatusInternalServerError)
+
+		return
+	}
+
+	client, buffered, err := hijacker.Hijack()
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	if _, err := io.WriteString(client, "HTTP/1.1 200 Connection Established\r\n\r\n"); err != nil {
+		return
+	}
+
+	// The client may have sent the start of the tunneled stream along with the request.
+	if n := buffered.Reader.Buffered(); n > 0 {
+		head, _ := buffered.Reader.Peek(n)
+		if _, err := target.Write(head); err != nil {
+			return
+		}
+	}
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		_, _ = io.Copy(target, client)
+		closeWrite(target)
+	}()
+
+	_, _ = io.Copy(client, target)
+	closeWrite(client)
+
+	wg.Wait()
+}
+
+func removeHopHeaders(header http.Header) {
+	for _, field := range header.Values("Connection") {
+		for _, name := range strings.Split(field, ",") {
+			header.Del(strings.TrimSpace(name))
+		}
+	}
+
+	for _, name := range hopHeaders {
+		header.Del(name)
+	}
+}
+
+func closeWrite(conn net.Conn) {
+	if c, ok := conn.(interface{ CloseWrite() error }); ok {
+		_ = c.CloseWrite()
+
+		return
+	}
+
+	_ = conn.Close()
+}
+
+// proxyError answers the requests to blocked addresses as forbidden, and those whose target
+// failed as a bad gateway.
+func proxyError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrBlocked) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+
+		return
+	}
+
+	http.Error(w, err.Error(), http.StatusBadGateway)
+}
@@ -0,0 +1,96 @@
+package netguard_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/netguard"
+)
+
+func TestProxy(t *testing.T) {
+	t.Parallel()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, "served")
+	})
+
+	site := httptest.NewServer(handler)
+	t.Cleanup(site.Close)
+
+	tlsSite := httptest.NewTLSServer(handler)
+	t.Cleanup(tlsSite.Close)
+
+	guard, err := netguard.New("127.0.0.1")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	allowed := httptest.NewServer(netguard.NewProxy(guard))
+	t.Cleanup(allowed.Close)
+
+	blocked := httptest.NewServer(netguard.NewProxy(nil))
+	t.Cleanup(blocked.Close)
+
+	tests := []struct {
+		name       string
+		proxy      string
+		url        string
+		wantStatus int
+	}{
+		{name: "forwarded", proxy: allowed.URL, url: site.URL, wantStatus: http.StatusOK},
+		{name: "tunneled", proxy: allowed.URL, url: tlsSite.URL, wantStatus: http.StatusOK},
+		{name: "forwarding blocked", proxy: blocked.URL, url: site.URL, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			proxyURL, err := url.Parse(tt.proxy)
+			if err != nil {
+				t.Fatalf("url.Parse(%s) error = %v", tt.proxy, err)
+			}
+
+			// The client of the TLS site trusts its certificate.
+			transport := tlsSite.Client().Transport.(*http.Transport).Clone()
+			transport.Proxy = http.ProxyURL(proxyURL)
+
+			client := &http.Client{Transport: transport}
+
+			resp, err := client.Get(tt.url)
+			if err != nil {
+				t.Fatalf("Get(%s) error = %v", tt.url, err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("Get(%s) status = %d, want %d", tt.url, resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestProxyTunnelBlocked(t *testing.T) {
+	t.Parallel()
+
+	site := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(site.Close)
+
+	proxy := httptest.NewServer(netguard.NewProxy(nil))
+	t.Cleanup(proxy.Close)
+
+	proxyURL, _ := url.Parse(proxy.URL)
+	client := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)}}
+
+	resp, err := client.Get(site.URL)
+	if err == nil {
+		resp.Body.Close()
+
+		t.Fatalf("Get(%s) error = nil, want the tunnel refused", site.URL)
+	}
+}
@@ -0,0 +1,105 @@
+package paseto
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// PASERK prefixes of the serialised keys, see https://github.com/paseto-standard/paserk.
+const (
+	paserkLocal  = "k4.local."
+	paserkPublic = "k4.public."
+	paserkSecret = "k4.secret."
+	paserkPID    = "k4.pid."
+
+	// pidSize is the size of the BLAKE2b-264 digest of a key ID.
+	pidSize = 33
+)
+
+// ErrInvalidKey is returned when a serialised key cannot be parsed.
+var ErrInvalidKey = errors.New("paseto: invalid key")
+
+// LocalKey is the symmetric key of v4.local tokens.
+type LocalKey [32]byte
+
+// GenerateLocalKey returns a random LocalKey.
+func GenerateLocalKey() (LocalKey, error) {
+	var key LocalKey
+	if _, err := rand.Read(key[:]); err != nil {
+		return key, fmt.Errorf("paseto: generating key: %w", err)
+	}
+
+	return key, nil
+}
+
+// ParseLocalKey parses a key serialised as k4.local.{base64url}.
+func ParseLocalKey(s string) (LocalKey, error) {
+	var key LocalKey
+
+	raw, err := parsePASERK(s, paserkLocal, len(key))
+	if err != nil {
+		return key, err
+	}
+
+	copy(key[:], raw)
+
+	return key, nil
+}
+
+// String serialises the key as k4.local.{base64url}.
+func (k LocalKey) String() string {
+	return paserkLocal + encoding.EncodeToString(k[:])
+}
+
+// ParsePublicKey parses a key serialised as k4.public.{base64url}.
+func ParsePublicKey(s string) (ed25519.PublicKey, error) {
+	raw, err := parsePASERK(s, paserkPublic, ed25519.PublicKeySize)
+	if err != nil {
+		return nil, err
+	}
+
+	return ed25519.PublicKey(raw), nil
+}
+
+// ParseSecretKey parses a key serialised as k4.secret.{base64url}.
+func ParseSecretKey(s string) (ed25519.PrivateKey, error) {
+	raw, err := parsePASERK(s, paserkSecret, ed25519.PrivateKeySize)
+	if err != nil {
+		return nil, err
+	}
+
+	return ed25519.PrivateKey(raw), nil
+}
+
+// FormatPublicKey serialises key as k4.public.{base64url}.
+func FormatPublicKey(key ed25519.PublicKey) string {
+	return paserkPublic + encoding.EncodeToString(key)
+}
+
+// FormatSecretKey serialises key as k4.secret.{base64url}.
+func FormatSecretKey(key ed25519.PrivateKey) string {
+	return paserkSecret + encoding.EncodeToString(key)
+}
+
+// PublicKeyID returns the PASERK identifier of key, k4.pid.{base64url}. It is a digest of the
+// key, fit to name it in the footer of the tokens it signs.
+func PublicKeyID(key ed25519.PublicKey) string {
+	return paserkPID + encoding.EncodeToString(keyedHash(nil, pidSize, []byte(paserkPID), []byte(FormatPublicKey(key))))
+}
+
+func parsePASERK(s, prefix string, size int) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, prefix) {
+		return nil, fmt.Errorf("%w: expected a %s key", ErrInvalidKey, strings.TrimSuffix(prefix, "."))
+	}
+
+	raw, err := encoding.DecodeString(strings.TrimPrefix(s, prefix))
+	if err != nil || len(raw) != size {
+		return nil, fmt.Errorf("%w: expected %d base64url encoded bytes", ErrInvalidKey, size)
+	}
+
+	return raw, nil
+}
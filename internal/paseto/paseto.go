@@ -0,0 +1,235 @@
+// Package paseto implements version 4 of the Platform-Agnostic Security Tokens: v4.public
+// tokens signed with Ed25519 and v4.local tokens encrypted with XChaCha20 and authenticated
+// with keyed BLAKE2b, as specified by https://github.com/paseto-standard/paseto-spec.
+package paseto
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/chacha20"
+)
+
+// Token headers.
+const (
+	HeaderPublic = "v4.public."
+	HeaderLocal  = "v4.local."
+)
+
+const (
+	localNonceSize = 32
+	localTagSize   = 32
+
+	encryptionKeyDomain = "paseto-encryption-key"
+	authKeyDomain       = "paseto-auth-key-for-aead"
+)
+
+var (
+	// ErrMalformed is returned for tokens that do not follow the v4 format.
+	ErrMalformed = errors.New("paseto: malformed token")
+	// ErrUnsupported is returned for tokens of another version or purpose.
+	ErrUnsupported = errors.New("paseto: unsupported token version or purpose")
+	// ErrInvalidSignature is returned when a v4.public signature does not verify.
+	ErrInvalidSignature = errors.New("paseto: invalid signature")
+	// ErrInvalidTag is returned when a v4.local token fails authentication.
+	ErrInvalidTag = errors.New("paseto: invalid authentication tag")
+)
+
+var encoding = base64.RawURLEncoding
+
+// Sign creates a v4.public token carrying message, signed with key.
+func Sign(key ed25519.PrivateKey, message, footer, implicit []byte) string {
+	sig := ed25519.Sign(key, pae([]byte(HeaderPublic), message, footer, implicit))
+
+	return assemble(HeaderPublic, append(append([]byte(nil), message...), sig...), footer)
+}
+
+// Verify checks the signature of a v4.public token with key and returns its message.
+func Verify(token string, key ed25519.PublicKey, implicit []byte) ([]byte, error) {
+	payload, footer, err := split(token, HeaderPublic)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(payload) < ed25519.SignatureSize {
+		return nil, ErrMalformed
+	}
+
+	message := payload[:len(payload)-ed25519.SignatureSize]
+	sig := payload[len(payload)-ed25519.SignatureSize:]
+
+	if !ed25519.Verify(key, pae([]byte(HeaderPublic), message, footer, implicit), sig) {
+		return nil, ErrInvalidSignature
+	}
+
+	return message, nil
+}
+
+// Encrypt creates a v4.local token carrying message, encrypted with key.
+func Encrypt(key LocalKey, message, footer, implicit []byte) (string, error) {
+	nonce := make([]byte, localNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("paseto: generating nonce: %w", err)
+	}
+
+	return encrypt(key, nonce, message, footer, implicit)
+}
+
+func encrypt(key LocalKey, nonce, message, footer, implicit []byte) (string, error) {
+	encKey, encNonce, authKey := splitLocalKey(key, nonce)
+
+	cipher, err := chacha20.NewUnauthenticatedCipher(encKey, encNonce)
+	if err != nil {
+		return "", fmt.Errorf("paseto: %w", err)
+	}
+
+	ciphertext := make([]byte, len(message))
+	cipher.XORKeyStream(ciphertext, message)
+
+	tag := localTag(authKey, nonce, ciphertext, footer, implicit)
+
+	payload := make([]byte, 0, len(nonce)+len(ciphertext)+len(tag))
+	payload = append(append(append(payload, nonce...), ciphertext...), tag...)
+
+	return assemble(HeaderLocal, payload, footer), nil
+}
+
+// Decrypt authenticates a v4.local token with key and returns its message.
+func Decrypt(token string, key LocalKey, implicit []byte) ([]byte, error) {
+	payload, footer, err := split(token, HeaderLocal)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(payload) < localNonceSize+localTagSize {
+		return nil, ErrMalformed
+	}
+
+	nonce := payload[:localNonceSize]
+	ciphertext := payload[localNonceSize : len(payload)-localTagSize]
+	tag := payload[len(payload)-localTagSize:]
+
+	encKey, encNonce, authKey := splitLocalKey(key, nonce)
+
+	if subtle.ConstantTimeCompare(tag, localTag(authKey, nonce, ciphertext, footer, implicit)) != 1 {
+		return nil, ErrInvalidTag
+	}
+
+	cipher, err := chacha20.NewUnauthenticatedCipher(encKey, encNonce)
+	if err != nil {
+		return nil, fmt.Errorf("paseto: %w", err)
+	}
+
+	message := make([]byte, len(ciphertext))
+	cipher.XORKeyStream(message, ciphertext)
+
+	return message, nil
+}
+
+// Footer returns the decoded footer of token without verifying it, e.g. to pick the key by ID.
+func Footer(token string) ([]byte, error) {
+	parts := strings.Split(token, ".")
+
+	switch len(parts) {
+	case 3:
+		return nil, nil
+	case 4:
+		footer, err := encoding.DecodeString(parts[3])
+		if err != nil {
+			return nil, ErrMalformed
+		}
+
+		return footer, nil
+	default:
+		return nil, ErrMalformed
+	}
+}
+
+// splitLocalKey derives the encryption key, the XChaCha20 nonce and the authentication key
+// of a v4.local token from the shared key and the token nonce.
+func splitLocalKey(key LocalKey, nonce []byte) (encKey, encNonce, authKey []byte) {
+	tmp := keyedHash(key[:], 56, []byte(encryptionKeyDomain), nonce)
+
+	return tmp[:32], tmp[32:], keyedHash(key[:], 32, []byte(authKeyDomain), nonce)
+}
+
+func localTag(authKey, nonce, ciphertext, footer, implicit []byte) []byte {
+	return keyedHash(authKey, localTagSize, pae([]byte(HeaderLocal), nonce, ciphertext, footer, implicit))
+}
+
+func keyedHash(key []byte, size int, data ...[]byte) []byte {
+	h, err := blake2b.New(size, key)
+	if err != nil {
+		// Only reachable with sizes or keys outside of BLAKE2b bounds, which are constants here.
+		panic(err)
+	}
+
+	for _, d := range data {
+		h.Write(d)
+	}
+
+	return h.Sum(nil)
+}
+
+// pae is the Pre-Authentication Encoding of the pieces.
+func pae(pieces ...[]byte) []byte {
+	size := 8
+	for _, p := range pieces {
+		size += 8 + len(p)
+	}
+
+	out := make([]byte, 0, size)
+	out = binary.LittleEndian.AppendUint64(out, uint64(len(pieces)))
+
+	for _, p := range pieces {
+		out = binary.LittleEndian.AppendUint64(out, uint64(len(p)))
+		out = append(out, p...)
+	}
+
+	return out
+}
+
+func assemble(header string, payload, footer []byte) string {
+	token := header + encoding.EncodeToString(payload)
+	if len(footer) > 0 {
+		token += "." + encoding.EncodeToString(footer)
+	}
+
+	return token
+}
+
+// split checks the header of token and decodes its payload and footer.
+func split(token, header string) (payload, footer []byte, err error) {
+	if !strings.HasPrefix(token, header) {
+		// Tokens of another version or purpose still read as "version.purpose.payload".
+		if strings.HasPrefix(token, "v") && strings.Count(token, ".") >= 2 {
+			return nil, nil, ErrUnsupported
+		}
+
+		return nil, nil, ErrMalformed
+	}
+
+	parts := strings.Split(strings.TrimPrefix(token, header), ".")
+	if len(parts) > 2 {
+		return nil, nil, ErrMalformed
+	}
+
+	if payload, err = encoding.DecodeString(parts[0]); err != nil {
+		return nil, nil, ErrMalformed
+	}
+
+	if len(parts) == 2 {
+		if footer, err = encoding.DecodeString(parts[1]); err != nil {
+			return nil, nil, ErrMalformed
+		}
+	}
+
+	return payload, footer, nil
+}
@@ -0,0 +1,380 @@
+package paseto
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// The inputs and tokens of the official test vectors, see
+// https://github.com/paseto-standard/test-vectors/blob/master/v4.json.
+const (
+	vectorLocalKey  = "707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f"
+	vectorSecretKey = "b4cbfb43df4ce210727d953e4a713307fa19bb7d9f85041438d9e11b942a3774" +
+		"1eb9dbbbbc047c03fd70604e0071f0987e16b28b757225c11f00415d0e20b1a2"
+	vectorPublicKey = "1eb9dbbbbc047c03fd70604e0071f0987e16b28b757225c11f00415d0e20b1a2"
+
+	vectorZeroNonce = "0000000000000000000000000000000000000000000000000000000000000000"
+	vectorNonce     = "df654812bac492663825520ba2f6e67cf5ca5bdc13d4e7507a98cc4c2fcc3ad8"
+	vectorNonceKID  = "26f7553354482a1d91d4784627854b8da6b8042a7966523c2b404e8dbbe7f7f2"
+
+	secretMessage = `{"data":"this is a secret message","exp":"2022-01-01T00:00:00+00:00"}`
+	hiddenMessage = `{"data":"this is a hidden message","exp":"2022-01-01T00:00:00+00:00"}`
+	signedMessage = `{"data":"this is a signed message","exp":"2022-01-01T00:00:00+00:00"}`
+
+	kidFooter = `{"kid":"zVhMiPBP9fRf2snEcT7gFTioeA9COcNy9DfgL1W60haN"}`
+)
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf("hex.DecodeString(%q) error = %v", s, err)
+	}
+
+	return b
+}
+
+func vectorKeys(t *testing.T) (LocalKey, ed25519.PrivateKey, ed25519.PublicKey) {
+	t.Helper()
+
+	var local LocalKey
+	copy(local[:], mustHex(t, vectorLocalKey))
+
+	return local, ed25519.PrivateKey(mustHex(t, vectorSecretKey)), ed25519.PublicKey(mustHex(t, vectorPublicKey))
+}
+
+func TestV4LocalVectors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		nonce    string
+		payload  string
+		footer   string
+		implicit string
+		token    string
+	}{
+		{
+			name:    "4-E-1",
+			nonce:   vectorZeroNonce,
+			payload: secretMessage,
+			token:   "v4.local.AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAr68PS4AXe7If_ZgesdkUMvSwscFlAl1pk5HC0e8kApeaqMfGo_7OpBnwJOAbY9V7WU6abu74MmcUE8YWAiaArVI8XJ5hOb_4v9RmDkneN0S92dx0OW4pgy7omxgf3S8c3LlQg",
+		},
+		{
+			name:    "4-E-2",
+			nonce:   vectorZeroNonce,
+			payload: hiddenMessage,
+			token:   "v4.local.AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAr68PS4AXe7If_ZgesdkUMvS2csCgglvpk5HC0e8kApeaqMfGo_7OpBnwJOAbY9V7WU6abu74MmcUE8YWAiaArVI8XIemu9chy3WVKvRBfg6t8wwYHK0ArLxxfZP73W_vfwt5A",
+		},
+		{
+			name:    "4-E-3",
+			nonce:   vectorNonce,
+			payload: secretMessage,
+			token:   "v4.local.32VIErrEkmY4JVILovbmfPXKW9wT1OdQepjMTC_MOtjA4kiqw7_tcaOM5GNEcnTxl60WkwMsYXw6FSNb_UdJPXjpzm0KW9ojM5f4O2mRvE2IcweP-PRdoHjd5-RHCiExR1IK6t6-tyebyWG6Ov7kKvBdkrrAJ837lKP3iDag2hzUPHuMKA",
+		},
+		{
+			name:    "4-E-4",
+			nonce:   vectorNonce,
+			payload: hiddenMessage,
+			token:   "v4.local.32VIErrEkmY4JVILovbmfPXKW9wT1OdQepjMTC_MOtjA4kiqw7_tcaOM5GNEcnTxl60WiA8rd3wgFSNb_UdJPXjpzm0KW9ojM5f4O2mRvE2IcweP-PRdoHjd5-RHCiExR1IK6t4gt6TiLm55vIH8c_lGxxZpE3AWlH4WTR0v45nsWoU3gQ",
+		},
+		{
+			name:    "4-E-5",
+			nonce:   vectorNonceKID,
+			payload: secretMessage,
+			footer:  kidFooter,
+			token:   "v4.local.JvdVM1RIKh2R1HhGJ4VLjaa4BCp5ZlI8K0BOjbvn9_L6qU34Aj806z9BHW68MiMIOL-WkS5pimduKSmcwEtx3ksEnMJnnMvZUScQKTvmZyxuKxT3L9IjiRh_2vdM-ac-tvG3LB4SjEkrlyKT9n2hlPhtLNi1CB9fsgS12-7n9paSy5-VNA.eyJraWQiOiJ6VmhNaVBCUDlmUmYyc25FY1Q3Z0ZUaW9lQTlDT2NOeTlEZmdMMVc2MGhhTiJ9",
+		},
+		{
+			name:    "4-E-6",
+			nonce:   vectorNonceKID,
+			payload: hiddenMessage,
+			footer:  kidFooter,
+			token:   "v4.local.JvdVM1RIKh2R1HhGJ4VLjaa4BCp5ZlI8K0BOjbvn9_L6qU34Aj806z9BHW68MiMIOL-WiiJunGd0KSmcwEtx3ksEnMJnnMvZUScQKTvmZyxuKxT3L9IjiRh_2vdM-ac-tvG3LB5ozczLb9MElasx3uIJ8cWCoKb4fN5i_xeLm5gnDzBytQ.eyJraWQiOiJ6VmhNaVBCUDlmUmYyc25FY1Q3Z0ZUaW9lQTlDT2NOeTlEZmdMMVc2MGhhTiJ9",
+		},
+		{
+			name:     "4-E-7",
+			nonce:    vectorNonceKID,
+			payload:  secretMessage,
+			footer:   kidFooter,
+			implicit: `{"test-vector":"4-E-7"}`,
+			token:    "v4.local.JvdVM1RIKh2R1HhGJ4VLjaa4BCp5ZlI8K0BOjbvn9_L6qU34Aj806z9BHW68MiMIOL-WkS5pimduKSmcwEtx3ksEnMJnnMvZUScQKTvmZyxuKxT3L9IjiRh_2vdM-ac-tvG3LB64Pgn6u99CSqTpbb91bQLjP2PLVowa4BWIJ54bpxlIoQ.eyJraWQiOiJ6VmhNaVBCUDlmUmYyc25FY1Q3Z0ZUaW9lQTlDT2NOeTlEZmdMMVc2MGhhTiJ9",
+		},
+		{
+			name:     "4-E-8",
+			nonce:    vectorNonceKID,
+			payload:  hiddenMessage,
+			footer:   kidFooter,
+			implicit: `{"test-vector":"4-E-8"}`,
+			token:    "v4.local.JvdVM1RIKh2R1HhGJ4VLjaa4BCp5ZlI8K0BOjbvn9_L6qU34Aj806z9BHW68MiMIOL-WiiJunGd0KSmcwEtx3ksEnMJnnMvZUScQKTvmZyxuKxT3L9IjiRh_2vdM-ac-tvG3LB5u0uIyC1GjzGidJQE-sOJDoBPmaOC5JJoUBG142uj_rQ.eyJraWQiOiJ6VmhNaVBCUDlmUmYyc25FY1Q3Z0ZUaW9lQTlDT2NOeTlEZmdMMVc2MGhhTiJ9",
+		},
+		{
+			name:     "4-E-9",
+			nonce:    vectorNonceKID,
+			payload:  hiddenMessage,
+			footer:   "arbitrary-string-that-isn't-json",
+			implicit: `{"test-vector":"4-E-9"}`,
+			token:    "v4.local.JvdVM1RIKh2R1HhGJ4VLjaa4BCp5ZlI8K0BOjbvn9_L6qU34Aj806z9BHW68MiMIOL-WiiJunGd0KSmcwEtx3ksEnMJnnMvZUScQKTvmZyxuKxT3L9IjiRh_2vdM-ac-tvG3LB6sb_p-PWZXBxTiDXqMtlpfbH5t4p0xFrWajPPCnKCHUw.YXJiaXRyYXJ5LXN0cmluZy10aGF0LWlzbid0LWpzb24",
+		},
+	}
+
+	key, _, _ := vectorKeys(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := encrypt(key, mustHex(t, tt.nonce), []byte(tt.payload), []byte(tt.footer), []byte(tt.implicit))
+			if err != nil {
+				t.Fatalf("encrypt() error = %v", err)
+			}
+
+			if token != tt.token {
+				t.Errorf("encrypt() = %s, want %s", token, tt.token)
+			}
+
+			payload, err := Decrypt(tt.token, key, []byte(tt.implicit))
+			if err != nil {
+				t.Fatalf("Decrypt() error = %v", err)
+			}
+
+			if string(payload) != tt.payload {
+				t.Errorf("Decrypt() = %s, want %s", payload, tt.payload)
+			}
+
+			footer, err := Footer(tt.token)
+			if err != nil || string(footer) != tt.footer {
+				t.Errorf("Footer() = %q, %v, want %q", footer, err, tt.footer)
+			}
+		})
+	}
+}
+
+func TestV4PublicVectors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		footer   string
+		implicit string
+		token    string
+	}{
+		{
+			name:  "4-S-1",
+			token: "v4.public.eyJkYXRhIjoidGhpcyBpcyBhIHNpZ25lZCBtZXNzYWdlIiwiZXhwIjoiMjAyMi0wMS0wMVQwMDowMDowMCswMDowMCJ9bg_XBBzds8lTZShVlwwKSgeKpLT3yukTw6JUz3W4h_ExsQV-P0V54zemZDcAxFaSeef1QlXEFtkqxT1ciiQEDA",
+		},
+		{
+			name:   "4-S-2",
+			footer: kidFooter,
+			token:  "v4.public.eyJkYXRhIjoidGhpcyBpcyBhIHNpZ25lZCBtZXNzYWdlIiwiZXhwIjoiMjAyMi0wMS0wMVQwMDowMDowMCswMDowMCJ9v3Jt8mx_TdM2ceTGoqwrh4yDFn0XsHvvV_D0DtwQxVrJEBMl0F2caAdgnpKlt4p7xBnx1HcO-SPo8FPp214HDw.eyJraWQiOiJ6VmhNaVBCUDlmUmYyc25FY1Q3Z0ZUaW9lQTlDT2NOeTlEZmdMMVc2MGhhTiJ9",
+		},
+		{
+			name:     "4-S-3",
+			footer:   kidFooter,
+			implicit: `{"test-vector":"4-S-3"}`,
+			token:    "v4.public.eyJkYXRhIjoidGhpcyBpcyBhIHNpZ25lZCBtZXNzYWdlIiwiZXhwIjoiMjAyMi0wMS0wMVQwMDowMDowMCswMDowMCJ9NPWciuD3d0o5eXJXG5pJy-DiVEoyPYWs1YSTwWHNJq6DZD3je5gf-0M4JR9ipdUSJbIovzmBECeaWmaqcaP0DQ.eyJraWQiOiJ6VmhNaVBCUDlmUmYyc25FY1Q3Z0ZUaW9lQTlDT2NOeTlEZmdMMVc2MGhhTiJ9",
+		},
+	}
+
+	_, secret, public := vectorKeys(t)
+
+	if got := secret.Public().(ed25519.PublicKey); !bytes.Equal(got, public) {
+		t.Fatalf("public key of the vectors = %x, want %x", got, public)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if token := Sign(secret, []byte(signedMessage), []byte(tt.footer), []byte(tt.implicit)); token != tt.token {
+				t.Errorf("Sign() = %s, want %s", token, tt.token)
+			}
+
+			payload, err := Verify(tt.token, public, []byte(tt.implicit))
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+
+			if string(payload) != signedMessage {
+				t.Errorf("Verify() = %s, want %s", payload, signedMessage)
+			}
+		})
+	}
+}
+
+// tamper flips a bit of the byte at index of the payload of token, counting from the end when
+// negative.
+func tamper(t *testing.T, token, header string, index int) string {
+	t.Helper()
+
+	payload, footer, err := split(token, header)
+	if err != nil {
+		t.Fatalf("split() error = %v", err)
+	}
+
+	if index < 0 {
+		index += len(payload)
+	}
+
+	payload[index] ^= 0x01
+
+	return assemble(header, payload, footer)
+}
+
+// withFooter replaces the footer of token.
+func withFooter(t *testing.T, token, header, footer string) string {
+	t.Helper()
+
+	payload, _, err := split(token, header)
+	if err != nil {
+		t.Fatalf("split() error = %v", err)
+	}
+
+	return assemble(header, payload, []byte(footer))
+}
+
+func TestDecryptRejects(t *testing.T) {
+	t.Parallel()
+
+	key, secret, _ := vectorKeys(t)
+
+	var otherKey LocalKey
+	copy(otherKey[:], key[:])
+	otherKey[0] ^= 0x01
+
+	implicit := []byte(`{"test-vector":"4-E-7"}`)
+
+	token, err := encrypt(key, mustHex(t, vectorNonceKID), []byte(secretMessage), []byte(kidFooter), implicit)
+	if err != nil {
+		t.Fatalf("encrypt() error = %v", err)
+	}
+
+	parts := strings.Split(token, ".")
+	parts[2] += "="
+	padded := strings.Join(parts, ".")
+
+	tests := []struct {
+		name     string
+		token    string
+		key      LocalKey
+		implicit []byte
+		wantErr  error
+	}{
+		{name: "wrong key", token: token, key: otherKey, implicit: implicit, wantErr: ErrInvalidTag},
+		{name: "tampered nonce", token: tamper(t, token, HeaderLocal, 0), key: key, implicit: implicit, wantErr: ErrInvalidTag},
+		{name: "tampered ciphertext", token: tamper(t, token, HeaderLocal, localNonceSize), key: key, implicit: implicit, wantErr: ErrInvalidTag},
+		{name: "tampered tag", token: tamper(t, token, HeaderLocal, -1), key: key, implicit: implicit, wantErr: ErrInvalidTag},
+		{name: "replaced footer", token: withFooter(t, token, HeaderLocal, `{"kid":"other"}`), key: key, implicit: implicit, wantErr: ErrInvalidTag},
+		{name: "stripped footer", token: withFooter(t, token, HeaderLocal, ""), key: key, implicit: implicit, wantErr: ErrInvalidTag},
+		{name: "other implicit assertion", token: token, key: key, implicit: []byte(`{"test-vector":"4-E-8"}`), wantErr: ErrInvalidTag},
+		{name: "missing implicit assertion", token: token, key: key, wantErr: ErrInvalidTag},
+		{name: "public token", token: Sign(secret, []byte(signedMessage), nil, nil), key: key, wantErr: ErrUnsupported},
+		{name: "other version", token: "v3.local." + strings.TrimPrefix(token, HeaderLocal), key: key, wantErr: ErrUnsupported},
+		{name: "padded encoding", token: padded, key: key, implicit: implicit, wantErr: ErrMalformed},
+		{name: "too short", token: HeaderLocal + encoding.EncodeToString(make([]byte, localNonceSize+localTagSize-1)), key: key, wantErr: ErrMalformed},
+		{name: "extra part", token: token + ".extra", key: key, implicit: implicit, wantErr: ErrMalformed},
+		{name: "not a token", token: "local", key: key, wantErr: ErrMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			payload, err := Decrypt(tt.token, tt.key, tt.implicit)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Decrypt() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if payload != nil {
+				t.Errorf("Decrypt() = %s, want nil", payload)
+			}
+		})
+	}
+}
+
+func TestVerifyRejects(t *testing.T) {
+	t.Parallel()
+
+	key, secret, public := vectorKeys(t)
+
+	otherPublic, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+
+	implicit := []byte(`{"test-vector":"4-S-3"}`)
+	token := Sign(secret, []byte(signedMessage), []byte(kidFooter), implicit)
+
+	local, err := Encrypt(key, []byte(secretMessage), nil, nil)
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		token    string
+		key      ed25519.PublicKey
+		implicit []byte
+		wantErr  error
+	}{
+		{name: "wrong key", token: token, key: otherPublic, implicit: implicit, wantErr: ErrInvalidSignature},
+		{name: "tampered message", token: tamper(t, token, HeaderPublic, 0), key: public, implicit: implicit, wantErr: ErrInvalidSignature},
+		{name: "tampered signature", token: tamper(t, token, HeaderPublic, -1), key: public, implicit: implicit, wantErr: ErrInvalidSignature},
+		{name: "replaced footer", token: withFooter(t, token, HeaderPublic, `{"kid":"other"}`), key: public, implicit: implicit, wantErr: ErrInvalidSignature},
+		{name: "stripped footer", token: withFooter(t, token, HeaderPublic, ""), key: public, implicit: implicit, wantErr: ErrInvalidSignature},
+		{name: "other implicit assertion", token: token, key: public, implicit: []byte(`{"test-vector":"4-S-2"}`), wantErr: ErrInvalidSignature},
+		{name: "missing implicit assertion", token: token, key: public, wantErr: ErrInvalidSignature},
+		{name: "local token", token: local, key: public, wantErr: ErrUnsupported},
+		{name: "other version", token: "v2.public." + strings.TrimPrefix(token, HeaderPublic), key: public, wantErr: ErrUnsupported},
+		{name: "shorter than a signature", token: HeaderPublic + encoding.EncodeToString(make([]byte, ed25519.SignatureSize-1)), key: public, wantErr: ErrMalformed},
+		{name: "invalid encoding", token: HeaderPublic + "not*base64", key: public, wantErr: ErrMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			payload, err := Verify(tt.token, tt.key, tt.implicit)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if payload != nil {
+				t.Errorf("Verify() = %s, want nil", payload)
+			}
+		})
+	}
+}
+
+func TestEncryptRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	key, err := GenerateLocalKey()
+	if err != nil {
+		t.Fatalf("GenerateLocalKey() error = %v", err)
+	}
+
+	first, err := Encrypt(key, []byte(secretMessage), []byte(kidFooter), nil)
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+
+	second, err := Encrypt(key, []byte(secretMessage), []byte(kidFooter), nil)
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+
+	if first == second {
+		t.Error("Encrypt() returned the same token twice, want a fresh nonce per token")
+	}
+
+	payload, err := Decrypt(first, key, nil)
+	if err != nil || string(payload) != secretMessage {
+		t.Errorf("Decrypt() = %s, %v, want %s", payload, err, secretMessage)
+	}
+}
@@ -0,0 +1,300 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const (
+	contentTypeJSON = "application/json"
+
+	retrySuffix      = ".retry"
+	deadLetterSuffix = ".dead"
+
+	headerError = "x-error"
+)
+
+// Channel is the subset of *amqp.Channel used by AMQPQueue. It allows the queue to run
+// against an in-memory broker stand-in, such as brokertest.Broker.
+type Channel interface {
+	QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (amqp.Queue, error)
+	Qos(prefetchCount, prefetchSize int, global bool) error
+	PublishWithContext(ctx context.Context, exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
+	Consume(queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (<-chan amqp.Delivery, error)
+	IsClosed() bool
+	Close() error
+}
+
+// AMQPQueue is a Queue backed by an AMQP 0-9-1 broker such as RabbitMQ.
+//
+// Jobs are acknowledged once handled, so the broker redelivers the jobs of a consumer that
+// dies mid-flight. Failed jobs are republished to a retry queue with a per-message TTL, which
+// dead-letters them back into the main queue once the backoff elapsed. Permanently failed
+// jobs are parked on a dead-letter queue.
+type AMQPQueue struct {
+	ch   Channel
+	conn *amqp.Connection
+	name string
+	opts options
+
+	// publishMu serialises publishing, since AMQP channels are not safe for concurrent use.
+	publishMu sync.Mutex
+}
+
+var _ Queue = (*AMQPQueue)(nil)
+
+// DialAMQP connects to the broker at url and declares the topology of the named queue.
+func DialAMQP(url, name string, opts ...Option) (*AMQPQueue, error) {
+	conn, err := amqp.Dial(url)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to broker: %w", err)
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, fmt.Errorf("opening channel: %w", err)
+	}
+
+	q, err := NewAMQP(ch, name, opts...)
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	q.conn = conn
+
+	return q, nil
+}
+
+// NewAMQP creates an AMQPQueue on an open channel and declares the queues it relies on.
+func NewAMQP(ch Channel, name string, opts ...Option) (*AMQPQueue, error) {
+	q := &AMQPQueue{
+		ch:   ch,
+		name: name,
+		opts: newOptions(opts),
+	}
+
+	if err := q.declare(); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+func (q *AMQPQueue) declare() error {
+	queues := []struct {
+		name string
+		args amqp.Table
+	}{
+		{name: q.name},
+		{name: q.name + retrySuffix, args: amqp.Table{
+			"x-dead-letter-exchange":    "",
+			"x-dead-letter-routing-key": q.name,
+		}},
+		{name: q.name + deadLetterSuffix},
+	}
+
+	for _, decl := range queues {
+		if _, err := q.ch.QueueDeclare(decl.name, true, false, false, false, decl.args); err != nil {
+			return fmt.Errorf("declaring queue %s: %w", decl.name, err)
+		}
+	}
+
+	return nil
+}
+
+// Publish sends job to the broker as a persistent message.
+func (q *AMQPQueue) Publish(ctx context.Context, job Job) error {
+	if job.Attempt == 0 {
+		job.Attempt = 1
+	}
+
+	if job.EnqueuedAt.IsZero() {
+		job.EnqueuedAt = time.Now().UTC()
+	}
+
+	return q.publish(ctx, q.name, job, amqp.Table{}, 0)
+}
+
+func (q *AMQPQueue) publish(ctx context.Context, key string, job Job, headers amqp.Table, delay time.Duration) error {
+	body, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("encoding job: %w", err)
+	}
+
+	msg := amqp.Publishing{
+		Headers:      headers,
+		ContentType:  contentTypeJSON,
+		DeliveryMode: amqp.Persistent,
+		MessageId:    job.AnalysisID.String(),
+		Timestamp:    time.Now().UTC(),
+		Body:         body,
+	}
+
+	if delay > 0 {
+		msg.Expiration = strconv.FormatInt(max(delay.Milliseconds(), 1), 10)
+	}
+
+	q.publishMu.Lock()
+	defer q.publishMu.Unlock()
+
+	if q.ch.IsClosed() {
+		return ErrClosed
+	}
+
+	if err := q.ch.PublishWithContext(ctx, "", key, false, false, msg); err != nil {
+		return fmt.Errorf("publishing job: %w", err)
+	}
+
+	return nil
+}
+
+// Consume delivers jobs to h until ctx is cancelled. It fails when the broker closes the
+// delivery channel, e.g. on connection loss.
+func (q *AMQPQueue) Consume(ctx context.Context, h Handler) error {
+	if err := q.ch.Qos(q.opts.workers, 0, false); err != nil {
+		return fmt.Errorf("setting prefetch: %w", err)
+	}
+
+	deliveries, err := q.ch.Consume(q.name, "", false, false, false, false, nil)
+	if err != nil {
+		return fmt.Errorf("consuming %s: %w", q.name, err)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		lostOnce sync.Once
+		lost     error
+	)
+
+	for range q.opts.workers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case d, ok := <-deliveries:
+					if !ok {
+						lostOnce.Do(func() {
+							lost = fmt.Errorf("consuming %s: %w", q.name, ErrClosed)
+						})
+
+						return
+					}
+
+					q.process(ctx, h, d)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return lost
+}
+
+func (q *AMQPQueue) process(ctx context.Context, h Handler, d amqp.Delivery) {
+	var job Job
+	if err := json.Unmarshal(d.Body, &job); err != nil {
+		// A message that cannot be decoded never will be; park it for inspection.
+		q.opts.logger.Error("undecodable job dead-lettered", slog.String("message_id", d.MessageId), slog.Any("error", err))
+		q.settle(d, q.publishRaw(ctx, d, err))
+
+		return
+	}
+
+	err := handle(ctx, h, job, q.opts.visibilityTimeout)
+	if err == nil {
+		q.settle(d, nil)
+
+		return
+	}
+
+	if q.opts.retry.ShouldRetry(job.Attempt, err) {
+		backoff := q.opts.retry.Backoff(job.Attempt)
+		q.opts.logger.Info("job scheduled for retry", jobAttrs(job), slog.Duration("backoff", backoff), slog.Any("error", err))
+
+		retry := job
+		retry.Attempt++
+		q.settle(d, q.publish(context.WithoutCancel(ctx), q.name+retrySuffix, retry, amqp.Table{headerError: err.Error()}, backoff))
+
+		return
+	}
+
+	q.opts.logger.Warn("job dead-lettered", jobAttrs(job), slog.Any("error", err))
+
+	published := q.publish(context.WithoutCancel(ctx), q.name+deadLetterSuffix, job, amqp.Table{headerError: err.Error()}, 0)
+	if published == nil {
+		deadLetter(ctx, h, job, err)
+	}
+
+	q.settle(d, published)
+}
+
+// settle acknowledges the delivery once its follow-up message was published. When publishing
+// failed, the delivery is requeued so that the job is not lost.
+func (q *AMQPQueue) settle(d amqp.Delivery, published error) {
+	var err error
+	if published != nil {
+		q.opts.logger.Error("requeueing job", slog.String("message_id", d.MessageId), slog.Any("error", published))
+		err = d.Nack(false, true)
+	} else {
+		err = d.Ack(false)
+	}
+
+	if err != nil {
+		q.opts.logger.Error("settling delivery", slog.String("message_id", d.MessageId), slog.Any("error", err))
+	}
+}
+
+func (q *AMQPQueue) publishRaw(ctx context.Context, d amqp.Delivery, cause error) error {
+	q.publishMu.Lock()
+	defer q.publishMu.Unlock()
+
+	return q.ch.PublishWithContext(context.WithoutCancel(ctx), "", q.name+deadLetterSuffix, false, false, amqp.Publishing{
+		Headers:      amqp.Table{headerError: cause.Error()},
+		ContentType:  d.ContentType,
+		DeliveryMode: amqp.Persistent,
+		MessageId:    d.MessageId,
+		Timestamp:    time.Now().UTC(),
+		Body:         d.Body,
+	})
+}
+
+// Ping fails when the channel or the connection to the broker is closed.
+func (q *AMQPQueue) Ping(_ context.Context) error {
+	if q.ch.IsClosed() || (q.conn != nil && q.conn.IsClosed()) {
+		return ErrClosed
+	}
+
+	return nil
+}
+
+// Close closes the channel and, when the queue dialed it, the connection.
+func (q *AMQPQueue) Close() error {
+	err := q.ch.Close()
+	if q.conn != nil {
+		err = errors.Join(err, q.conn.Close())
+	}
+
+	if errors.Is(err, amqp.ErrClosed) {
+		return nil
+	}
+
+	return err
+}
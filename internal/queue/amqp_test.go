@@ -0,0 +1,444 @@
+package queue_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"github.com/architeacher/svc-web-analyzer/internal/queue"
+	"github.com/architeacher/svc-web-analyzer/internal/queue/brokertest"
+)
+
+const queueName = "analyses"
+
+// waitTimeout bounds every wait of the tests, which otherwise complete within milliseconds.
+const waitTimeout = 5 * time.Second
+
+// recordingHandler answers the deliveries with the errors of fail, in turn, and records the
+// jobs it handled and dead-lettered.
+type recordingHandler struct {
+	fail func(job queue.Job) error
+
+	handled      chan queue.Job
+	deadLettered chan deadLettered
+}
+
+type deadLettered struct {
+	job queue.Job
+	err error
+}
+
+func newRecordingHandler(fail func(job queue.Job) error) *recordingHandler {
+	return &recordingHandler{
+		fail:         fail,
+		handled:      make(chan queue.Job, 16),
+		deadLettered: make(chan deadLettered, 16),
+	}
+}
+
+func (h *recordingHandler) Handle(_ context.Context, job queue.Job) error {
+	h.handled <- job
+
+	if h.fail == nil {
+		return nil
+	}
+
+	return h.fail(job)
+}
+
+func (h *recordingHandler) DeadLetter(_ context.Context, job queue.Job, err error) {
+	h.deadLettered <- deadLettered{job: job, err: err}
+}
+
+func receive[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(waitTimeout):
+		t.Fatal("timed out waiting for the queue")
+
+		var zero T
+
+		return zero
+	}
+}
+
+// eventually waits for cond to hold.
+func eventually(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(waitTimeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// startConsumer consumes q until the test ends, and returns the error Consume returned.
+func startConsumer(t *testing.T, q queue.Queue, h queue.Handler) <-chan error {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+
+	go func() {
+		done <- q.Consume(ctx, h)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	return done
+}
+
+func newAMQPQueue(t *testing.T, broker *brokertest.Broker, opts ...queue.Option) *queue.AMQPQueue {
+	t.Helper()
+
+	q, err := queue.NewAMQP(broker, queueName, append([]queue.Option{
+		queue.WithWorkers(2),
+		queue.WithRetryPolicy(queue.RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: 10 * time.Millisecond,
+			MaxBackoff:     10 * time.Millisecond,
+		}),
+	}, opts...)...)
+	if err != nil {
+		t.Fatalf("NewAMQP() error = %v", err)
+	}
+
+	return q
+}
+
+func newJob() queue.Job {
+	return queue.Job{AnalysisID: uuid.New(), URL: "https://example.com/"}
+}
+
+func TestAMQPQueueAcknowledgesHandledJobs(t *testing.T) {
+	t.Parallel()
+
+	broker := brokertest.New()
+	q := newAMQPQueue(t, broker)
+	h := newRecordingHandler(nil)
+
+	startConsumer(t, q, h)
+
+	job := newJob()
+	if err := q.Publish(context.Background(), job); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	got := receive(t, h.handled)
+	if got.AnalysisID != job.AnalysisID || got.URL != job.URL {
+		t.Errorf("handled job = %+v, want %+v", got, job)
+	}
+
+	if got.Attempt != 1 || got.EnqueuedAt.IsZero() {
+		t.Errorf("handled job attempt = %d, enqueued at %v, want the first attempt with its enqueue time", got.Attempt, got.EnqueuedAt)
+	}
+
+	eventually(t, "the acknowledgement", func() bool { return broker.Unacked() == 0 })
+
+	if n := broker.Len(queueName); n != 0 {
+		t.Errorf("%d jobs left in the queue, want 0", n)
+	}
+}
+
+func TestAMQPQueueRetriesThroughTheRetryQueue(t *testing.T) {
+	t.Parallel()
+
+	broker := brokertest.New()
+	q := newAMQPQueue(t, broker)
+	h := newRecordingHandler(func(job queue.Job) error {
+		if job.Attempt == 1 {
+			return errors.New("page unreachable")
+		}
+
+		return nil
+	})
+
+	startConsumer(t, q, h)
+
+	if err := q.Publish(context.Background(), newJob()); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	if first := receive(t, h.handled); first.Attempt != 1 {
+		t.Fatalf("first delivery attempt = %d, want 1", first.Attempt)
+	}
+
+	// The retry is parked on the retry queue until its TTL dead-letters it back.
+	second := receive(t, h.handled)
+	if second.Attempt != 2 {
+		t.Errorf("second delivery attempt = %d, want 2", second.Attempt)
+	}
+
+	eventually(t, "the acknowledgement", func() bool { return broker.Unacked() == 0 })
+
+	for _, name := range []string{queueName, queueName + ".retry", queueName + ".dead"} {
+		if n := broker.Len(name); n != 0 {
+			t.Errorf("%d jobs left in %s, want 0", n, name)
+		}
+	}
+
+	select {
+	case d := <-h.deadLettered:
+		t.Errorf("job dead-lettered after a successful retry: %v", d.err)
+	default:
+	}
+}
+
+func TestAMQPQueueRetryMessagesExpire(t *testing.T) {
+	t.Parallel()
+
+	broker := brokertest.New()
+	q := newAMQPQueue(t, broker, queue.WithRetryPolicy(queue.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Hour,
+		MaxBackoff:     time.Hour,
+	}))
+	h := newRecordingHandler(func(queue.Job) error { return errors.New("page unreachable") })
+
+	startConsumer(t, q, h)
+
+	if err := q.Publish(context.Background(), newJob()); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	receive(t, h.handled)
+	eventually(t, "the retry", func() bool { return broker.Len(queueName+".retry") == 1 })
+
+	retry := broker.Messages(queueName + ".retry")[0]
+	if retry.Expiration == "" {
+		t.Error("retry message has no expiration, it would never return to the queue")
+	}
+
+	if retry.Headers["x-error"] != "page unreachable" {
+		t.Errorf("retry message x-error header = %v, want the error of the attempt", retry.Headers["x-error"])
+	}
+
+	var job queue.Job
+	if err := json.Unmarshal(retry.Body, &job); err != nil || job.Attempt != 2 {
+		t.Errorf("retry message = %s (%v), want the job with its next attempt", retry.Body, err)
+	}
+}
+
+func TestAMQPQueueDeadLettersPermanentFailures(t *testing.T) {
+	t.Parallel()
+
+	broker := brokertest.New()
+	q := newAMQPQueue(t, broker)
+	cause := errors.New("not an html page")
+	h := newRecordingHandler(func(queue.Job) error { return queue.Permanent(cause) })
+
+	startConsumer(t, q, h)
+
+	job := newJob()
+	if err := q.Publish(context.Background(), job); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	receive(t, h.handled)
+
+	dead := receive(t, h.deadLettered)
+	if dead.job.AnalysisID != job.AnalysisID || !errors.Is(dead.err, cause) {
+		t.Errorf("DeadLetter(%s, %v), want (%s, %v)", dead.job.AnalysisID, dead.err, job.AnalysisID, cause)
+	}
+
+	eventually(t, "the dead-lettered job", func() bool { return broker.Len(queueName+".dead") == 1 })
+	eventually(t, "the acknowledgement", func() bool { return broker.Unacked() == 0 })
+
+	if msg := broker.Messages(queueName + ".dead")[0]; msg.Headers["x-error"] != cause.Error() {
+		t.Errorf("dead-lettered x-error header = %v, want %q", msg.Headers["x-error"], cause.Error())
+	}
+
+	if n := broker.Len(queueName + ".retry"); n != 0 {
+		t.Errorf("%d permanent failures retried, want 0", n)
+	}
+}
+
+func TestAMQPQueueDeadLettersOnceAttemptsAreExhausted(t *testing.T) {
+	t.Parallel()
+
+	broker := brokertest.New()
+	q := newAMQPQueue(t, broker)
+	h := newRecordingHandler(func(queue.Job) error { return errors.New("page unreachable") })
+
+	startConsumer(t, q, h)
+
+	if err := q.Publish(context.Background(), newJob()); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	for attempt := 1; attempt <= 3; attempt++ {
+		if got := receive(t, h.handled); got.Attempt != attempt {
+			t.Fatalf("delivery attempt = %d, want %d", got.Attempt, attempt)
+		}
+	}
+
+	if dead := receive(t, h.deadLettered); dead.job.Attempt != 3 {
+		t.Errorf("dead-lettered attempt = %d, want 3", dead.job.Attempt)
+	}
+
+	eventually(t, "the dead-lettered job", func() bool { return broker.Len(queueName+".dead") == 1 })
+}
+
+func TestAMQPQueueParksUndecodableMessages(t *testing.T) {
+	t.Parallel()
+
+	broker := brokertest.New()
+	q := newAMQPQueue(t, broker)
+	h := newRecordingHandler(nil)
+
+	startConsumer(t, q, h)
+
+	if err := broker.PublishWithContext(context.Background(), "", queueName, false, false, amqpPublishing("{not json")); err != nil {
+		t.Fatalf("PublishWithContext() error = %v", err)
+	}
+
+	eventually(t, "the parked message", func() bool { return broker.Len(queueName+".dead") == 1 })
+	eventually(t, "the acknowledgement", func() bool { return broker.Unacked() == 0 })
+
+	if body := string(broker.Messages(queueName + ".dead")[0].Body); body != "{not json" {
+		t.Errorf("parked message body = %q, want the original body", body)
+	}
+
+	select {
+	case job := <-h.handled:
+		t.Errorf("undecodable message handled as %+v", job)
+	default:
+	}
+}
+
+func TestAMQPQueueRedeliversUnacknowledgedJobs(t *testing.T) {
+	t.Parallel()
+
+	broker := brokertest.New()
+	q := newAMQPQueue(t, broker)
+
+	release := make(chan struct{})
+	h := newRecordingHandler(func(queue.Job) error {
+		<-release
+
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- q.Consume(ctx, h)
+	}()
+
+	job := newJob()
+	if err := q.Publish(context.Background(), job); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	receive(t, h.handled)
+
+	// The channel goes away while the job is in flight, as when the worker dies.
+	if err := broker.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	// The acknowledgement of the job finishing afterwards is lost with the channel.
+	close(release)
+
+	if err := receive(t, done); !errors.Is(err, queue.ErrClosed) {
+		t.Errorf("Consume() error = %v, want %v", err, queue.ErrClosed)
+	}
+
+	messages := broker.Messages(queueName)
+	if len(messages) != 1 || messages[0].MessageId != job.AnalysisID.String() {
+		t.Fatalf("queue holds %d messages, want the unacknowledged job back", len(messages))
+	}
+
+	if err := q.Publish(context.Background(), newJob()); !errors.Is(err, queue.ErrClosed) {
+		t.Errorf("Publish() on a closed channel error = %v, want %v", err, queue.ErrClosed)
+	}
+
+	if err := q.Ping(context.Background()); !errors.Is(err, queue.ErrClosed) {
+		t.Errorf("Ping() on a closed channel error = %v, want %v", err, queue.ErrClosed)
+	}
+}
+
+func TestAMQPQueueBoundsJobsInFlight(t *testing.T) {
+	t.Parallel()
+
+	broker := brokertest.New()
+	q := newAMQPQueue(t, broker)
+
+	var (
+		mu       sync.Mutex
+		inFlight int
+		peak     int
+	)
+
+	release := make(chan struct{})
+	h := newRecordingHandler(func(queue.Job) error {
+		mu.Lock()
+		inFlight++
+		peak = max(peak, inFlight)
+		mu.Unlock()
+
+		<-release
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		return nil
+	})
+
+	startConsumer(t, q, h)
+
+	for range 5 {
+		if err := q.Publish(context.Background(), newJob()); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+	}
+
+	receive(t, h.handled)
+	receive(t, h.handled)
+
+	if n := broker.Unacked(); n != 2 {
+		t.Errorf("%d deliveries in flight, want the 2 workers' prefetch", n)
+	}
+
+	if n := broker.Len(queueName); n != 3 {
+		t.Errorf("%d jobs waiting, want 3", n)
+	}
+
+	close(release)
+
+	for range 3 {
+		receive(t, h.handled)
+	}
+
+	eventually(t, "the acknowledgements", func() bool { return broker.Unacked() == 0 })
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if peak > 2 {
+		t.Errorf("peak jobs in flight = %d, want at most 2", peak)
+	}
+}
+
+func amqpPublishing(body string) amqp.Publishing {
+	return amqp.Publishing{ContentType: "application/json", MessageId: "garbled", Body: []byte(body)}
+}
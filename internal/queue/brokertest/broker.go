@@ -0,0 +1,402 @@
+// Package brokertest provides an in-memory AMQP broker stand-in for exercising queue.AMQPQueue
+// without RabbitMQ.
+//
+// It implements the default exchange routing, manual acknowledgements, prefetch limits,
+// per-message expiration and dead-lettering through the x-dead-letter-exchange and
+// x-dead-letter-routing-key queue arguments. Exchanges other than the default are not supported.
+package brokertest
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"github.com/architeacher/svc-web-analyzer/internal/queue"
+)
+
+// unboundedPrefetch is the in-flight limit of consumers without a prefetch count.
+const unboundedPrefetch = 256
+
+var errUnknownQueue = errors.New("brokertest: unknown queue")
+
+// Broker is an in-memory broker exposing a single channel.
+type Broker struct {
+	mu        sync.Mutex
+	closed    bool
+	prefetch  int
+	nextTag   uint64
+	queues    map[string]*mailbox
+	consumers []*consumer
+	unacked   map[uint64]*inflight
+}
+
+type mailbox struct {
+	name     string
+	args     amqp.Table
+	messages []*message
+}
+
+type message struct {
+	publishing  amqp.Publishing
+	routingKey  string
+	redelivered bool
+	expiry      *time.Timer
+}
+
+type consumer struct {
+	tag      string
+	queue    *mailbox
+	prefetch int
+	inFlight int
+	ch       chan amqp.Delivery
+}
+
+type inflight struct {
+	msg      *message
+	consumer *consumer
+}
+
+var (
+	_ queue.Channel     = (*Broker)(nil)
+	_ amqp.Acknowledger = (*Broker)(nil)
+)
+
+// New creates an empty Broker.
+func New() *Broker {
+	return &Broker{
+		queues:  make(map[string]*mailbox),
+		unacked: make(map[uint64]*inflight),
+	}
+}
+
+// QueueDeclare creates the queue unless it exists.
+func (b *Broker) QueueDeclare(name string, _, _, _, _ bool, args amqp.Table) (amqp.Queue, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return amqp.Queue{}, amqp.ErrClosed
+	}
+
+	q, ok := b.queues[name]
+	if !ok {
+		q = &mailbox{name: name, args: args}
+		b.queues[name] = q
+	}
+
+	return amqp.Queue{Name: name, Messages: len(q.messages), Consumers: b.consumerCount(q)}, nil
+}
+
+// Qos sets the prefetch count of the consumers created afterwards.
+func (b *Broker) Qos(prefetchCount, _ int, _ bool) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.prefetch = prefetchCount
+
+	return nil
+}
+
+// PublishWithContext routes msg to the queue named key. Like the default exchange, it
+// silently drops messages for unknown queues.
+func (b *Broker) PublishWithContext(ctx context.Context, exchange, key string, _, _ bool, msg amqp.Publishing) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if exchange != "" {
+		return errors.New("brokertest: only the default exchange is supported")
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return amqp.ErrClosed
+	}
+
+	if q, ok := b.queues[key]; ok {
+		b.enqueue(q, &message{publishing: msg, routingKey: key})
+		b.dispatch()
+	}
+
+	return nil
+}
+
+// Consume registers a consumer on the queue. autoAck is not supported.
+func (b *Broker) Consume(queueName, consumerTag string, autoAck, _, _, _ bool, _ amqp.Table) (<-chan amqp.Delivery, error) {
+	if autoAck {
+		return nil, errors.New("brokertest: autoAck is not supported")
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, amqp.ErrClosed
+	}
+
+	q, ok := b.queues[queueName]
+	if !ok {
+		return nil, errUnknownQueue
+	}
+
+	prefetch := b.prefetch
+	if prefetch <= 0 {
+		prefetch = unboundedPrefetch
+	}
+
+	c := &consumer{
+		tag:      consumerTag,
+		queue:    q,
+		prefetch: prefetch,
+		// Deliveries are sent while holding the lock, so the buffer must fit the prefetch window.
+		ch: make(chan amqp.Delivery, prefetch),
+	}
+	b.consumers = append(b.consumers, c)
+	b.dispatch()
+
+	return c.ch, nil
+}
+
+// IsClosed reports whether Close was called.
+func (b *Broker) IsClosed() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.closed
+}
+
+// Close ends the consumers. Unacknowledged messages are returned to their queue and flagged
+// as redelivered, as a broker does when a channel goes away.
+func (b *Broker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return amqp.ErrClosed
+	}
+
+	b.closed = true
+
+	for tag, f := range b.unacked {
+		delete(b.unacked, tag)
+		f.msg.redelivered = true
+		f.consumer.queue.messages = append([]*message{f.msg}, f.consumer.queue.messages...)
+	}
+
+	for _, c := range b.consumers {
+		close(c.ch)
+	}
+
+	b.consumers = nil
+
+	return nil
+}
+
+// Len returns the number of ready messages of the named queue.
+func (b *Broker) Len(queueName string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if q, ok := b.queues[queueName]; ok {
+		return len(q.messages)
+	}
+
+	return 0
+}
+
+// Unacked returns the number of delivered messages awaiting acknowledgement.
+func (b *Broker) Unacked() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.unacked)
+}
+
+// Messages returns a copy of the ready messages of the named queue.
+func (b *Broker) Messages(queueName string) []amqp.Publishing {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	q, ok := b.queues[queueName]
+	if !ok {
+		return nil
+	}
+
+	messages := make([]amqp.Publishing, 0, len(q.messages))
+	for _, m := range q.messages {
+		messages = append(messages, m.publishing)
+	}
+
+	return messages
+}
+
+// Ack implements amqp.Acknowledger.
+func (b *Broker) Ack(tag uint64, _ bool) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, err := b.settle(tag); err != nil {
+		return err
+	}
+
+	b.dispatch()
+
+	return nil
+}
+
+// Nack implements amqp.Acknowledger.
+func (b *Broker) Nack(tag uint64, _ bool, requeue bool) error {
+	return b.Reject(tag, requeue)
+}
+
+// Reject implements amqp.Acknowledger. Rejected messages are requeued or dead-lettered.
+func (b *Broker) Reject(tag uint64, requeue bool) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	f, err := b.settle(tag)
+	if err != nil {
+		return err
+	}
+
+	if requeue {
+		f.msg.redelivered = true
+		f.consumer.queue.messages = append([]*message{f.msg}, f.consumer.queue.messages...)
+	} else {
+		b.deadLetter(f.consumer.queue, f.msg)
+	}
+
+	b.dispatch()
+
+	return nil
+}
+
+func (b *Broker) settle(tag uint64) (*inflight, error) {
+	f, ok := b.unacked[tag]
+	if !ok {
+		return nil, errors.New("brokertest: unknown delivery tag " + strconv.FormatUint(tag, 10))
+	}
+
+	delete(b.unacked, tag)
+	f.consumer.inFlight--
+
+	return f, nil
+}
+
+func (b *Broker) enqueue(q *mailbox, m *message) {
+	q.messages = append(q.messages, m)
+
+	ttl, err := strconv.ParseInt(m.publishing.Expiration, 10, 64)
+	if m.publishing.Expiration == "" || err != nil {
+		return
+	}
+
+	m.expiry = time.AfterFunc(time.Duration(ttl)*time.Millisecond, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		if b.closed || !remove(q, m) {
+			return
+		}
+
+		b.deadLetter(q, m)
+		b.dispatch()
+	})
+}
+
+// deadLetter routes m to the queue configured by the x-dead-letter-* arguments of q, if any.
+func (b *Broker) deadLetter(q *mailbox, m *message) {
+	exchange, ok := q.args["x-dead-letter-exchange"].(string)
+	if !ok || exchange != "" {
+		return
+	}
+
+	key := m.routingKey
+	if k, ok := q.args["x-dead-letter-routing-key"].(string); ok {
+		key = k
+	}
+
+	target, ok := b.queues[key]
+	if !ok {
+		return
+	}
+
+	publishing := m.publishing
+	publishing.Expiration = ""
+	b.enqueue(target, &message{publishing: publishing, routingKey: key})
+}
+
+// dispatch hands ready messages to the consumers with room in their prefetch window.
+func (b *Broker) dispatch() {
+	for _, c := range b.consumers {
+		for c.inFlight < c.prefetch && len(c.queue.messages) > 0 {
+			m := c.queue.messages[0]
+			c.queue.messages = c.queue.messages[1:]
+
+			if m.expiry != nil {
+				m.expiry.Stop()
+			}
+
+			b.nextTag++
+			b.unacked[b.nextTag] = &inflight{msg: m, consumer: c}
+			c.inFlight++
+
+			c.ch <- delivery(b, c, m, b.nextTag)
+		}
+	}
+}
+
+func (b *Broker) consumerCount(q *mailbox) int {
+	count := 0
+	for _, c := range b.consumers {
+		if c.queue == q {
+			count++
+		}
+	}
+
+	return count
+}
+
+func delivery(b *Broker, c *consumer, m *message, tag uint64) amqp.Delivery {
+	p := m.publishing
+
+	return amqp.Delivery{
+		Acknowledger:    b,
+		Headers:         p.Headers,
+		ContentType:     p.ContentType,
+		ContentEncoding: p.ContentEncoding,
+		DeliveryMode:    p.DeliveryMode,
+		Priority:        p.Priority,
+		CorrelationId:   p.CorrelationId,
+		ReplyTo:         p.ReplyTo,
+		Expiration:      p.Expiration,
+		MessageId:       p.MessageId,
+		Timestamp:       p.Timestamp,
+		Type:            p.Type,
+		UserId:          p.UserId,
+		AppId:           p.AppId,
+		ConsumerTag:     c.tag,
+		DeliveryTag:     tag,
+		Redelivered:     m.redelivered,
+		RoutingKey:      m.routingKey,
+		Body:            p.Body,
+	}
+}
+
+func remove(q *mailbox, m *message) bool {
+	for i, candidate := range q.messages {
+		if candidate == m {
+			q.messages = append(q.messages[:i], q.messages[i+1:]...)
+
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,133 @@
+package queue
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// MemoryQueue is an in-process Queue backed by a bounded channel and a worker pool.
+// Pending jobs and scheduled retries do not survive a restart.
+type MemoryQueue struct {
+	opts options
+	jobs chan Job
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+var _ Queue = (*MemoryQueue)(nil)
+
+// NewMemory creates a MemoryQueue.
+func NewMemory(opts ...Option) *MemoryQueue {
+	o := newOptions(opts)
+
+	return &MemoryQueue{
+		opts: o,
+		jobs: make(chan Job, o.capacity),
+		done: make(chan struct{}),
+	}
+}
+
+// Publish enqueues job without blocking, failing with ErrFull when the queue is at capacity.
+func (q *MemoryQueue) Publish(_ context.Context, job Job) error {
+	select {
+	case <-q.done:
+		return ErrClosed
+	default:
+	}
+
+	if job.Attempt == 0 {
+		job.Attempt = 1
+	}
+
+	if job.EnqueuedAt.IsZero() {
+		job.EnqueuedAt = time.Now().UTC()
+	}
+
+	select {
+	case q.jobs <- job:
+		return nil
+	default:
+		return ErrFull
+	}
+}
+
+// Consume runs the worker pool until ctx is cancelled or the queue is closed.
+func (q *MemoryQueue) Consume(ctx context.Context, h Handler) error {
+	var wg sync.WaitGroup
+
+	for range q.opts.workers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-q.done:
+					return
+				case job := <-q.jobs:
+					q.process(ctx, h, job)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+// Ping fails once the queue has been closed.
+func (q *MemoryQueue) Ping(_ context.Context) error {
+	select {
+	case <-q.done:
+		return ErrClosed
+	default:
+		return nil
+	}
+}
+
+// Close stops the workers and drops the pending jobs and scheduled retries.
+func (q *MemoryQueue) Close() error {
+	q.closeOnce.Do(func() {
+		close(q.done)
+	})
+
+	return nil
+}
+
+// Len returns the number of jobs waiting for a worker.
+func (q *MemoryQueue) Len() int {
+	return len(q.jobs)
+}
+
+func (q *MemoryQueue) process(ctx context.Context, h Handler, job Job) {
+	err := handle(ctx, h, job, q.opts.visibilityTimeout)
+	if err == nil {
+		return
+	}
+
+	if !q.opts.retry.ShouldRetry(job.Attempt, err) {
+		q.opts.logger.Warn("job dead-lettered", jobAttrs(job), slog.Any("error", err))
+		deadLetter(ctx, h, job, err)
+
+		return
+	}
+
+	backoff := q.opts.retry.Backoff(job.Attempt)
+	q.opts.logger.Info("job scheduled for retry", jobAttrs(job), slog.Duration("backoff", backoff), slog.Any("error", err))
+
+	job.Attempt++
+	time.AfterFunc(backoff, func() {
+		// Retries wait for room rather than failing with ErrFull, since they were accepted once.
+		select {
+		case q.jobs <- job:
+		case <-q.done:
+		}
+	})
+}
@@ -0,0 +1,223 @@
+// Package queue runs analysis jobs asynchronously, with at-least-once delivery, retries with
+// exponential backoff and a dead-letter path for jobs that fail permanently.
+package queue
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+const (
+	defaultWorkers           = 8
+	defaultCapacity          = 1024
+	defaultVisibilityTimeout = 6 * time.Minute
+	defaultMaxAttempts       = 3
+	defaultInitialBackoff    = 2 * time.Second
+	defaultMaxBackoff        = time.Minute
+
+	// deadLetterTimeout bounds the DeadLetter callback, which runs after the job context expired.
+	deadLetterTimeout = 10 * time.Second
+)
+
+var (
+	// ErrFull is returned by Publish when the queue cannot accept more jobs.
+	ErrFull = errors.New("queue is full")
+	// ErrClosed is returned once the queue has been closed.
+	ErrClosed = errors.New("queue is closed")
+)
+
+// Job is a request to analyze a page.
+type Job struct {
+	AnalysisID uuid.UUID      `json:"analysis_id"`
+	URL        string         `json:"url"`
+	Options    domain.Options `json:"options"`
+	// BatchID is the batch the analysis was submitted in, if any.
+	BatchID uuid.UUID `json:"batch_id,omitzero"`
+	// Attempt is the 1-based delivery attempt, incremented on every retry.
+	Attempt    int       `json:"attempt"`
+	EnqueuedAt time.Time `json:"enqueued_at"`
+}
+
+// Handler processes the jobs of a queue.
+type Handler interface {
+	// Handle processes a job. A returned error schedules a retry, unless it is marked with
+	// Permanent or the attempts are exhausted, in which case the job is dead-lettered.
+	Handle(ctx context.Context, job Job) error
+	// DeadLetter is called once for every job that failed permanently, with the last error.
+	DeadLetter(ctx context.Context, job Job, err error)
+}
+
+// Queue delivers published jobs to a Handler at least once.
+type Queue interface {
+	// Publish enqueues a job.
+	Publish(ctx context.Context, job Job) error
+	// Consume delivers jobs to h until ctx is cancelled, then waits for the jobs in flight.
+	Consume(ctx context.Context, h Handler) error
+	// Ping reports whether the queue is able to accept jobs.
+	Ping(ctx context.Context) error
+	// Close releases the queue resources.
+	Close() error
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as not worth retrying.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err was marked with Permanent.
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+
+	return errors.As(err, &permanent)
+}
+
+// RetryPolicy controls how failed jobs are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of deliveries, including the first one.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryPolicy returns the policy used when none is configured.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    defaultMaxAttempts,
+		InitialBackoff: defaultInitialBackoff,
+		MaxBackoff:     defaultMaxBackoff,
+	}
+}
+
+// Backoff returns the delay before the delivery following attempt. It doubles with every
+// attempt, up to MaxBackoff, with up to 20% of jitter so that retries do not synchronise.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	backoff = min(backoff, p.MaxBackoff)
+	if backoff <= 0 {
+		return 0
+	}
+
+	return backoff - time.Duration(rand.Int64N(int64(backoff)/5+1))
+}
+
+// ShouldRetry reports whether a job whose attempt failed with err is delivered again.
+func (p RetryPolicy) ShouldRetry(attempt int, err error) bool {
+	return !IsPermanent(err) && attempt < p.MaxAttempts
+}
+
+// Option configures a Queue implementation.
+type Option func(*options)
+
+type options struct {
+	workers           int
+	capacity          int
+	visibilityTimeout time.Duration
+	retry             RetryPolicy
+	logger            *slog.Logger
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		workers:           defaultWorkers,
+		capacity:          defaultCapacity,
+		visibilityTimeout: defaultVisibilityTimeout,
+		retry:             DefaultRetryPolicy(),
+		logger:            slog.New(slog.DiscardHandler),
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// WithWorkers sets the number of jobs processed concurrently.
+func WithWorkers(workers int) Option {
+	return func(o *options) {
+		if workers > 0 {
+			o.workers = workers
+		}
+	}
+}
+
+// WithCapacity bounds the number of pending jobs of the in-process queue.
+func WithCapacity(capacity int) Option {
+	return func(o *options) {
+		if capacity > 0 {
+			o.capacity = capacity
+		}
+	}
+}
+
+// WithVisibilityTimeout sets how long a delivered job may run before the attempt is
+// considered lost and the job is retried.
+func WithVisibilityTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		if timeout > 0 {
+			o.visibilityTimeout = timeout
+		}
+	}
+}
+
+// WithRetryPolicy sets the retry policy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		if policy.MaxAttempts > 0 {
+			o.retry = policy
+		}
+	}
+}
+
+// WithLogger sets the logger used to report retries and dead-lettered jobs.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		if logger != nil {
+			o.logger = logger
+		}
+	}
+}
+
+// handle runs a single delivery of job, bounded by the visibility timeout.
+func handle(ctx context.Context, h Handler, job Job, visibilityTimeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), visibilityTimeout)
+	defer cancel()
+
+	return h.Handle(ctx, job)
+}
+
+func deadLetter(ctx context.Context, h Handler, job Job, err error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), deadLetterTimeout)
+	defer cancel()
+
+	h.DeadLetter(ctx, job, err)
+}
+
+func jobAttrs(job Job) slog.Attr {
+	return slog.Group("job",
+		slog.String("analysis_id", job.AnalysisID.String()),
+		slog.Int("attempt", job.Attempt),
+	)
+}
@@ -0,0 +1,79 @@
+package queue_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/queue"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	t.Parallel()
+
+	policy := queue.RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 1, want: time.Second},
+		{attempt: 2, want: 2 * time.Second},
+		{attempt: 3, want: 4 * time.Second},
+		{attempt: 4, want: 5 * time.Second},
+		{attempt: 10, want: 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("attempt %d", tt.attempt), func(t *testing.T) {
+			t.Parallel()
+
+			// The jitter takes off up to a fifth of the backoff.
+			for range 20 {
+				got := policy.Backoff(tt.attempt)
+				if got > tt.want || got < tt.want-tt.want/5 {
+					t.Fatalf("Backoff(%d) = %v, want within [%v, %v]", tt.attempt, got, tt.want-tt.want/5, tt.want)
+				}
+			}
+		})
+	}
+
+	if got := (queue.RetryPolicy{MaxAttempts: 1}).Backoff(1); got != 0 {
+		t.Errorf("Backoff() without an initial backoff = %v, want 0", got)
+	}
+}
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	t.Parallel()
+
+	policy := queue.RetryPolicy{MaxAttempts: 3}
+	transient := errors.New("connection reset")
+
+	tests := []struct {
+		name    string
+		attempt int
+		err     error
+		want    bool
+	}{
+		{name: "first attempt", attempt: 1, err: transient, want: true},
+		{name: "attempts left", attempt: 2, err: transient, want: true},
+		{name: "attempts exhausted", attempt: 3, err: transient, want: false},
+		{name: "permanent", attempt: 1, err: queue.Permanent(transient), want: false},
+		{name: "wrapped permanent", attempt: 1, err: fmt.Errorf("handling: %w", queue.Permanent(transient)), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := policy.ShouldRetry(tt.attempt, tt.err); got != tt.want {
+				t.Errorf("ShouldRetry(%d, %v) = %t, want %t", tt.attempt, tt.err, got, tt.want)
+			}
+		})
+	}
+
+	if queue.Permanent(nil) != nil {
+		t.Error("Permanent(nil) != nil")
+	}
+}
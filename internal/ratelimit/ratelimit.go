@@ -0,0 +1,60 @@
+// Package ratelimit throttles clients with token buckets, kept in process or shared by the
+// instances through Redis.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Limit is the budget of a bucket: up to Requests at once, refilled at Requests per Period.
+type Limit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// rate returns the tokens added per second.
+func (l Limit) rate() float64 {
+	return float64(l.Requests) / l.Period.Seconds()
+}
+
+// Result is the outcome of taking a token from a bucket.
+type Result struct {
+	Allowed bool
+	// Limit is the capacity of the bucket and Remaining the tokens left in it.
+	Limit     int
+	Remaining int
+	// Reset is the time until the bucket is full again, and RetryAfter, for denied requests,
+	// the time until a token is available.
+	Reset      time.Duration
+	RetryAfter time.Duration
+}
+
+// Limiter takes tokens from buckets identified by key.
+type Limiter interface {
+	// Allow takes a token from the bucket of key, created full with limit when absent.
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// result describes a bucket holding tokens after a request was allowed or not.
+func result(limit Limit, tokens float64, allowed bool) Result {
+	rate := limit.rate()
+
+	r := Result{
+		Allowed:   allowed,
+		Limit:     limit.Requests,
+		Remaining: int(math.Floor(tokens)),
+		Reset:     seconds((float64(limit.Requests) - tokens) / rate),
+	}
+
+	if !allowed {
+		r.RetryAfter = seconds((1 - tokens) / rate)
+	}
+
+	return r
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(math.Max(s, 0) * float64(time.Second))
+}
@@ -0,0 +1,89 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const keyPrefix = "web-analyzer:ratelimit:"
+
+// tokenBucket refills and takes a token from the bucket of KEYS[1] atomically, on the clock of
+// the server so that the instances agree on it. ARGV holds the capacity and the tokens added
+// per second. The bucket expires once full again.
+var tokenBucket = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) + tonumber(time[2]) / 1000000
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'updated_at')
+local tokens = tonumber(state[1]) or capacity
+local updated_at = tonumber(state[2]) or now
+
+tokens = math.min(capacity, tokens + math.max(0, now - updated_at) * rate)
+
+local allowed = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'updated_at', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil((capacity - tokens) / rate * 1000) + 1000)
+
+return {allowed, tostring(tokens)}
+`)
+
+// RedisLimiter is a Limiter whose buckets are shared by the instances through Redis, so that
+// the limits hold across replicas.
+type RedisLimiter struct {
+	client *redis.Client
+}
+
+var _ Limiter = (*RedisLimiter)(nil)
+
+// NewRedis creates a RedisLimiter for the server at url, e.g. redis://localhost:6379/0.
+// Connections are established lazily.
+func NewRedis(url string) (*RedisLimiter, error) {
+	options, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("parsing redis url: %w", err)
+	}
+
+	return &RedisLimiter{client: redis.NewClient(options)}, nil
+}
+
+// Allow takes a token from the bucket of key.
+func (l *RedisLimiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	values, err := tokenBucket.Run(ctx, l.client, []string{keyPrefix + key}, limit.Requests, limit.rate()).Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("taking a token for %s: %w", key, err)
+	}
+
+	if len(values) != 2 {
+		return Result{}, fmt.Errorf("taking a token for %s: unexpected reply %v", key, values)
+	}
+
+	allowed, _ := values[0].(int64)
+	raw, _ := values[1].(string)
+
+	tokens, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return Result{}, fmt.Errorf("taking a token for %s: parsing tokens %q: %w", key, raw, err)
+	}
+
+	return result(limit, tokens, allowed == 1), nil
+}
+
+// Ping checks the connection to the server.
+func (l *RedisLimiter) Ping(ctx context.Context) error {
+	return l.client.Ping(ctx).Err()
+}
+
+// Close closes the connections to the server.
+func (l *RedisLimiter) Close() error {
+	return l.client.Close()
+}
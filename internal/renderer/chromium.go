@@ -0,0 +1,386 @@
+package renderer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/chromedp/cdproto/browser"
+	"github.com/chromedp/cdproto/cdp"
+	"github.com/chromedp/cdproto/emulation"
+	"github.com/chromedp/cdproto/fetch"
+	"github.com/chromedp/cdproto/network"
+	"github.com/chromedp/cdproto/page"
+	"github.com/chromedp/cdproto/target"
+	"github.com/chromedp/chromedp"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/netguard"
+)
+
+const (
+	defaultMaxTabs = 4
+
+	// lifecycleBuffer is how many lifecycle events of a tab, subframes included, may be pending
+	// while its navigation is awaited.
+	lifecycleBuffer = 256
+
+	defaultProxyAddr = "127.0.0.1:0"
+
+	// proxyBypassList drops the implicit bypass of the loopback addresses, so that the browser
+	// requests even those through the proxy.
+	proxyBypassList = "<-loopback>"
+
+	// documentScript serialises the rendered document, whose outerHTML lacks the DOCTYPE.
+	documentScript = `(document.doctype ? new XMLSerializer().serializeToString(document.doctype) : "") + document.documentElement.outerHTML`
+)
+
+// Chromium renders pages in tabs of a headless Chromium, driven through the DevTools protocol.
+// The requests of the pages, their scripts included, are held to the guard: every tab connects
+// through a netguard.Proxy served by the renderer, which checks the addresses it dials, requests
+// to schemes other than http and https fail, and WebSockets are refused.
+type Chromium struct {
+	execPath  string
+	remoteURL string
+	noSandbox bool
+	maxTabs   int
+	userAgent string
+	guard     *netguard.Guard
+	proxyAddr string
+	proxyURL  string
+
+	proxy   *http.Server
+	browser context.Context
+	cancel  context.CancelFunc
+	tabs    chan struct{}
+}
+
+var _ Renderer = (*Chromium)(nil)
+
+// ChromiumOption configures a Chromium renderer.
+type ChromiumOption func(*Chromium)
+
+// WithExecPath sets the browser binary launched, found in the PATH by default.
+func WithExecPath(path string) ChromiumOption {
+	return func(c *Chromium) {
+		c.execPath = path
+	}
+}
+
+// WithRemoteURL connects to a running browser, such as a headless Chromium container, through
+// its DevTools WebSocket URL rather than launching one.
+func WithRemoteURL(remoteURL string) ChromiumOption {
+	return func(c *Chromium) {
+		c.remoteURL = remoteURL
+	}
+}
+
+// WithNoSandbox launches the browser without its sandbox, which containers running as root
+// require.
+func WithNoSandbox() ChromiumOption {
+	return func(c *Chromium) {
+		c.noSandbox = true
+	}
+}
+
+// WithMaxTabs caps the pages rendered at once; further renders wait for a tab to close.
+func WithMaxTabs(n int) ChromiumOption {
+	return func(c *Chromium) {
+		if n > 0 {
+			c.maxTabs = n
+		}
+	}
+}
+
+// WithUserAgent sets the User-Agent the pages are requested with.
+func WithUserAgent(ua string) ChromiumOption {
+	return func(c *Chromium) {
+		c.userAgent = ua
+	}
+}
+
+// WithGuard sets the guard checking the addresses the pages request. By default, only public
+// addresses are reachable.
+func WithGuard(guard *netguard.Guard) ChromiumOption {
+	return func(c *Chromium) {
+		c.guard = guard
+	}
+}
+
+// WithProxyAddr sets the address the proxy of the tabs listens on, 127.0.0.1 on a free port by
+// default. A remote browser must be able to reach it.
+func WithProxyAddr(addr string) ChromiumOption {
+	return func(c *Chromium) {
+		c.proxyAddr = addr
+	}
+}
+
+// WithProxyURL sets the URL the browser reaches the proxy of the tabs at, such as
+// http://web-analyzer:3128 for a remote browser, by default that of the address it listens on.
+func WithProxyURL(proxyURL string) ChromiumOption {
+	return func(c *Chromium) {
+		c.proxyURL = proxyURL
+	}
+}
+
+// NewChromium launches the browser, or connects to the remote one, failing when it cannot be
+// started. Close releases it.
+func NewChromium(opts ...ChromiumOption) (*Chromium, error) {
+	c := &Chromium{maxTabs: defaultMaxTabs, proxyAddr: defaultProxyAddr}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.guard == nil {
+		c.guard, _ = netguard.New()
+	}
+
+	if err := c.serveProxy(); err != nil {
+		return nil, err
+	}
+
+	var (
+		allocator   context.Context
+		cancelAlloc context.CancelFunc
+	)
+
+	if c.remoteURL != "" {
+		allocator, cancelAlloc = chromedp.NewRemoteAllocator(context.Background(), c.remoteURL)
+	} else {
+		// The defaults keep cross-site frames in the process of their page, where their requests
+		// are screened along with those of the page.
+		allocOpts := chromedp.DefaultExecAllocatorOptions[:]
+		if c.execPath != "" {
+			allocOpts = append(allocOpts, chromedp.ExecPath(c.execPath))
+		}
+
+		if c.noSandbox {
+			allocOpts = append(allocOpts, chromedp.NoSandbox)
+		}
+
+		// WebRTC would otherwise connect over UDP, past the proxy.
+		allocOpts = append(allocOpts, chromedp.Flag("force-webrtc-ip-handling-policy", "disable_non_proxied_udp"))
+
+		allocator, cancelAlloc = chromedp.NewExecAllocator(context.Background(), allocOpts...)
+	}
+
+	browserCtx, cancelBrowser := chromedp.NewContext(allocator)
+
+	c.browser = browserCtx
+	c.cancel = func() {
+		cancelBrowser()
+		cancelAlloc()
+		_ = c.proxy.Close()
+	}
+	c.tabs = make(chan struct{}, c.maxTabs)
+
+	if err := chromedp.Run(browserCtx); err != nil {
+		c.cancel()
+
+		return nil, fmt.Errorf("starting the browser: %w", err)
+	}
+
+	return c, nil
+}
+
+// Render renders pageURL in a new tab, closed once the page is serialised or ctx is done.
+func (c *Chromium) Render(ctx context.Context, pageURL string, opts domain.RenderOptions) (*Page, error) {
+	select {
+	case c.tabs <- struct{}{}:
+		defer func() { <-c.tabs }()
+	case <-ctx.Done():
+		return nil, renderError(ctx, ctx.Err())
+	}
+
+	// Each tab has a browser context of its own, sharing no cookies nor cache with the others,
+	// connecting through the proxy.
+	tab, closeTab := chromedp.NewContext(c.browser, chromedp.WithNewBrowserContext(
+		func(params *target.CreateBrowserContextParams) *target.CreateBrowserContextParams {
+			return params.WithProxyServer(c.proxyURL).WithProxyBypassList(proxyBypassList)
+		},
+	))
+	defer closeTab()
+
+	// Tabs derive from the browser context, not from ctx.
+	stop := context.AfterFunc(ctx, closeTab)
+	defer stop()
+
+	lifecycle := make(chan *page.EventLifecycleEvent, lifecycleBuffer)
+
+	chromedp.ListenTarget(tab, func(ev any) {
+		switch ev := ev.(type) {
+		case *fetch.EventRequestPaused:
+			go c.screen(tab, ev)
+		case *page.EventLifecycleEvent:
+			select {
+			case lifecycle <- ev:
+			default:
+			}
+		}
+	})
+
+	tasks := chromedp.Tasks{
+		fetch.Enable(),
+		network.Enable(),
+		network.SetBlockedURLs([]string{"ws://*", "wss://*"}),
+		page.SetLifecycleEventsEnabled(true),
+	}
+
+	if c.userAgent != "" {
+		tasks = append(tasks, emulation.SetUserAgentOverride(c.userAgent))
+	}
+
+	var rendered Page
+
+	tasks = append(tasks,
+		chromedp.ActionFunc(func(ctx context.Context) error {
+			return navigate(ctx, pageURL, opts, lifecycle)
+		}),
+		chromedp.Location(&rendered.URL),
+		chromedp.Evaluate(documentScript, &rendered.HTML),
+	)
+
+	if err := chromedp.Run(tab, tasks); err != nil {
+		return nil, renderError(ctx, err)
+	}
+
+	return &rendered, nil
+}
+
+// Ping checks that the browser answers.
+func (c *Chromium) Ping(ctx context.Context) error {
+	_, _, _, _, _, err := browser.GetVersion().Do(cdp.WithExecutor(ctx, chromedp.FromContext(c.browser).Browser))
+
+	return err
+}
+
+// Details reports the tabs in use, for the health check.
+func (c *Chromium) Details(context.Context) (map[string]any, error) {
+	return map[string]any{
+		"open_tabs": len(c.tabs),
+		"max_tabs":  cap(c.tabs),
+	}, nil
+}
+
+// Close closes the browser, or disconnects from the remote one, and stops the proxy.
+func (c *Chromium) Close() error {
+	c.cancel()
+
+	return nil
+}
+
+// navigate loads pageURL and waits for opts.WaitFor.
+func navigate(ctx context.Context, pageURL string, opts domain.RenderOptions, lifecycle <-chan *page.EventLifecycleEvent) error {
+	_, loaderID, errorText, _, err := page.Navigate(pageURL).Do(ctx)
+	if err != nil {
+		return err
+	}
+
+	if errorText != "" {
+		return fmt.Errorf("navigating to %s: %s", pageURL, errorText)
+	}
+
+	event := "load"
+	if opts.WaitFor == domain.WaitNetworkIdle {
+		event = "networkIdle"
+	}
+
+	if err := awaitLifecycle(ctx, lifecycle, loaderID, event); err != nil {
+		return err
+	}
+
+	switch opts.WaitFor {
+	case domain.WaitSelector:
+		return chromedp.WaitReady(opts.Selector, chromedp.ByQuery).Do(ctx)
+	case domain.WaitDelay:
+		return chromedp.Sleep(opts.Delay).Do(ctx)
+	default:
+		return nil
+	}
+}
+
+// awaitLifecycle waits for the lifecycle event name of the document loaded by loaderID.
+func awaitLifecycle(ctx context.Context, lifecycle <-chan *page.EventLifecycleEvent, loaderID cdp.LoaderID, name string) error {
+	for {
+		select {
+		case ev := <-lifecycle:
+			if ev.LoaderID == loaderID && ev.Name == name {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// serveProxy serves the proxy the tabs connect through.
+func (c *Chromium) serveProxy() error {
+	listener, err := net.Listen("tcp", c.proxyAddr)
+	if err != nil {
+		return fmt.Errorf("listening for the requests of the browser: %w", err)
+	}
+
+	c.proxy = &http.Server{
+		Handler:           netguard.NewProxy(c.guard),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	if c.proxyURL == "" {
+		c.proxyURL = "http://" + listener.Addr().String()
+	}
+
+	go func() {
+		_ = c.proxy.Serve(listener)
+	}()
+
+	return nil
+}
+
+// screen lets a paused request of tab through, or fails it when it is not allowed.
+func (c *Chromium) screen(tab context.Context, ev *fetch.EventRequestPaused) {
+	ctx := cdp.WithExecutor(tab, chromedp.FromContext(tab).Target)
+
+	if err := c.allow(ctx, ev.Request.URL); err != nil {
+		_ = fetch.FailRequest(ev.RequestID, network.ErrorReasonBlockedByClient).Do(ctx)
+
+		return
+	}
+
+	_ = fetch.ContinueRequest(ev.RequestID).Do(ctx)
+}
+
+// allow fails early the requests of schemes other than http and https, and those to hosts
+// resolving to blocked addresses, the proxy checking the addresses actually dialed.
+func (c *Chromium) allow(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		return c.guard.Check(ctx, u.Hostname())
+	case "data", "blob":
+		return nil
+	default:
+		return fmt.Errorf("%w: the %s scheme is not allowed", netguard.ErrBlocked, u.Scheme)
+	}
+}
+
+// renderError maps the failures of a render to analysis errors. Cancellations, such as those
+// of a shutdown, are returned as is.
+func renderError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return domain.NewAnalysisError(domain.ErrCodeTimeout, "Rendering the page timed out", 0, err.Error())
+	case ctx.Err() != nil:
+		return fmt.Errorf("rendering the page: %w", ctx.Err())
+	default:
+		return domain.NewAnalysisError(domain.ErrCodeRenderFailed, "The page could not be rendered", 0, err.Error())
+	}
+}
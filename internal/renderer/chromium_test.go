@@ -0,0 +1,224 @@
+package renderer
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/chromedp/cdproto/cdp"
+	"github.com/chromedp/cdproto/page"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/netguard"
+)
+
+func TestChromiumAllow(t *testing.T) {
+	t.Parallel()
+
+	guard, err := netguard.New("10.1.0.0/16")
+	if err != nil {
+		t.Fatalf("netguard.New() error = %v", err)
+	}
+
+	c := &Chromium{guard: guard}
+
+	tests := []struct {
+		url         string
+		wantBlocked bool
+	}{
+		{url: "https://93.184.215.14/app.js"},
+		{url: "http://93.184.215.14:8080/api"},
+		{url: "http://10.1.2.3/allowed"},
+		{url: "data:image/png;base64,iVBORw0KGgo="},
+		{url: "blob:https://93.184.215.14/4f1c2a3e"},
+		{url: "http://10.2.0.1/internal", wantBlocked: true},
+		{url: "http://127.0.0.1:6379/", wantBlocked: true},
+		{url: "http://169.254.169.254/latest/meta-data/", wantBlocked: true},
+		{url: "http://[::1]/", wantBlocked: true},
+		{url: "http://[64:ff9b::a9fe:a9fe]/", wantBlocked: true},
+		{url: "ws://93.184.215.14/socket", wantBlocked: true},
+		{url: "wss://93.184.215.14/socket", wantBlocked: true},
+		{url: "file:///etc/passwd", wantBlocked: true},
+		{url: "ftp://93.184.215.14/", wantBlocked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.allow(context.Background(), tt.url)
+			if got := errors.Is(err, netguard.ErrBlocked); got != tt.wantBlocked {
+				t.Errorf("allow(%s) = %v, want blocked: %t", tt.url, err, tt.wantBlocked)
+			}
+		})
+	}
+}
+
+// rebindingResolver resolves every host to a public address the first time, and to the
+// loopback address afterwards.
+type rebindingResolver struct {
+	mu      sync.Mutex
+	lookups int
+}
+
+func (r *rebindingResolver) LookupNetIP(context.Context, string, string) ([]netip.Addr, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lookups++
+	if r.lookups == 1 {
+		return []netip.Addr{netip.MustParseAddr("93.184.215.14")}, nil
+	}
+
+	return []netip.Addr{netip.MustParseAddr("127.0.0.1")}, nil
+}
+
+func TestChromiumProxyBlocksRebindingHosts(t *testing.T) {
+	t.Parallel()
+
+	var hits atomic.Int32
+
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(internal.Close)
+
+	guard, err := netguard.New()
+	if err != nil {
+		t.Fatalf("netguard.New() error = %v", err)
+	}
+
+	c := &Chromium{guard: guard.WithResolver(&rebindingResolver{}), proxyAddr: defaultProxyAddr}
+	if err := c.serveProxy(); err != nil {
+		t.Fatalf("serveProxy() error = %v", err)
+	}
+	t.Cleanup(func() { _ = c.proxy.Close() })
+
+	_, port, _ := net.SplitHostPort(internal.Listener.Addr().String())
+	target := "http://rebinding.example:" + port + "/"
+
+	// The early check sees the public address.
+	if err := c.allow(context.Background(), target); err != nil {
+		t.Fatalf("allow(%s) error = %v, want none", target, err)
+	}
+
+	proxyURL, err := url.Parse(c.proxyURL)
+	if err != nil {
+		t.Fatalf("url.Parse(%s) error = %v", c.proxyURL, err)
+	}
+
+	// The browser connecting through the proxy, the loopback address it then resolves to is
+	// not dialed.
+	client := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)}}
+
+	resp, err := client.Get(target)
+	if err != nil {
+		t.Fatalf("Get(%s) error = %v", target, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Get(%s) status = %d, want %d", target, resp.StatusCode, http.StatusForbidden)
+	}
+
+	if n := hits.Load(); n != 0 {
+		t.Errorf("the internal server was requested %d times, want 0", n)
+	}
+}
+
+func TestRenderError(t *testing.T) {
+	t.Parallel()
+
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now())
+	defer cancelExpired()
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode string
+	}{
+		{name: "deadline exceeded", ctx: expired, wantCode: domain.ErrCodeTimeout},
+		{name: "browser failure", ctx: context.Background(), wantCode: domain.ErrCodeRenderFailed},
+		{name: "canceled", ctx: canceled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := renderError(tt.ctx, errors.New("net::ERR_CONNECTION_REFUSED"))
+
+			var analysisErr *domain.AnalysisError
+			if !errors.As(err, &analysisErr) {
+				if tt.wantCode != "" {
+					t.Fatalf("renderError() = %v, want a %s analysis error", err, tt.wantCode)
+				}
+
+				if !errors.Is(err, context.Canceled) {
+					t.Errorf("renderError() = %v, want the cancellation", err)
+				}
+
+				return
+			}
+
+			if analysisErr.Code != tt.wantCode {
+				t.Errorf("renderError() code = %s, want %s", analysisErr.Code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestAwaitLifecycle(t *testing.T) {
+	t.Parallel()
+
+	lifecycle := make(chan *page.EventLifecycleEvent, 4)
+	lifecycle <- &page.EventLifecycleEvent{LoaderID: "frame", Name: "load"}
+	lifecycle <- &page.EventLifecycleEvent{LoaderID: "main", Name: "DOMContentLoaded"}
+	lifecycle <- &page.EventLifecycleEvent{LoaderID: "main", Name: "load"}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := awaitLifecycle(ctx, lifecycle, cdp.LoaderID("main"), "load"); err != nil {
+		t.Errorf("awaitLifecycle() error = %v, want the load of the main document", err)
+	}
+
+	// The network of the page never going idle, the render times out.
+	lifecycle <- &page.EventLifecycleEvent{LoaderID: "main", Name: "networkAlmostIdle"}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := awaitLifecycle(ctx, lifecycle, cdp.LoaderID("main"), "networkIdle"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("awaitLifecycle() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestChromiumRenderTimesOutWaitingForATab(t *testing.T) {
+	t.Parallel()
+
+	c := &Chromium{tabs: make(chan struct{}, 1)}
+	c.tabs <- struct{}{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := c.Render(ctx, "https://example.com/", domain.RenderOptions{WaitFor: domain.WaitLoad})
+
+	var analysisErr *domain.AnalysisError
+	if !errors.As(err, &analysisErr) || analysisErr.Code != domain.ErrCodeTimeout {
+		t.Errorf("Render() error = %v, want a %s analysis error", err, domain.ErrCodeTimeout)
+	}
+}
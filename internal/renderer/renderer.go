@@ -0,0 +1,25 @@
+// Package renderer renders web pages in a browser, running their scripts, so that the pages
+// built by JavaScript, such as single-page applications, can be analyzed.
+package renderer
+
+import (
+	"context"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+// Page is a rendered page.
+type Page struct {
+	// URL is the address of the page once rendered, after redirects and script navigations.
+	URL string
+	// HTML is the serialised document, DOCTYPE included.
+	HTML string
+}
+
+// Renderer renders pages. Implementations other than Chromium, such as
+// renderertest.Renderer, stand in for the browser in tests.
+type Renderer interface {
+	// Render navigates to pageURL and returns the document once opts.WaitFor is met. Failures
+	// are reported as domain.AnalysisError.
+	Render(ctx context.Context, pageURL string, opts domain.RenderOptions) (*Page, error)
+}
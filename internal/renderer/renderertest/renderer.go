@@ -0,0 +1,92 @@
+// Package renderertest provides a renderer.Renderer stand-in serving canned pages, for
+// exercising the rendering mode without a browser.
+package renderertest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/renderer"
+)
+
+// Call is a render requested from a Renderer.
+type Call struct {
+	URL     string
+	Options domain.RenderOptions
+}
+
+// Renderer renders the pages it serves as they were registered. Rendering other pages fails
+// with a render_failed analysis error.
+type Renderer struct {
+	mu    sync.Mutex
+	pages map[string]renderer.Page
+	errs  map[string]error
+	calls []Call
+}
+
+var _ renderer.Renderer = (*Renderer)(nil)
+
+// New creates a Renderer serving no page.
+func New() *Renderer {
+	return &Renderer{
+		pages: make(map[string]renderer.Page),
+		errs:  make(map[string]error),
+	}
+}
+
+// Serve renders pageURL as html.
+func (r *Renderer) Serve(pageURL, html string) {
+	r.Redirect(pageURL, pageURL, html)
+}
+
+// Redirect renders pageURL as html, found at finalURL, as after a script navigation.
+func (r *Renderer) Redirect(pageURL, finalURL, html string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.pages[pageURL] = renderer.Page{URL: finalURL, HTML: html}
+	delete(r.errs, pageURL)
+}
+
+// Fail fails the renders of pageURL with err.
+func (r *Renderer) Fail(pageURL string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.errs[pageURL] = err
+	delete(r.pages, pageURL)
+}
+
+// Render returns the page registered for pageURL, recording the call.
+func (r *Renderer) Render(ctx context.Context, pageURL string, opts domain.RenderOptions) (*renderer.Page, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{URL: pageURL, Options: opts})
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if err, ok := r.errs[pageURL]; ok {
+		return nil, err
+	}
+
+	page, ok := r.pages[pageURL]
+	if !ok {
+		return nil, domain.NewAnalysisError(domain.ErrCodeRenderFailed, "The page could not be rendered", 0,
+			fmt.Sprintf("renderertest: no page served at %s", pageURL))
+	}
+
+	return &page, nil
+}
+
+// Calls returns the renders requested so far, in order.
+func (r *Renderer) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Call(nil), r.calls...)
+}
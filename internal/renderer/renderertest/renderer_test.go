@@ -0,0 +1,128 @@
+package renderertest_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/renderer"
+	"github.com/architeacher/svc-web-analyzer/internal/renderer/renderertest"
+)
+
+func TestRendererRender(t *testing.T) {
+	t.Parallel()
+
+	errBrowser := domain.NewAnalysisError(domain.ErrCodeTimeout, "Rendering the page timed out", 0, "")
+
+	r := renderertest.New()
+	r.Serve("https://example.com/", "<html><body><h1>Home</h1></body></html>")
+	r.Redirect("https://example.com/app", "https://example.com/app#/login", "<html><body><form></form></body></html>")
+	r.Fail("https://example.com/slow", errBrowser)
+	r.Fail("https://example.com/fixed", errBrowser)
+	r.Serve("https://example.com/fixed", "<html></html>")
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		url      string
+		want     *renderer.Page
+		wantErr  error
+		wantCode string
+	}{
+		{
+			name: "served",
+			ctx:  context.Background(),
+			url:  "https://example.com/",
+			want: &renderer.Page{URL: "https://example.com/", HTML: "<html><body><h1>Home</h1></body></html>"},
+		},
+		{
+			name: "redirected",
+			ctx:  context.Background(),
+			url:  "https://example.com/app",
+			want: &renderer.Page{URL: "https://example.com/app#/login", HTML: "<html><body><form></form></body></html>"},
+		},
+		{
+			name: "served after failing",
+			ctx:  context.Background(),
+			url:  "https://example.com/fixed",
+			want: &renderer.Page{URL: "https://example.com/fixed", HTML: "<html></html>"},
+		},
+		{
+			name:    "failed",
+			ctx:     context.Background(),
+			url:     "https://example.com/slow",
+			wantErr: errBrowser,
+		},
+		{
+			name:     "not served",
+			ctx:      context.Background(),
+			url:      "https://example.com/missing",
+			wantCode: domain.ErrCodeRenderFailed,
+		},
+		{
+			name:    "canceled",
+			ctx:     canceled,
+			url:     "https://example.com/",
+			wantErr: context.Canceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := r.Render(tt.ctx, tt.url, domain.RenderOptions{WaitFor: domain.WaitLoad})
+
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Render(%s) error = %v, want %v", tt.url, err, tt.wantErr)
+				}
+			case tt.wantCode != "":
+				var analysisErr *domain.AnalysisError
+				if !errors.As(err, &analysisErr) || analysisErr.Code != tt.wantCode {
+					t.Errorf("Render(%s) error = %v, want a %s analysis error", tt.url, err, tt.wantCode)
+				}
+			case err != nil:
+				t.Errorf("Render(%s) error = %v", tt.url, err)
+			case !reflect.DeepEqual(got, tt.want):
+				t.Errorf("Render(%s) = %+v, want %+v", tt.url, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRendererCalls(t *testing.T) {
+	t.Parallel()
+
+	r := renderertest.New()
+	r.Serve("https://example.com/", "<html></html>")
+
+	first := domain.RenderOptions{WaitFor: domain.WaitSelector, Selector: "#root h1"}
+	second := domain.RenderOptions{WaitFor: domain.WaitDelay, Delay: domain.DefaultRenderDelay}
+
+	_, _ = r.Render(context.Background(), "https://example.com/", first)
+	_, _ = r.Render(context.Background(), "https://example.com/missing", second)
+
+	want := []renderertest.Call{
+		{URL: "https://example.com/", Options: first},
+		{URL: "https://example.com/missing", Options: second},
+	}
+
+	calls := r.Calls()
+	if !reflect.DeepEqual(calls, want) {
+		t.Fatalf("Calls() = %+v, want %+v", calls, want)
+	}
+
+	// The calls returned are a copy.
+	calls[0].URL = "https://example.com/changed"
+
+	if got := r.Calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Calls() after changing a copy = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+// MemoryRepository keeps analyses in process memory. They are lost on restart and are not
+// shared between instances.
+type MemoryRepository struct {
+	mu       sync.RWMutex
+	analyses map[uuid.UUID]*domain.Analysis
+	pages    map[uuid.UUID][]domain.CrawledPage
+	batches  map[uuid.UUID]*memoryBatch
+}
+
+// memoryBatch is a batch along with the ids of its analyses.
+type memoryBatch struct {
+	batch    domain.Batch
+	analyses []uuid.UUID
+}
+
+var _ AnalysisRepository = (*MemoryRepository)(nil)
+
+// NewMemory creates an empty MemoryRepository.
+func NewMemory() *MemoryRepository {
+	return &MemoryRepository{
+		analyses: make(map[uuid.UUID]*domain.Analysis),
+		pages:    make(map[uuid.UUID][]domain.CrawledPage),
+		batches:  make(map[uuid.UUID]*memoryBatch),
+	}
+}
+
+// Create stores a copy of analysis.
+func (r *MemoryRepository) Create(_ context.Context, analysis *domain.Analysis) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.analyses[analysis.ID] = analysis.Clone()
+
+	return nil
+}
+
+// Get returns a copy of the analysis with the given id.
+func (r *MemoryRepository) Get(_ context.Context, id uuid.UUID) (*domain.Analysis, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	analysis, ok := r.analyses[id]
+	if !ok {
+		return nil, domain.ErrAnalysisNotFound
+	}
+
+	return analysis.Clone(), nil
+}
+
+// Update applies fn to a copy of the analysis and stores it once the transition is validated.
+func (r *MemoryRepository) Update(_ context.Context, id uuid.UUID, fn func(*domain.Analysis) error) (*domain.Analysis, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.analyses[id]
+	if !ok {
+		return nil, domain.ErrAnalysisNotFound
+	}
+
+	updated := current.Clone()
+	if err := fn(updated); err != nil {
+		return nil, err
+	}
+
+	if err := checkTransition(current.Status, updated.Status); err != nil {
+		return nil, err
+	}
+
+	r.analyses[id] = updated
+
+	return updated.Clone(), nil
+}
+
+// Delete removes the analysis with the given id.
+func (r *MemoryRepository) Delete(_ context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.analyses[id]; !ok {
+		return domain.ErrAnalysisNotFound
+	}
+
+	delete(r.analyses, id)
+	delete(r.pages, id)
+
+	return nil
+}
+
+// SavePages stores a copy of pages, replacing those stored for the analysis.
+func (r *MemoryRepository) SavePages(_ context.Context, id uuid.UUID, pages []domain.CrawledPage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.analyses[id]; !ok {
+		return domain.ErrAnalysisNotFound
+	}
+
+	r.pages[id] = append([]domain.CrawledPage(nil), pages...)
+
+	return nil
+}
+
+// ListPages returns a copy of the requested pages of the analysis.
+func (r *MemoryRepository) ListPages(_ context.Context, id uuid.UUID, offset, limit int) ([]domain.CrawledPage, int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.analyses[id]; !ok {
+		return nil, 0, domain.ErrAnalysisNotFound
+	}
+
+	pages := r.pages[id]
+	start := min(max(offset, 0), len(pages))
+	end := min(start+max(limit, 0), len(pages))
+
+	return append([]domain.CrawledPage{}, pages[start:end]...), len(pages), nil
+}
+
+// CreateBatch stores a copy of batch.
+func (r *MemoryRepository) CreateBatch(_ context.Context, batch *domain.Batch, analyses []uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.batches[batch.ID] = &memoryBatch{batch: *batch, analyses: append([]uuid.UUID(nil), analyses...)}
+
+	return nil
+}
+
+// GetBatch returns a copy of the batch with the given id.
+func (r *MemoryRepository) GetBatch(_ context.Context, id uuid.UUID) (*domain.Batch, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	b, ok := r.batches[id]
+	if !ok {
+		return nil, domain.ErrBatchNotFound
+	}
+
+	batch := b.batch
+	batch.Progress = domain.NewBatchProgress(r.batchItems(b))
+
+	return &batch, nil
+}
+
+// ListBatchItems returns copies of the requested analyses of the batch.
+func (r *MemoryRepository) ListBatchItems(_ context.Context, id uuid.UUID, offset, limit int) ([]*domain.Analysis, int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	b, ok := r.batches[id]
+	if !ok {
+		return nil, 0, domain.ErrBatchNotFound
+	}
+
+	items := r.batchItems(b)
+	start := min(max(offset, 0), len(items))
+	end := min(start+max(limit, 0), len(items))
+
+	page := make([]*domain.Analysis, 0, end-start)
+	for _, analysis := range items[start:end] {
+		page = append(page, analysis.Clone())
+	}
+
+	return page, len(items), nil
+}
+
+// batchItems returns the analyses of b still stored. r.mu must be held.
+func (r *MemoryRepository) batchItems(b *memoryBatch) []*domain.Analysis {
+	items := make([]*domain.Analysis, 0, len(b.analyses))
+
+	for _, id := range b.analyses {
+		if analysis, ok := r.analyses[id]; ok {
+			items = append(items, analysis)
+		}
+	}
+
+	return items
+}
+
+// DeleteExpired removes the analyses that finished before finishedBefore, and the unfinished
+// ones submitted before abandonedBefore.
+func (r *MemoryRepository) DeleteExpired(_ context.Context, finishedBefore, abandonedBefore time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int64
+
+	for id, analysis := range r.analyses {
+		if expired(analysis, finishedBefore, abandonedBefore) {
+			delete(r.analyses, id)
+			delete(r.pages, id)
+			deleted++
+		}
+	}
+
+	for id, b := range r.batches {
+		if b.batch.CreatedAt.Before(finishedBefore) {
+			delete(r.batches, id)
+		}
+	}
+
+	return deleted, nil
+}
+
+// Ping always succeeds.
+func (r *MemoryRepository) Ping(_ context.Context) error {
+	return nil
+}
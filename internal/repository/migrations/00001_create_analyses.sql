@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE analyses
+(
+    id           UUID PRIMARY KEY,
+    url          TEXT        NOT NULL,
+    options      JSONB       NOT NULL,
+    status       TEXT        NOT NULL CHECK (status IN ('requested', 'in_progress', 'completed', 'failed')),
+    progress     SMALLINT    NOT NULL DEFAULT 0 CHECK (progress BETWEEN 0 AND 100),
+    current_step TEXT        NOT NULL DEFAULT '',
+    steps        JSONB,
+    results      JSONB,
+    error        JSONB,
+    created_at   TIMESTAMPTZ NOT NULL,
+    started_at   TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    duration_ms  BIGINT,
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Serves the retention sweep, which expires analyses by completion time, or creation time while unfinished.
+CREATE INDEX analyses_expiry_idx ON analyses ((COALESCE(completed_at, created_at)));
+
+-- +goose Down
+DROP TABLE analyses;
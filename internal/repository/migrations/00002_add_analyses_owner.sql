@@ -0,0 +1,6 @@
+-- +goose Up
+-- The tenant that submitted the analysis; only it may read the analysis back.
+ALTER TABLE analyses ADD COLUMN owner TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE analyses DROP COLUMN owner;
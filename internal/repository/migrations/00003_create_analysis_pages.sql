@@ -0,0 +1,12 @@
+-- +goose Up
+-- The pages of the crawls, in crawl order, removed along with their analysis.
+CREATE TABLE analysis_pages
+(
+    analysis_id UUID    NOT NULL REFERENCES analyses (id) ON DELETE CASCADE,
+    position    INTEGER NOT NULL,
+    page        JSONB   NOT NULL,
+    PRIMARY KEY (analysis_id, position)
+);
+
+-- +goose Down
+DROP TABLE analysis_pages;
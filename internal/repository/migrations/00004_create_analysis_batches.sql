@@ -0,0 +1,26 @@
+-- +goose Up
+-- The batches of analyses, submitted at once by a tenant.
+CREATE TABLE analysis_batches
+(
+    id          UUID PRIMARY KEY,
+    owner       TEXT        NOT NULL,
+    sitemap_url TEXT        NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ NOT NULL
+);
+
+-- Serves the retention sweep, which expires batches by creation time.
+CREATE INDEX analysis_batches_created_at_idx ON analysis_batches (created_at);
+
+-- The analyses of the batches, in submission order. Analyses answered from the cache may belong
+-- to several batches, and expire before them, so they are not referenced.
+CREATE TABLE analysis_batch_items
+(
+    batch_id    UUID    NOT NULL REFERENCES analysis_batches (id) ON DELETE CASCADE,
+    position    INTEGER NOT NULL,
+    analysis_id UUID    NOT NULL,
+    PRIMARY KEY (batch_id, position)
+);
+
+-- +goose Down
+DROP TABLE analysis_batch_items;
+DROP TABLE analysis_batches;
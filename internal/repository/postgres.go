@@ -0,0 +1,564 @@
+package repository
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+const analysisColumns = `id, url, options, status, progress, current_step, steps, results, error,
+	created_at, started_at, completed_at, owner`
+
+// PostgresRepository stores analyses in PostgreSQL. The options, steps, results and errors
+// are kept as JSONB documents, and so are the pages of the crawls, in a table of their own.
+// Batches list the ids of their analyses.
+type PostgresRepository struct {
+	pool *pgxpool.Pool
+}
+
+var _ AnalysisRepository = (*PostgresRepository)(nil)
+
+// PostgresOption configures a PostgresRepository.
+type PostgresOption func(*pgxpool.Config)
+
+// WithMaxConns bounds the size of the connection pool.
+func WithMaxConns(conns int32) PostgresOption {
+	return func(c *pgxpool.Config) {
+		if conns > 0 {
+			c.MaxConns = conns
+		}
+	}
+}
+
+// NewPostgres connects to the database described by dsn.
+func NewPostgres(ctx context.Context, dsn string, opts ...PostgresOption) (*PostgresRepository, error) {
+	cfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("parsing postgres dsn: %w", err)
+	}
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to postgres: %w", err)
+	}
+
+	return &PostgresRepository{pool: pool}, nil
+}
+
+// Migrate applies the pending schema migrations. Concurrent instances are serialised through
+// an advisory lock.
+func (r *PostgresRepository) Migrate(ctx context.Context) error {
+	fsys, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		return err
+	}
+
+	locker, err := lock.NewPostgresSessionLocker()
+	if err != nil {
+		return fmt.Errorf("creating migration lock: %w", err)
+	}
+
+	provider, err := goose.NewProvider(goose.DialectPostgres, stdlib.OpenDBFromPool(r.pool), fsys,
+		goose.WithSessionLocker(locker),
+	)
+	if err != nil {
+		return fmt.Errorf("loading migrations: %w", err)
+	}
+
+	defer provider.Close()
+
+	if _, err := provider.Up(ctx); err != nil {
+		return fmt.Errorf("applying migrations: %w", err)
+	}
+
+	return nil
+}
+
+// Create inserts analysis.
+func (r *PostgresRepository) Create(ctx context.Context, analysis *domain.Analysis) error {
+	row, err := newAnalysisRow(analysis)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, `
+		INSERT INTO analyses (`+analysisColumns+`, duration_ms)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+		row.args()...,
+	)
+	if err != nil {
+		return fmt.Errorf("inserting analysis: %w", err)
+	}
+
+	return nil
+}
+
+// Get returns the analysis with the given id.
+func (r *PostgresRepository) Get(ctx context.Context, id uuid.UUID) (*domain.Analysis, error) {
+	return r.get(ctx, r.pool, id, "")
+}
+
+// Update locks the analysis row for the duration of fn and writes the result back.
+func (r *PostgresRepository) Update(ctx context.Context, id uuid.UUID, fn func(*domain.Analysis) error) (*domain.Analysis, error) {
+	var updated *domain.Analysis
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		current, err := r.get(ctx, tx, id, "FOR UPDATE")
+		if err != nil {
+			return err
+		}
+
+		from := current.Status
+		if err := fn(current); err != nil {
+			return err
+		}
+
+		if err := checkTransition(from, current.Status); err != nil {
+			return err
+		}
+
+		row, err := newAnalysisRow(current)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE analyses
+			SET url = $2, options = $3, status = $4, progress = $5, current_step = $6, steps = $7,
+				results = $8, error = $9, created_at = $10, started_at = $11, completed_at = $12,
+				owner = $13, duration_ms = $14, updated_at = now()
+			WHERE id = $1`,
+			row.args()...,
+		)
+		if err != nil {
+			return fmt.Errorf("updating analysis: %w", err)
+		}
+
+		updated = current
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// Delete removes the analysis with the given id.
+func (r *PostgresRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM analyses WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("deleting analysis: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrAnalysisNotFound
+	}
+
+	return nil
+}
+
+// SavePages replaces the pages of the analysis, locking its row meanwhile.
+func (r *PostgresRepository) SavePages(ctx context.Context, id uuid.UUID, pages []domain.CrawledPage) error {
+	rows := make([][]any, 0, len(pages))
+
+	for i, page := range pages {
+		data, err := json.Marshal(page)
+		if err != nil {
+			return fmt.Errorf("encoding page: %w", err)
+		}
+
+		rows = append(rows, []any{id, i, data})
+	}
+
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, `SELECT id FROM analyses WHERE id = $1 FOR UPDATE`, id).Scan(&id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrAnalysisNotFound
+		}
+
+		if err != nil {
+			return fmt.Errorf("querying analysis: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, `DELETE FROM analysis_pages WHERE analysis_id = $1`, id); err != nil {
+			return fmt.Errorf("deleting pages: %w", err)
+		}
+
+		_, err = tx.CopyFrom(ctx, pgx.Identifier{"analysis_pages"}, []string{"analysis_id", "position", "page"},
+			pgx.CopyFromRows(rows))
+		if err != nil {
+			return fmt.Errorf("inserting pages: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ListPages returns the requested pages of the analysis.
+func (r *PostgresRepository) ListPages(ctx context.Context, id uuid.UUID, offset, limit int) ([]domain.CrawledPage, int, error) {
+	var (
+		exists bool
+		total  int
+	)
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM analyses WHERE id = $1),
+			(SELECT count(*) FROM analysis_pages WHERE analysis_id = $1)`, id,
+	).Scan(&exists, &total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("counting pages: %w", err)
+	}
+
+	if !exists {
+		return nil, 0, domain.ErrAnalysisNotFound
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT page FROM analysis_pages
+		WHERE analysis_id = $1
+		ORDER BY position
+		OFFSET $2 LIMIT $3`,
+		id, max(offset, 0), max(limit, 0),
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("querying pages: %w", err)
+	}
+
+	pages, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.CrawledPage, error) {
+		var (
+			data []byte
+			page domain.CrawledPage
+		)
+
+		if err := row.Scan(&data); err != nil {
+			return page, err
+		}
+
+		return page, json.Unmarshal(data, &page)
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("decoding pages: %w", err)
+	}
+
+	return pages, total, nil
+}
+
+// CreateBatch inserts batch along with the ids of its analyses.
+func (r *PostgresRepository) CreateBatch(ctx context.Context, batch *domain.Batch, analyses []uuid.UUID) error {
+	rows := make([][]any, 0, len(analyses))
+	for i, id := range analyses {
+		rows = append(rows, []any{batch.ID, i, id})
+	}
+
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO analysis_batches (id, owner, sitemap_url, created_at)
+			VALUES ($1, $2, $3, $4)`,
+			batch.ID, batch.Owner, batch.SitemapURL, batch.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("inserting batch: %w", err)
+		}
+
+		_, err = tx.CopyFrom(ctx, pgx.Identifier{"analysis_batch_items"}, []string{"batch_id", "position", "analysis_id"},
+			pgx.CopyFromRows(rows))
+		if err != nil {
+			return fmt.Errorf("inserting batch items: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetBatch returns the batch with the given id, counting its analyses by status.
+func (r *PostgresRepository) GetBatch(ctx context.Context, id uuid.UUID) (*domain.Batch, error) {
+	var (
+		batch = domain.Batch{ID: id}
+		p     = &batch.Progress
+		done  int
+	)
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT b.owner, b.sitemap_url, b.created_at,
+			count(a.id),
+			count(a.id) FILTER (WHERE a.status = 'requested'),
+			count(a.id) FILTER (WHERE a.status = 'in_progress'),
+			count(a.id) FILTER (WHERE a.status = 'completed'),
+			count(a.id) FILTER (WHERE a.status = 'failed'),
+			COALESCE(sum(CASE WHEN a.status IN ('completed', 'failed') THEN 100 ELSE a.progress END), 0)
+		FROM analysis_batches b
+			LEFT JOIN analysis_batch_items i ON i.batch_id = b.id
+			LEFT JOIN analyses a ON a.id = i.analysis_id
+		WHERE b.id = $1
+		GROUP BY b.id`, id,
+	).Scan(&batch.Owner, &batch.SitemapURL, &batch.CreatedAt,
+		&p.Total, &p.Requested, &p.InProgress, &p.Completed, &p.Failed, &done)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrBatchNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("querying batch: %w", err)
+	}
+
+	batch.CreatedAt = batch.CreatedAt.UTC()
+	p.Percentage = 100
+	if p.Total > 0 {
+		p.Percentage = done / p.Total
+	}
+
+	return &batch, nil
+}
+
+// ListBatchItems returns the requested analyses of the batch.
+func (r *PostgresRepository) ListBatchItems(ctx context.Context, id uuid.UUID, offset, limit int) ([]*domain.Analysis, int, error) {
+	var (
+		exists bool
+		total  int
+	)
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM analysis_batches WHERE id = $1),
+			(SELECT count(*) FROM analysis_batch_items i JOIN analyses a ON a.id = i.analysis_id WHERE i.batch_id = $1)`, id,
+	).Scan(&exists, &total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("counting batch items: %w", err)
+	}
+
+	if !exists {
+		return nil, 0, domain.ErrBatchNotFound
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+analysisColumns+`
+		FROM analysis_batch_items i
+			JOIN analyses a ON a.id = i.analysis_id
+		WHERE i.batch_id = $1
+		ORDER BY i.position
+		OFFSET $2 LIMIT $3`,
+		id, max(offset, 0), max(limit, 0),
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("querying batch items: %w", err)
+	}
+
+	analyses, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*domain.Analysis, error) {
+		var a analysisRow
+
+		if err := row.Scan(a.dest()...); err != nil {
+			return nil, err
+		}
+
+		return a.analysis()
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("decoding batch items: %w", err)
+	}
+
+	return analyses, total, nil
+}
+
+// DeleteExpired removes the analyses that finished before finishedBefore, the unfinished ones
+// submitted before abandonedBefore, and the batches created before finishedBefore.
+func (r *PostgresRepository) DeleteExpired(ctx context.Context, finishedBefore, abandonedBefore time.Time) (int64, error) {
+	// abandonedBefore preceding finishedBefore, the expiry index narrows down both.
+	tag, err := r.pool.Exec(ctx, `
+		DELETE FROM analyses
+		WHERE COALESCE(completed_at, created_at) < $1
+		  AND (status IN ($3, $4) OR created_at < $2)`,
+		finishedBefore, abandonedBefore, string(domain.StatusCompleted), string(domain.StatusFailed))
+	if err != nil {
+		return 0, fmt.Errorf("deleting expired analyses: %w", err)
+	}
+
+	if _, err := r.pool.Exec(ctx, `DELETE FROM analysis_batches WHERE created_at < $1`, finishedBefore); err != nil {
+		return tag.RowsAffected(), fmt.Errorf("deleting expired batches: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// Ping checks that a connection to the database can be established.
+func (r *PostgresRepository) Ping(ctx context.Context) error {
+	return r.pool.Ping(ctx)
+}
+
+// Close closes the connection pool.
+func (r *PostgresRepository) Close() error {
+	r.pool.Close()
+
+	return nil
+}
+
+// querier is implemented by both the pool and transactions.
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func (r *PostgresRepository) get(ctx context.Context, q querier, id uuid.UUID, lockClause string) (*domain.Analysis, error) {
+	var row analysisRow
+
+	err := q.QueryRow(ctx, `SELECT `+analysisColumns+` FROM analyses WHERE id = $1 `+lockClause, id).Scan(row.dest()...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrAnalysisNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("querying analysis: %w", err)
+	}
+
+	return row.analysis()
+}
+
+// analysisRow is the database representation of an analysis.
+type analysisRow struct {
+	id          uuid.UUID
+	url         string
+	options     []byte
+	status      string
+	progress    int
+	currentStep string
+	steps       []byte
+	results     []byte
+	error       []byte
+	createdAt   time.Time
+	startedAt   *time.Time
+	completedAt *time.Time
+	owner       string
+	durationMS  *int64
+}
+
+func newAnalysisRow(a *domain.Analysis) (analysisRow, error) {
+	row := analysisRow{
+		id:          a.ID,
+		url:         a.URL,
+		status:      string(a.Status),
+		progress:    a.Progress,
+		currentStep: a.CurrentStep,
+		createdAt:   a.CreatedAt,
+		startedAt:   a.StartedAt,
+		completedAt: a.CompletedAt,
+		owner:       a.Owner,
+	}
+
+	if a.CompletedAt != nil {
+		row.durationMS = ptr(a.Duration().Milliseconds())
+	}
+
+	var err error
+	if row.options, err = json.Marshal(a.Options); err != nil {
+		return row, fmt.Errorf("encoding options: %w", err)
+	}
+
+	if row.steps, err = marshalNullable(a.Steps, len(a.Steps) == 0); err != nil {
+		return row, fmt.Errorf("encoding steps: %w", err)
+	}
+
+	if row.results, err = marshalNullable(a.Results, a.Results == nil); err != nil {
+		return row, fmt.Errorf("encoding results: %w", err)
+	}
+
+	if row.error, err = marshalNullable(a.Error, a.Error == nil); err != nil {
+		return row, fmt.Errorf("encoding error: %w", err)
+	}
+
+	return row, nil
+}
+
+func (row analysisRow) args() []any {
+	return []any{
+		row.id, row.url, row.options, row.status, row.progress, row.currentStep, row.steps,
+		row.results, row.error, row.createdAt, row.startedAt, row.completedAt, row.owner, row.durationMS,
+	}
+}
+
+// dest returns the scan destinations of analysisColumns.
+func (row *analysisRow) dest() []any {
+	return []any{
+		&row.id, &row.url, &row.options, &row.status, &row.progress, &row.currentStep, &row.steps,
+		&row.results, &row.error, &row.createdAt, &row.startedAt, &row.completedAt, &row.owner,
+	}
+}
+
+func (row analysisRow) analysis() (*domain.Analysis, error) {
+	a := &domain.Analysis{
+		ID:          row.id,
+		URL:         row.url,
+		Status:      domain.Status(row.status),
+		Progress:    row.progress,
+		CurrentStep: row.currentStep,
+		CreatedAt:   row.createdAt.UTC(),
+		StartedAt:   utc(row.startedAt),
+		CompletedAt: utc(row.completedAt),
+		Owner:       row.owner,
+	}
+
+	if err := json.Unmarshal(row.options, &a.Options); err != nil {
+		return nil, fmt.Errorf("decoding options: %w", err)
+	}
+
+	if err := unmarshalNullable(row.steps, &a.Steps); err != nil {
+		return nil, fmt.Errorf("decoding steps: %w", err)
+	}
+
+	if err := unmarshalNullable(row.results, &a.Results); err != nil {
+		return nil, fmt.Errorf("decoding results: %w", err)
+	}
+
+	if err := unmarshalNullable(row.error, &a.Error); err != nil {
+		return nil, fmt.Errorf("decoding error: %w", err)
+	}
+
+	return a, nil
+}
+
+// marshalNullable encodes v, or returns nil so that the column is set to NULL.
+func marshalNullable(v any, null bool) ([]byte, error) {
+	if null {
+		return nil, nil
+	}
+
+	return json.Marshal(v)
+}
+
+func unmarshalNullable(data []byte, v any) error {
+	if data == nil {
+		return nil
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+func utc(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	return ptr(t.UTC())
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,76 @@
+// Package repository stores analyses, their status transitions and results, and the batches
+// they are submitted in.
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+// AnalysisRepository persists analyses.
+//
+// Implementations hand out copies: mutating a returned analysis has no effect until it goes
+// through Update.
+type AnalysisRepository interface {
+	// Create stores a new analysis.
+	Create(ctx context.Context, analysis *domain.Analysis) error
+	// Get returns the analysis with the given id, or domain.ErrAnalysisNotFound.
+	Get(ctx context.Context, id uuid.UUID) (*domain.Analysis, error)
+	// Update applies fn to the stored analysis atomically and returns the updated analysis.
+	// It fails with domain.ErrInvalidTransition when fn moves the analysis to a status that
+	// cannot follow its current one, in which case nothing is written.
+	Update(ctx context.Context, id uuid.UUID, fn func(*domain.Analysis) error) (*domain.Analysis, error)
+	// Delete removes the analysis with the given id, and its pages.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// SavePages replaces the pages crawled by the analysis with the given id, or fails with
+	// domain.ErrAnalysisNotFound.
+	SavePages(ctx context.Context, id uuid.UUID, pages []domain.CrawledPage) error
+	// ListPages returns up to limit pages crawled by the analysis with the given id, in crawl
+	// order from offset on, along with how many pages it crawled.
+	ListPages(ctx context.Context, id uuid.UUID, offset, limit int) ([]domain.CrawledPage, int, error)
+	// CreateBatch stores a new batch of the analyses with the given ids, in that order.
+	CreateBatch(ctx context.Context, batch *domain.Batch, analyses []uuid.UUID) error
+	// GetBatch returns the batch with the given id, its progress counting the analyses stored,
+	// or domain.ErrBatchNotFound.
+	GetBatch(ctx context.Context, id uuid.UUID) (*domain.Batch, error)
+	// ListBatchItems returns up to limit analyses of the batch with the given id, in submission
+	// order from offset on, along with how many analyses of the batch are stored.
+	ListBatchItems(ctx context.Context, id uuid.UUID, offset, limit int) ([]*domain.Analysis, int, error)
+	// DeleteExpired removes the analyses that finished before finishedBefore, and the unfinished
+	// ones submitted before abandonedBefore, which no job is left to finish, along with their
+	// pages, and returns how many were removed. The batches submitted before finishedBefore are
+	// removed too.
+	DeleteExpired(ctx context.Context, finishedBefore, abandonedBefore time.Time) (int64, error)
+	// Ping reports whether the storage is reachable.
+	Ping(ctx context.Context) error
+}
+
+// checkTransition validates the move of an analysis from one status to another.
+func checkTransition(from, to domain.Status) error {
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %s to %s", domain.ErrInvalidTransition, from, to)
+	}
+
+	return nil
+}
+
+// expired reports whether a finished before finishedBefore or, unfinished, was submitted before
+// abandonedBefore. Analyses orphaned by a lost job, such as those of a memory queue restarted
+// or whose failure could not be recorded, are thus swept once no job can still run them.
+func expired(a *domain.Analysis, finishedBefore, abandonedBefore time.Time) bool {
+	if !a.Status.IsTerminal() {
+		return a.CreatedAt.Before(abandonedBefore)
+	}
+
+	finishedAt := a.CreatedAt
+	if a.CompletedAt != nil {
+		finishedAt = *a.CompletedAt
+	}
+
+	return finishedAt.Before(finishedBefore)
+}
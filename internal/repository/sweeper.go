@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Sweeper periodically deletes the analyses whose retention period elapsed since they finished.
+// Results are kept for a limited time only; once swept, an analysis is reported as not found.
+// Unfinished analyses are swept once their retention period elapsed after the longest their
+// job may live, so that those whose job was lost are not reported as accepted forever.
+type Sweeper struct {
+	repo        AnalysisRepository
+	retention   time.Duration
+	jobLifetime time.Duration
+	interval    time.Duration
+	logger      *slog.Logger
+}
+
+// NewSweeper creates a Sweeper removing, every interval, the analyses that finished more than
+// retention ago, and the unfinished ones submitted more than retention plus jobLifetime ago,
+// jobLifetime being the longest the job of an analysis may run, its retries included.
+func NewSweeper(repo AnalysisRepository, retention, jobLifetime, interval time.Duration, logger *slog.Logger) *Sweeper {
+	return &Sweeper{
+		repo:        repo,
+		retention:   retention,
+		jobLifetime: jobLifetime,
+		interval:    interval,
+		logger:      logger,
+	}
+}
+
+// Run sweeps every interval until ctx is cancelled.
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.Sweep(ctx); err != nil && ctx.Err() == nil {
+				s.logger.Error("sweeping expired analyses", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// Sweep deletes the expired analyses once and returns how many were removed.
+func (s *Sweeper) Sweep(ctx context.Context) (int64, error) {
+	finishedBefore := time.Now().UTC().Add(-s.retention)
+
+	deleted, err := s.repo.DeleteExpired(ctx, finishedBefore, finishedBefore.Add(-s.jobLifetime))
+	if err != nil {
+		return 0, err
+	}
+
+	if deleted > 0 {
+		s.logger.Info("expired analyses deleted", slog.Int64("count", deleted), slog.Duration("retention", s.retention))
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,90 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/repository"
+)
+
+func TestSweeperSweep(t *testing.T) {
+	t.Parallel()
+
+	const (
+		retention   = time.Hour
+		jobLifetime = 2 * time.Hour
+	)
+
+	now := time.Now().UTC()
+	longAgo := now.Add(-2 * retention)
+	// abandoned is further back than the retention period and the lifetime of a job.
+	abandoned := now.Add(-2 * (retention + jobLifetime))
+	recently := now.Add(-retention / 2)
+
+	tests := []struct {
+		name        string
+		status      domain.Status
+		createdAt   time.Time
+		completedAt *time.Time
+		wantDeleted bool
+	}{
+		{name: "completed long ago", status: domain.StatusCompleted, createdAt: longAgo, completedAt: &longAgo, wantDeleted: true},
+		{name: "failed long ago", status: domain.StatusFailed, createdAt: longAgo, completedAt: &longAgo, wantDeleted: true},
+		{name: "completed recently after a long wait", status: domain.StatusCompleted, createdAt: longAgo, completedAt: &recently},
+		{name: "completed recently", status: domain.StatusCompleted, createdAt: recently, completedAt: &recently},
+		// Their job may still be retried.
+		{name: "queued long ago", status: domain.StatusRequested, createdAt: longAgo},
+		{name: "running since long ago", status: domain.StatusInProgress, createdAt: longAgo},
+		// Their job was lost.
+		{name: "queued and abandoned", status: domain.StatusRequested, createdAt: abandoned, wantDeleted: true},
+		{name: "running and abandoned", status: domain.StatusInProgress, createdAt: abandoned, wantDeleted: true},
+	}
+
+	repo := repository.NewMemory()
+	ids := make(map[string]domain.Analysis, len(tests))
+
+	for _, tt := range tests {
+		analysis := domain.NewAnalysis("tenant", "https://example.com/", domain.DefaultOptions())
+		analysis.Status = tt.status
+		analysis.CreatedAt = tt.createdAt
+		analysis.CompletedAt = tt.completedAt
+
+		if err := repo.Create(context.Background(), analysis); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+
+		ids[tt.name] = *analysis
+	}
+
+	sweeper := repository.NewSweeper(repo, retention, jobLifetime, time.Minute, slog.New(slog.DiscardHandler))
+
+	deleted, err := sweeper.Sweep(context.Background())
+	if err != nil {
+		t.Fatalf("Sweep() error = %v", err)
+	}
+
+	var wantDeleted int64
+
+	for _, tt := range tests {
+		_, err := repo.Get(context.Background(), ids[tt.name].ID)
+
+		switch {
+		case tt.wantDeleted:
+			wantDeleted++
+
+			if !errors.Is(err, domain.ErrAnalysisNotFound) {
+				t.Errorf("%s: Get() error = %v, want %v", tt.name, err, domain.ErrAnalysisNotFound)
+			}
+		case err != nil:
+			t.Errorf("%s: Get() error = %v, want the analysis kept", tt.name, err)
+		}
+	}
+
+	if deleted != wantDeleted {
+		t.Errorf("Sweep() = %d, want %d", deleted, wantDeleted)
+	}
+}
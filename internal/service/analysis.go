@@ -0,0 +1,628 @@
+// Package service implements the analysis use cases on top of the fetcher and analyzer.
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/architeacher/svc-web-analyzer/internal/analyzer"
+	"github.com/architeacher/svc-web-analyzer/internal/cache"
+	"github.com/architeacher/svc-web-analyzer/internal/crawler"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/events"
+	"github.com/architeacher/svc-web-analyzer/internal/fetcher"
+	"github.com/architeacher/svc-web-analyzer/internal/queue"
+	"github.com/architeacher/svc-web-analyzer/internal/renderer"
+	"github.com/architeacher/svc-web-analyzer/internal/repository"
+	"github.com/architeacher/svc-web-analyzer/internal/sitemap"
+)
+
+// Analysis steps reported through AnalysisInProgress.CurrentStep. While the analyzers run,
+// the current step is the name of the running analyzer.
+const (
+	StepQueued          = "queued"
+	StepFetchingPage    = "fetching_page"
+	StepRenderingPage   = "rendering_page"
+	StepParsingHTML     = "parsing_html"
+	StepCrawlingSite    = "crawling_site"
+	StepFinalizeResults = "finalizing_results"
+)
+
+// stepMessages are the progress messages of the steps preceding and following the analyzers.
+var stepMessages = map[string]string{
+	StepQueued:          "Waiting for another attempt...",
+	StepFetchingPage:    "Fetching page content...",
+	StepRenderingPage:   "Rendering page...",
+	StepParsingHTML:     "Parsing HTML content...",
+	StepCrawlingSite:    "Crawling site pages...",
+	StepFinalizeResults: "Finalizing results...",
+}
+
+// Progress milestones. The analyzers share the range between parsing and finalizing.
+const (
+	progressFetching   = 25
+	progressRendering  = 35
+	progressParsing    = 50
+	progressFinalizing = 95
+)
+
+// AnalysisService queues page analyses, processes them as jobs are delivered and records
+// their state in the repository.
+type AnalysisService struct {
+	fetcher   fetcher.Fetcher
+	analyzers *analyzer.Registry
+	queue     queue.Queue
+	repo      repository.AnalysisRepository
+	results   *cache.ResultCache
+	events    *events.Hub
+	renderer  renderer.Renderer
+	crawler   *crawler.Crawler
+	sitemaps  *sitemap.Reader
+	logger    *slog.Logger
+
+	// ctx bounds the running analyses; it is cancelled when Shutdown stops waiting for them.
+	ctx           context.Context
+	cancel        context.CancelFunc
+	stopConsuming context.CancelFunc
+	wg            sync.WaitGroup
+}
+
+var _ queue.Handler = (*AnalysisService)(nil)
+
+// Option configures an AnalysisService.
+type Option func(*AnalysisService)
+
+// WithResultCache answers the analyses of a page analysed recently with the same options from
+// the cached result, rather than queueing them.
+func WithResultCache(results *cache.ResultCache) Option {
+	return func(s *AnalysisService) {
+		s.results = results
+	}
+}
+
+// WithEvents publishes the progress of the analyses to hub.
+func WithEvents(hub *events.Hub) Option {
+	return func(s *AnalysisService) {
+		s.events = hub
+	}
+}
+
+// WithRenderer renders the pages of the analyses asking for it before they are analyzed.
+// Without a renderer, such analyses are rejected.
+func WithRenderer(r renderer.Renderer) Option {
+	return func(s *AnalysisService) {
+		s.renderer = r
+	}
+}
+
+// WithCrawler sets the crawler pacing the crawls and retrieving the robots.txt policies of the
+// sites crawled, a crawler.New with its defaults otherwise.
+func WithCrawler(c *crawler.Crawler) Option {
+	return func(s *AnalysisService) {
+		s.crawler = c
+	}
+}
+
+// WithSitemaps sets the reader of the sitemaps the batches are submitted from, a sitemap.New
+// with its defaults otherwise.
+func WithSitemaps(r *sitemap.Reader) Option {
+	return func(s *AnalysisService) {
+		s.sitemaps = r
+	}
+}
+
+// NewAnalysisService creates an AnalysisService. Call Start to begin processing jobs.
+func NewAnalysisService(
+	f fetcher.Fetcher,
+	analyzers *analyzer.Registry,
+	q queue.Queue,
+	repo repository.AnalysisRepository,
+	logger *slog.Logger,
+	opts ...Option,
+) *AnalysisService {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := &AnalysisService{
+		fetcher:       f,
+		analyzers:     analyzers,
+		queue:         q,
+		repo:          repo,
+		logger:        logger,
+		ctx:           ctx,
+		cancel:        cancel,
+		stopConsuming: func() {},
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.crawler == nil {
+		s.crawler = crawler.New()
+	}
+
+	if s.sitemaps == nil {
+		s.sitemaps = sitemap.New()
+	}
+
+	return s
+}
+
+// Start consumes analysis jobs from the queue until Shutdown is called.
+func (s *AnalysisService) Start() {
+	ctx, stop := context.WithCancel(context.Background())
+	s.stopConsuming = stop
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		if err := s.queue.Consume(ctx, s); err != nil {
+			s.logger.Error("consuming analysis jobs", slog.Any("error", err))
+		}
+	}()
+}
+
+// Submit registers a new analysis on behalf of owner and queues it for processing. When a
+// fresh result of the same page and options submitted by owner is cached, the completed
+// analysis it belongs to is returned instead.
+func (s *AnalysisService) Submit(ctx context.Context, owner, rawURL string, opts domain.Options) (*domain.Analysis, error) {
+	if err := s.checkOptions(opts); err != nil {
+		return nil, err
+	}
+
+	analysis, _, err := s.submit(ctx, owner, rawURL, opts, uuid.Nil)
+
+	return analysis, err
+}
+
+// checkOptions rejects the options this server cannot honour.
+func (s *AnalysisService) checkOptions(opts domain.Options) error {
+	if opts.Render != nil && s.renderer == nil {
+		return fmt.Errorf("%w: rendering is not enabled on this server", domain.ErrInvalidOptions)
+	}
+
+	return nil
+}
+
+// submit returns the cached analysis of the page, or registers and queues a new one as part of
+// the batch with the given id, if any. It reports whether the analysis was queued.
+func (s *AnalysisService) submit(ctx context.Context, owner, rawURL string, opts domain.Options, batchID uuid.UUID) (*domain.Analysis, bool, error) {
+	if cached := s.cached(ctx, owner, rawURL, opts); cached != nil {
+		return cached, false, nil
+	}
+
+	analysis := domain.NewAnalysis(owner, rawURL, opts)
+	analysis.CurrentStep = StepQueued
+
+	if err := s.repo.Create(ctx, analysis); err != nil {
+		return nil, false, fmt.Errorf("storing analysis: %w", err)
+	}
+
+	err := s.queue.Publish(ctx, queue.Job{
+		AnalysisID: analysis.ID,
+		URL:        rawURL,
+		Options:    opts,
+		BatchID:    batchID,
+		EnqueuedAt: analysis.CreatedAt,
+	})
+	if err != nil {
+		if deleteErr := s.repo.Delete(context.WithoutCancel(ctx), analysis.ID); deleteErr != nil {
+			s.logger.Error("removing unqueued analysis",
+				slog.String("analysis_id", analysis.ID.String()), slog.Any("error", deleteErr))
+		}
+
+		if errors.Is(err, queue.ErrFull) || errors.Is(err, queue.ErrClosed) {
+			return nil, false, fmt.Errorf("%w: %w", domain.ErrServiceUnavailable, err)
+		}
+
+		return nil, false, fmt.Errorf("queueing analysis: %w", err)
+	}
+
+	return analysis, true, nil
+}
+
+// Get returns a snapshot of the analysis with the given id. Analyses submitted by another
+// owner are reported as not found, so that tenants cannot tell them apart from missing ones.
+func (s *AnalysisService) Get(ctx context.Context, owner string, id uuid.UUID) (*domain.Analysis, error) {
+	analysis, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if analysis.Owner != owner {
+		return nil, domain.ErrAnalysisNotFound
+	}
+
+	return analysis, nil
+}
+
+// ListPages returns up to limit pages of the crawl with the given id, from offset on, along
+// with how many pages it crawled. It fails with domain.ErrNotCrawl for other analyses.
+func (s *AnalysisService) ListPages(ctx context.Context, owner string, id uuid.UUID, offset, limit int) ([]domain.CrawledPage, int, error) {
+	analysis, err := s.Get(ctx, owner, id)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if analysis.Options.Crawl == nil {
+		return nil, 0, domain.ErrNotCrawl
+	}
+
+	return s.repo.ListPages(ctx, id, offset, limit)
+}
+
+// Shutdown stops consuming jobs and waits for running analyses to finish, cancelling them
+// once ctx is done.
+func (s *AnalysisService) Shutdown(ctx context.Context) error {
+	s.stopConsuming()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.cancel()
+		<-done
+
+		return ctx.Err()
+	}
+}
+
+// Handle runs the analysis of a delivered job. Failures worth another attempt, such as an
+// unreachable page, are returned for the queue to retry; other failures are permanent.
+// Redeliveries of analyses that already finished or expired are dropped. Crawls are bounded by
+// the time limit of the crawler rather than by the timeout, which applies to each page.
+func (s *AnalysisService) Handle(ctx context.Context, job queue.Job) error {
+	timeout := job.Options.Timeout
+	if job.Options.Crawl != nil {
+		timeout = s.crawler.MaxDuration()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	stop := context.AfterFunc(s.ctx, cancel)
+	defer stop()
+
+	id := job.AnalysisID
+	started := time.Now().UTC()
+	analysis, err := s.repo.Update(ctx, id, func(a *domain.Analysis) error {
+		a.Status = domain.StatusInProgress
+		a.Progress = 0
+		a.Steps = nil
+		if a.StartedAt == nil {
+			a.StartedAt = &started
+		}
+
+		return nil
+	})
+
+	switch {
+	case errors.Is(err, domain.ErrInvalidTransition), errors.Is(err, domain.ErrAnalysisNotFound):
+		s.logger.Info("stale analysis job dropped",
+			slog.String("analysis_id", id.String()), slog.Int("attempt", job.Attempt), slog.Any("error", err))
+
+		return nil
+	case err != nil:
+		return fmt.Errorf("starting analysis: %w", err)
+	}
+
+	s.publish(id, events.Started(analysis.ID))
+
+	var (
+		data  *domain.AnalysisData
+		pages []domain.CrawledPage
+	)
+
+	if job.Options.Crawl != nil {
+		data, pages, err = s.crawl(ctx, id, job.URL, job.Options)
+	} else {
+		data, err = s.analyze(ctx, id, job.URL, job.Options)
+	}
+
+	if err != nil {
+		if !retryable(err) {
+			return queue.Permanent(err)
+		}
+
+		s.logger.Info("analysis attempt failed",
+			slog.String("analysis_id", id.String()), slog.Int("attempt", job.Attempt), slog.Any("error", err))
+		s.progress(context.WithoutCancel(ctx), id, StepQueued, 0)
+
+		return err
+	}
+
+	// The pages are stored before the analysis completes, for clients to find them once it did.
+	if pages != nil {
+		if err := s.repo.SavePages(context.WithoutCancel(ctx), id, pages); err != nil {
+			return fmt.Errorf("storing crawled pages: %w", err)
+		}
+	}
+
+	completed := time.Now().UTC()
+	// The results are recorded even when the analysis deadline elapsed in the meantime.
+	analysis, err = s.repo.Update(context.WithoutCancel(ctx), id, func(a *domain.Analysis) error {
+		a.Status = domain.StatusCompleted
+		a.Progress = 100
+		a.CurrentStep = ""
+		a.CompletedAt = &completed
+		a.Results = data
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("completing analysis: %w", err)
+	}
+
+	s.publish(id, events.Outcome(analysis))
+	s.publishBatch(context.WithoutCancel(ctx), job.BatchID, analysis)
+	s.logger.Info("analysis completed", slog.String("analysis_id", id.String()), slog.Duration("duration", analysis.Duration()))
+
+	if s.results != nil {
+		if err := s.results.Store(context.WithoutCancel(ctx), analysis); err != nil {
+			s.logger.Warn("caching analysis result", slog.String("analysis_id", id.String()), slog.Any("error", err))
+		}
+	}
+
+	return nil
+}
+
+// cached returns the completed analysis cached for the page and options, if any. Cache
+// failures are logged and treated as misses.
+func (s *AnalysisService) cached(ctx context.Context, owner, rawURL string, opts domain.Options) *domain.Analysis {
+	if s.results == nil {
+		return nil
+	}
+
+	analysis, err := s.results.Lookup(ctx, owner, rawURL, opts)
+	if err != nil {
+		if !errors.Is(err, cache.ErrMiss) {
+			s.logger.Warn("looking up cached analysis", slog.String("url", rawURL), slog.Any("error", err))
+		}
+
+		return nil
+	}
+
+	s.logger.Info("analysis served from cache", slog.String("analysis_id", analysis.ID.String()))
+
+	return analysis
+}
+
+// DeadLetter records the failure of a job that will not be retried.
+func (s *AnalysisService) DeadLetter(ctx context.Context, job queue.Job, err error) {
+	completed := time.Now().UTC()
+	analysis := s.update(ctx, job.AnalysisID, func(a *domain.Analysis) {
+		a.Status = domain.StatusFailed
+		a.CurrentStep = ""
+		a.CompletedAt = &completed
+		a.Error = domain.AsAnalysisError(err)
+	})
+	if analysis != nil {
+		s.publish(job.AnalysisID, events.Outcome(analysis))
+		s.publishBatch(ctx, job.BatchID, analysis)
+	}
+
+	s.logger.Warn("analysis failed",
+		slog.String("analysis_id", job.AnalysisID.String()), slog.Int("attempts", job.Attempt), slog.Any("error", err))
+}
+
+// retryable reports whether a failed analysis may succeed on another attempt.
+func retryable(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		// The service is shutting down; another consumer may pick the job up.
+		return true
+	}
+
+	analysisErr := domain.AsAnalysisError(err)
+
+	switch analysisErr.Code {
+	case domain.ErrCodePageUnreachable, domain.ErrCodeTimeout:
+		return true
+	case domain.ErrCodeUpstreamError:
+		return analysisErr.StatusCode >= http.StatusInternalServerError || analysisErr.StatusCode == http.StatusTooManyRequests
+	default:
+		return false
+	}
+}
+
+func (s *AnalysisService) analyze(ctx context.Context, id uuid.UUID, rawURL string, opts domain.Options) (*domain.AnalysisData, error) {
+	page, err := s.analyzePage(ctx, rawURL, opts, &stepObserver{ctx: ctx, service: s, id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return page.data, nil
+}
+
+// analyzedPage is a page analyzed, along with the links it holds.
+type analyzedPage struct {
+	url   *url.URL
+	data  *domain.AnalysisData
+	links []analyzer.Link
+}
+
+// analyzePage fetches the page, renders it when asked to, and runs the analyzers against it.
+// Its steps are reported to observer, unless it is nil.
+func (s *AnalysisService) analyzePage(ctx context.Context, rawURL string, opts domain.Options, observer *stepObserver) (*analyzedPage, error) {
+	observer.step(StepFetchingPage, progressFetching)
+
+	page, err := s.fetcher.Fetch(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// The page is fetched first all the same, for its status, its address to be checked and
+	// its encoding.
+	if opts.Render != nil {
+		observer.step(StepRenderingPage, progressRendering)
+
+		rendered, err := s.renderer.Render(ctx, page.URL.String(), *opts.Render)
+		if err != nil {
+			return nil, err
+		}
+
+		if u, err := url.Parse(rendered.URL); err == nil && u.IsAbs() {
+			page.URL = u
+		}
+
+		page.Body = []byte(rendered.HTML)
+	}
+
+	observer.step(StepParsingHTML, progressParsing)
+
+	doc, err := analyzer.NewDocument(page.Body, page.URL, page.ContentType)
+	if err != nil {
+		return nil, domain.NewAnalysisError(
+			domain.ErrCodeInvalidContent,
+			"The page content could not be parsed",
+			page.StatusCode,
+			fmt.Sprintf("The response does not contain valid HTML content: %v", err),
+		)
+	}
+
+	var steps analyzer.Observer
+	if observer != nil {
+		steps = observer
+	}
+
+	results, err := s.analyzers.Run(ctx, doc, enabledAnalyzers(opts), steps)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, domain.NewAnalysisError(domain.ErrCodeTimeout, "The analysis timed out", 0, err.Error())
+		}
+
+		return nil, err
+	}
+
+	for name, result := range results {
+		if failure, ok := result.(analyzer.Failure); ok {
+			s.logger.Warn("analyzer failed", slog.String("analyzer", name), slog.String("url", page.URL.String()),
+				slog.String("error", failure.Error))
+		}
+	}
+
+	observer.step(StepFinalizeResults, progressFinalizing)
+
+	data := analyzer.NewAnalysisData(results)
+	data.Encoding = page.Encoding
+
+	// The links are extracted by the link_analysis analyzer, which always runs.
+	summary, ok := results[analyzer.NameLinks].(analyzer.LinkSummary)
+	if !ok {
+		summary.Links = analyzer.ExtractLinks(doc)
+	}
+
+	return &analyzedPage{
+		url:   page.URL,
+		data:  data,
+		links: summary.Links,
+	}, nil
+}
+
+// enabledAnalyzers maps the request options onto the built-in analyzers. Analyzers registered
+// beyond the built-in ones always run.
+func enabledAnalyzers(opts domain.Options) analyzer.Filter {
+	return func(name string) bool {
+		switch name {
+		case analyzer.NameHeadings:
+			return opts.IncludeHeadings
+		case analyzer.NameLinkAccessibility:
+			return opts.CheckLinks
+		case analyzer.NameForms:
+			return opts.DetectForms
+		default:
+			return true
+		}
+	}
+}
+
+// stepObserver records the steps of an analysis.
+type stepObserver struct {
+	ctx     context.Context
+	service *AnalysisService
+	id      uuid.UUID
+}
+
+// step reports the step of the analysis preceding or following the analyzers.
+func (o *stepObserver) step(step string, progress int) {
+	if o != nil {
+		o.service.progress(o.ctx, o.id, step, progress)
+	}
+}
+
+func (o *stepObserver) StepStarted(name string, index, total int) {
+	o.service.progress(o.ctx, o.id, name, stepProgress(index, total))
+}
+
+func (o *stepObserver) StepCompleted(name string, result any, index, total int) {
+	step := domain.Step{
+		Name:        name,
+		Progress:    stepProgress(index+1, total),
+		Results:     result,
+		CompletedAt: time.Now().UTC(),
+	}
+
+	o.service.update(o.ctx, o.id, func(a *domain.Analysis) {
+		a.Progress = step.Progress
+		a.Steps = append(a.Steps, step)
+	})
+	o.service.publish(o.id, events.StepCompleted(step))
+}
+
+// stepProgress spreads the analyzers evenly between parsing and finalizing.
+func stepProgress(done, total int) int {
+	if total == 0 {
+		return progressFinalizing
+	}
+
+	return progressParsing + (progressFinalizing-progressParsing)*done/total
+}
+
+func (s *AnalysisService) progress(ctx context.Context, id uuid.UUID, step string, progress int) {
+	s.update(ctx, id, func(a *domain.Analysis) {
+		a.CurrentStep = step
+		a.Progress = progress
+	})
+
+	message, ok := stepMessages[step]
+	if !ok {
+		message = fmt.Sprintf("Running %s...", step)
+	}
+
+	s.publish(id, events.Progress(step, progress, message))
+}
+
+// update records a change that the analysis does not depend on, such as its progress, and
+// returns the updated analysis. A failure is logged rather than failing the analysis.
+func (s *AnalysisService) update(ctx context.Context, id uuid.UUID, fn func(*domain.Analysis)) *domain.Analysis {
+	analysis, err := s.repo.Update(ctx, id, func(a *domain.Analysis) error {
+		fn(a)
+
+		return nil
+	})
+	if err != nil && ctx.Err() == nil {
+		s.logger.Warn("updating analysis", slog.String("analysis_id", id.String()), slog.Any("error", err))
+	}
+
+	return analysis
+}
+
+func (s *AnalysisService) publish(id uuid.UUID, msg events.Message) {
+	if s.events != nil {
+		s.events.Publish(id, msg)
+	}
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/events"
+	"github.com/architeacher/svc-web-analyzer/internal/netguard"
+)
+
+// SubmitBatch submits the analyses of items on behalf of owner, as Submit does, and groups
+// them in a batch. The analyses are returned in the order of items. When one of them cannot be
+// queued, those queued already are withdrawn and no batch is created.
+func (s *AnalysisService) SubmitBatch(ctx context.Context, owner string, items []domain.BatchItem) (*domain.Batch, []*domain.Analysis, error) {
+	return s.submitBatch(ctx, domain.NewBatch(owner, ""), items)
+}
+
+// SubmitSitemap submits the analyses of the pages listed by the sitemap at sitemapURL, with
+// opts, as SubmitBatch does. It fails with domain.ErrBlockedTarget when the sitemap is on a
+// host that may not be fetched, and with domain.ErrInvalidSitemap when it cannot be read,
+// lists no page or more than domain.MaxBatchItems pages.
+func (s *AnalysisService) SubmitSitemap(ctx context.Context, owner, sitemapURL string, opts domain.Options) (*domain.Batch, []*domain.Analysis, error) {
+	if err := s.checkOptions(opts); err != nil {
+		return nil, nil, err
+	}
+
+	pages, err := s.sitemaps.Read(ctx, sitemapURL, domain.MaxBatchItems)
+
+	switch {
+	case errors.Is(err, netguard.ErrBlocked), errors.Is(err, netguard.ErrSchemeNotAllowed):
+		return nil, nil, fmt.Errorf("%w: %v", domain.ErrBlockedTarget, err)
+	case err != nil:
+		return nil, nil, fmt.Errorf("%w: %v", domain.ErrInvalidSitemap, err)
+	}
+
+	if len(pages) == 0 {
+		return nil, nil, fmt.Errorf("%w: the sitemap lists no pages", domain.ErrInvalidSitemap)
+	}
+
+	items := make([]domain.BatchItem, 0, len(pages))
+	for _, page := range pages {
+		items = append(items, domain.BatchItem{URL: page, Options: opts})
+	}
+
+	return s.submitBatch(ctx, domain.NewBatch(owner, sitemapURL), items)
+}
+
+// GetBatch returns the batch with the given id, along with its progress. Batches submitted by
+// another owner are reported as not found.
+func (s *AnalysisService) GetBatch(ctx context.Context, owner string, id uuid.UUID) (*domain.Batch, error) {
+	batch, err := s.repo.GetBatch(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if batch.Owner != owner {
+		return nil, domain.ErrBatchNotFound
+	}
+
+	return batch, nil
+}
+
+// ListBatchItems returns up to limit analyses of the batch with the given id, in submission
+// order from offset on, along with how many analyses the batch holds.
+func (s *AnalysisService) ListBatchItems(ctx context.Context, owner string, id uuid.UUID, offset, limit int) ([]*domain.Analysis, int, error) {
+	if _, err := s.GetBatch(ctx, owner, id); err != nil {
+		return nil, 0, err
+	}
+
+	return s.repo.ListBatchItems(ctx, id, offset, limit)
+}
+
+func (s *AnalysisService) submitBatch(ctx context.Context, batch *domain.Batch, items []domain.BatchItem) (*domain.Batch, []*domain.Analysis, error) {
+	for i, item := range items {
+		if err := s.checkOptions(item.Options); err != nil {
+			return nil, nil, fmt.Errorf("items[%d]: %w", i, err)
+		}
+	}
+
+	var (
+		analyses = make([]*domain.Analysis, 0, len(items))
+		ids      = make([]uuid.UUID, 0, len(items))
+		queued   []uuid.UUID
+	)
+
+	for _, item := range items {
+		analysis, ok, err := s.submit(ctx, batch.Owner, item.URL, item.Options, batch.ID)
+		if err != nil {
+			s.withdraw(ctx, queued)
+
+			return nil, nil, err
+		}
+
+		if ok {
+			queued = append(queued, analysis.ID)
+		}
+
+		analyses = append(analyses, analysis)
+		ids = append(ids, analysis.ID)
+	}
+
+	if err := s.repo.CreateBatch(ctx, batch, ids); err != nil {
+		s.withdraw(ctx, queued)
+
+		return nil, nil, fmt.Errorf("storing batch: %w", err)
+	}
+
+	batch.Progress = domain.NewBatchProgress(analyses)
+
+	// Analyses finishing before their batch was stored could not tell it they did.
+	s.publishBatch(ctx, batch.ID, nil)
+
+	return batch, analyses, nil
+}
+
+// withdraw removes the queued analyses of a batch that could not be submitted; their jobs are
+// dropped once delivered.
+func (s *AnalysisService) withdraw(ctx context.Context, ids []uuid.UUID) {
+	ctx = context.WithoutCancel(ctx)
+
+	for _, id := range ids {
+		if err := s.repo.Delete(ctx, id); err != nil && !errors.Is(err, domain.ErrAnalysisNotFound) {
+			s.logger.Error("withdrawing analysis of an unsubmitted batch",
+				slog.String("analysis_id", id.String()), slog.Any("error", err))
+		}
+	}
+}
+
+// publishBatch announces to the stream of the batch with the given id that analysis, if any,
+// finished, and that the batch completed once all its analyses finished.
+func (s *AnalysisService) publishBatch(ctx context.Context, batchID uuid.UUID, analysis *domain.Analysis) {
+	if s.events == nil || batchID == uuid.Nil {
+		return
+	}
+
+	batch, err := s.repo.GetBatch(ctx, batchID)
+	if err != nil {
+		// Batches are stored once all their analyses were queued.
+		if !errors.Is(err, domain.ErrBatchNotFound) {
+			s.logger.Warn("reading batch progress", slog.String("batch_id", batchID.String()), slog.Any("error", err))
+		}
+
+		return
+	}
+
+	if analysis != nil {
+		s.events.Publish(batchID, events.ItemFinished(batch, analysis))
+	}
+
+	if batch.Progress.Finished() {
+		s.events.Publish(batchID, events.BatchCompleted(batch))
+	}
+}
@@ -0,0 +1,127 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+	"github.com/architeacher/svc-web-analyzer/internal/queue"
+	"github.com/architeacher/svc-web-analyzer/internal/repository"
+	"github.com/architeacher/svc-web-analyzer/internal/service"
+)
+
+// failingQueue accepts the first accept jobs published, then fails with err.
+type failingQueue struct {
+	accept int
+	err    error
+
+	mu   sync.Mutex
+	jobs []queue.Job
+}
+
+func (q *failingQueue) Publish(_ context.Context, job queue.Job) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.jobs) == q.accept {
+		return q.err
+	}
+
+	q.jobs = append(q.jobs, job)
+
+	return nil
+}
+
+func (q *failingQueue) Consume(ctx context.Context, _ queue.Handler) error {
+	<-ctx.Done()
+
+	return nil
+}
+
+func (q *failingQueue) Ping(_ context.Context) error { return nil }
+
+func (q *failingQueue) Close() error { return nil }
+
+func TestSubmitBatchWithdraws(t *testing.T) {
+	t.Parallel()
+
+	errBroker := errors.New("broker unreachable")
+
+	items := []domain.BatchItem{
+		{URL: "https://example.com/"},
+		{URL: "https://example.com/about"},
+		{URL: "https://example.com/contact"},
+		{URL: "https://example.com/blog"},
+	}
+
+	tests := []struct {
+		name    string
+		accept  int
+		err     error
+		wantErr error
+	}{
+		{
+			name:    "first item not queued",
+			accept:  0,
+			err:     queue.ErrFull,
+			wantErr: domain.ErrServiceUnavailable,
+		},
+		{
+			name:    "queue full partway",
+			accept:  2,
+			err:     queue.ErrFull,
+			wantErr: domain.ErrServiceUnavailable,
+		},
+		{
+			name:    "queue failing on the last item",
+			accept:  len(items) - 1,
+			err:     errBroker,
+			wantErr: errBroker,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := repository.NewMemory()
+			q := &failingQueue{accept: tt.accept, err: tt.err}
+			s := service.NewAnalysisService(nil, nil, q, repo, slog.New(slog.DiscardHandler))
+
+			batch, analyses, err := s.SubmitBatch(context.Background(), "tenant", items)
+			if err == nil {
+				t.Fatalf("SubmitBatch() = %+v, %v, want an error", batch, analyses)
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("SubmitBatch() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if len(q.jobs) != tt.accept {
+				t.Fatalf("jobs published = %d, want %d", len(q.jobs), tt.accept)
+			}
+
+			for _, job := range q.jobs {
+				if _, err := repo.Get(context.Background(), job.AnalysisID); !errors.Is(err, domain.ErrAnalysisNotFound) {
+					t.Errorf("Get(%s) of a withdrawn analysis error = %v, want %v", job.AnalysisID, err, domain.ErrAnalysisNotFound)
+				}
+
+				if job.BatchID == uuid.Nil {
+					t.Errorf("job of %s has no batch", job.URL)
+				}
+			}
+
+			// Every analysis the batch registered, queued or not, is gone.
+			later := time.Now().Add(time.Hour)
+			if n, err := repo.DeleteExpired(context.Background(), later, later); err != nil || n != 0 {
+				t.Errorf("analyses left after withdrawing = %d, %v, want 0", n, err)
+			}
+		})
+	}
+}
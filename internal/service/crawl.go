@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/architeacher/svc-web-analyzer/internal/analyzer"
+	"github.com/architeacher/svc-web-analyzer/internal/crawler"
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+// crawl analyzes the page, then the internal pages it links to, breadth first, within the
+// limits of opts.Crawl and the time limit of the crawler. The start page is analyzed even when
+// robots.txt disallows it, since it was asked for, and fails the crawl as it would fail a
+// single-page analysis; the other pages are reported along with their failure. Once the time
+// limit is reached, the crawl completes with the pages crawled so far.
+func (s *AnalysisService) crawl(ctx context.Context, id uuid.UUID, rawURL string, opts domain.Options) (*domain.AnalysisData, []domain.CrawledPage, error) {
+	limits := *opts.Crawl
+
+	s.progress(ctx, id, StepCrawlingSite, 0)
+
+	start, err := s.crawlPage(ctx, rawURL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	frontier := crawler.NewFrontier(start.url, limits.MaxDepth)
+	if requested, err := url.Parse(rawURL); err == nil {
+		frontier.Visit(requested)
+	}
+
+	frontier.Add(internalLinks(start.links), 0)
+
+	pages := []domain.CrawledPage{{
+		URL:       start.url.String(),
+		CrawledAt: time.Now().UTC(),
+		Results:   start.data,
+	}}
+
+	summary := &domain.CrawlSummary{}
+	policies := make(map[string]*crawler.Robots)
+
+	for len(pages) < limits.MaxPages && ctx.Err() == nil {
+		target, ok := frontier.Next()
+		if !ok {
+			break
+		}
+
+		robots := s.robots(ctx, policies, target.URL)
+		if !robots.Allowed(target.URL) {
+			summary.PagesDisallowed++
+
+			continue
+		}
+
+		s.progress(ctx, id, StepCrawlingSite, progressFinalizing*len(pages)/limits.MaxPages)
+
+		if err := crawler.Wait(ctx, s.crawler.Delay(robots)); err != nil {
+			break
+		}
+
+		page, err := s.crawlPage(ctx, target.URL.String(), opts)
+		if ctx.Err() != nil {
+			// The page was cut short by the end of the crawl, not by its own timeout.
+			break
+		}
+
+		crawled := domain.CrawledPage{
+			URL:       target.URL.String(),
+			Depth:     target.Depth,
+			CrawledAt: time.Now().UTC(),
+		}
+
+		if err != nil {
+			crawled.Error = domain.AsAnalysisError(err)
+		} else {
+			crawled.URL = page.url.String()
+			crawled.Results = page.data
+
+			// Pages redirected to another host have their links dropped by the frontier.
+			frontier.Visit(page.url)
+			frontier.Add(internalLinks(page.links), target.Depth)
+		}
+
+		pages = append(pages, crawled)
+	}
+
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return nil, nil, ctx.Err()
+	}
+
+	summary.Truncated = ctx.Err() != nil || frontier.Len() > 0
+	summarize(summary, pages, opts)
+
+	s.progress(ctx, id, StepFinalizeResults, progressFinalizing)
+
+	data := *start.data
+	data.Crawl = summary
+
+	return &data, pages, nil
+}
+
+// crawlPage analyzes a page of a crawl within the timeout of the analysis.
+func (s *AnalysisService) crawlPage(ctx context.Context, rawURL string, opts domain.Options) (*analyzedPage, error) {
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	return s.analyzePage(ctx, rawURL, opts, nil)
+}
+
+// robots returns the robots.txt policy of the site of u, retrieving it on first use. Sites
+// whose policy cannot be retrieved disallow every page.
+func (s *AnalysisService) robots(ctx context.Context, policies map[string]*crawler.Robots, u *url.URL) *crawler.Robots {
+	site := u.Scheme + "://" + u.Host
+	if robots, ok := policies[site]; ok {
+		return robots
+	}
+
+	robots, err := s.crawler.Robots(ctx, u)
+	if err != nil {
+		if ctx.Err() != nil {
+			// Not cached, since the crawl stops.
+			return robots
+		}
+
+		s.logger.Warn("retrieving robots.txt, crawling disallowed", slog.String("site", site), slog.Any("error", err))
+	}
+
+	policies[site] = robots
+
+	return robots
+}
+
+// internalLinks returns the targets of the links classified as internal to their page.
+func internalLinks(links []analyzer.Link) []*url.URL {
+	internal := make([]*url.URL, 0, len(links))
+
+	for _, link := range links {
+		if link.Internal {
+			internal = append(internal, link.URL)
+		}
+	}
+
+	return internal
+}
+
+// summarize aggregates the pages of a crawl into summary. The checks left out by opts are not
+// counted.
+func summarize(summary *domain.CrawlSummary, pages []domain.CrawledPage, opts domain.Options) {
+	broken := make(map[string]struct{})
+
+	summary.PagesCrawled = len(pages)
+
+	for _, page := range pages {
+		summary.MaxDepthReached = max(summary.MaxDepthReached, page.Depth)
+
+		data := page.Results
+		if data == nil {
+			summary.PagesFailed++
+
+			continue
+		}
+
+		if opts.CheckLinks && data.Links != nil {
+			for _, link := range data.Links.InaccessibleLinks {
+				broken[link.URL] = struct{}{}
+			}
+		}
+
+		if opts.IncludeHeadings && data.HeadingCounts != nil && data.HeadingCounts.H1 == 0 {
+			summary.PagesWithoutH1++
+		}
+
+		if opts.DetectForms && data.Forms != nil && data.Forms.LoginFormsDetected > 0 {
+			summary.PagesWithLoginForms++
+		}
+	}
+
+	summary.BrokenLinks = len(broken)
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/domain"
+)
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+
+	withH1 := &domain.AnalysisData{
+		HeadingCounts: &domain.HeadingCounts{H1: 1},
+		Links: &domain.LinkAnalysis{InaccessibleLinks: []domain.InaccessibleLink{
+			{URL: "https://example.com/gone"},
+		}},
+		Forms: &domain.FormAnalysis{LoginFormsDetected: 1},
+	}
+
+	withoutH1 := &domain.AnalysisData{
+		HeadingCounts: &domain.HeadingCounts{},
+		Links: &domain.LinkAnalysis{InaccessibleLinks: []domain.InaccessibleLink{
+			{URL: "https://example.com/gone"},
+			{URL: "https://example.com/moved"},
+		}},
+		Forms: &domain.FormAnalysis{},
+	}
+
+	pages := []domain.CrawledPage{
+		{URL: "https://example.com/", Results: withH1},
+		{URL: "https://example.com/a", Depth: 1, Results: withoutH1},
+		{URL: "https://example.com/b", Depth: 2, Error: &domain.AnalysisError{Code: domain.ErrCodePageNotFound}},
+	}
+
+	tests := []struct {
+		name string
+		opts domain.Options
+		want domain.CrawlSummary
+	}{
+		{
+			name: "every check",
+			opts: domain.Options{CheckLinks: true, IncludeHeadings: true, DetectForms: true},
+			want: domain.CrawlSummary{
+				PagesCrawled:        3,
+				PagesFailed:         1,
+				MaxDepthReached:     2,
+				BrokenLinks:         2,
+				PagesWithoutH1:      1,
+				PagesWithLoginForms: 1,
+			},
+		},
+		{
+			name: "checks left out",
+			want: domain.CrawlSummary{PagesCrawled: 3, PagesFailed: 1, MaxDepthReached: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got domain.CrawlSummary
+			summarize(&got, pages, tt.opts)
+
+			if got != tt.want {
+				t.Errorf("summarize() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
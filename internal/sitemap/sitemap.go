@@ -0,0 +1,272 @@
+// Package sitemap reads the pages listed by the sitemaps of the sites, following the
+// sitemaps.org protocol: URL sets, sitemap indexes listing them, and their gzipped variants.
+package sitemap
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/architeacher/svc-web-analyzer/internal/netguard"
+)
+
+const (
+	defaultUserAgent    = "web-analyzer/1.0 (+https://github.com/architeacher/svc-web-analyzer)"
+	defaultMaxSize      = 10 << 20
+	defaultMaxSitemaps  = 50
+	defaultTimeout      = 10 * time.Second
+	defaultMaxRedirects = 5
+)
+
+var (
+	// ErrTooManyPages is returned when a sitemap lists more pages than asked for.
+	ErrTooManyPages = errors.New("too many pages")
+	errTooLarge     = errors.New("size limit exceeded")
+)
+
+// Option configures a Reader.
+type Option func(*Reader)
+
+// WithClient overrides the HTTP client retrieving the sitemaps, along with its protection of
+// the internal network.
+func WithClient(client *http.Client) Option {
+	return func(r *Reader) {
+		r.client = client
+	}
+}
+
+// WithGuard sets the guard checking the addresses the sitemaps are retrieved from. By default,
+// only public addresses are reachable.
+func WithGuard(guard *netguard.Guard) Option {
+	return func(r *Reader) {
+		r.guard = guard
+	}
+}
+
+// WithUserAgent sets the User-Agent the sitemaps are requested with.
+func WithUserAgent(userAgent string) Option {
+	return func(r *Reader) {
+		r.userAgent = userAgent
+	}
+}
+
+// WithMaxSize limits the size of a sitemap, once decompressed.
+func WithMaxSize(size int64) Option {
+	return func(r *Reader) {
+		if size > 0 {
+			r.maxSize = size
+		}
+	}
+}
+
+// WithTimeout bounds the reading of a sitemap, along with the sitemaps its index lists.
+func WithTimeout(timeout time.Duration) Option {
+	return func(r *Reader) {
+		if timeout > 0 {
+			r.timeout = timeout
+		}
+	}
+}
+
+// Reader retrieves sitemaps and lists the pages they hold.
+type Reader struct {
+	client      *http.Client
+	guard       *netguard.Guard
+	userAgent   string
+	maxSize     int64
+	maxSitemaps int
+	timeout     time.Duration
+}
+
+// New creates a Reader.
+func New(opts ...Option) *Reader {
+	r := &Reader{
+		userAgent:   defaultUserAgent,
+		maxSize:     defaultMaxSize,
+		maxSitemaps: defaultMaxSitemaps,
+		timeout:     defaultTimeout,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.client == nil {
+		r.client = netguard.NewClient(r.guard, defaultMaxRedirects)
+	}
+
+	return r
+}
+
+// document is a sitemap: a URL set, or a sitemap index listing other sitemaps.
+type document struct {
+	XMLName  xml.Name
+	URLs     []location `xml:"url"`
+	Sitemaps []location `xml:"sitemap"`
+}
+
+type location struct {
+	Loc string `xml:"loc"`
+}
+
+// Read returns the pages listed by the sitemap at rawURL, in order and without duplicates. The
+// sitemaps listed by a sitemap index are read in turn; locations that are not absolute HTTP or
+// HTTPS URLs are skipped. It fails with ErrTooManyPages when more than limit pages are listed.
+func (r *Reader) Read(ctx context.Context, rawURL string, limit int) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	doc, err := r.fetch(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		pages []string
+		seen  = make(map[string]struct{})
+	)
+
+	add := func(doc *document) error {
+		for _, loc := range doc.URLs {
+			page, ok := pageURL(loc.Loc)
+			if !ok {
+				continue
+			}
+
+			if _, ok := seen[page]; ok {
+				continue
+			}
+
+			if len(pages) == limit {
+				return fmt.Errorf("%w: the sitemap lists more than %d pages", ErrTooManyPages, limit)
+			}
+
+			seen[page] = struct{}{}
+			pages = append(pages, page)
+		}
+
+		return nil
+	}
+
+	switch doc.XMLName.Local {
+	case "urlset":
+		if err := add(doc); err != nil {
+			return nil, err
+		}
+	case "sitemapindex":
+		if len(doc.Sitemaps) > r.maxSitemaps {
+			return nil, fmt.Errorf("the sitemap index lists %d sitemaps, the limit is %d", len(doc.Sitemaps), r.maxSitemaps)
+		}
+
+		for _, loc := range doc.Sitemaps {
+			sitemapURL, ok := pageURL(loc.Loc)
+			if !ok {
+				continue
+			}
+
+			child, err := r.fetch(ctx, sitemapURL)
+			if err != nil {
+				return nil, err
+			}
+
+			// Sitemap indexes may not list other indexes.
+			if child.XMLName.Local != "urlset" {
+				return nil, fmt.Errorf("%s is not a URL set", sitemapURL)
+			}
+
+			if err := add(child); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%s is not a sitemap: unexpected <%s> root element", rawURL, doc.XMLName.Local)
+	}
+
+	return pages, nil
+}
+
+// fetch retrieves and parses the sitemap at rawURL, gzipped or not.
+func (r *Reader) fetch(ctx context.Context, rawURL string) (*document, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", r.userAgent)
+	req.Header.Set("Accept", "application/xml,text/xml;q=0.9,*/*;q=0.8")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %w", rawURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("retrieving %s: %s", rawURL, resp.Status)
+	}
+
+	body, err := r.readBody(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", rawURL, err)
+	}
+
+	var doc document
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", rawURL, err)
+	}
+
+	return &doc, nil
+}
+
+// readBody reads a sitemap within the size limit, decompressing the gzipped ones. Responses
+// with a gzip Content-Encoding are decompressed by the transport already.
+func (r *Reader) readBody(body io.Reader) ([]byte, error) {
+	data, err := readAll(body, r.maxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.HasPrefix(data, []byte{0x1f, 0x8b}) {
+		return data, nil
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decompressing the sitemap: %w", err)
+	}
+	defer zr.Close()
+
+	return readAll(zr, r.maxSize)
+}
+
+// readAll reads r, failing once it holds more than limit bytes.
+func readAll(r io.Reader, limit int64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("%w: the sitemap exceeds %d bytes", errTooLarge, limit)
+	}
+
+	return data, nil
+}
+
+// pageURL returns loc as an absolute HTTP or HTTPS URL, or false when it is not one.
+func pageURL(loc string) (string, bool) {
+	u, err := url.Parse(strings.TrimSpace(loc))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", false
+	}
+
+	return u.String(), true
+}
@@ -0,0 +1,241 @@
+package sitemap_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/architeacher/svc-web-analyzer/internal/netguard"
+	"github.com/architeacher/svc-web-analyzer/internal/sitemap"
+)
+
+func urlSet(locs ...string) string {
+	var b strings.Builder
+
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?><urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`)
+
+	for _, loc := range locs {
+		fmt.Fprintf(&b, "<url><loc>%s</loc></url>", loc)
+	}
+
+	b.WriteString("</urlset>")
+
+	return b.String()
+}
+
+func sitemapIndex(locs ...string) string {
+	var b strings.Builder
+
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?><sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`)
+
+	for _, loc := range locs {
+		fmt.Fprintf(&b, "<sitemap><loc>%s</loc></sitemap>", loc)
+	}
+
+	b.WriteString("</sitemapindex>")
+
+	return b.String()
+}
+
+func gzipped(t *testing.T, s string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write([]byte(s)); err != nil {
+		t.Fatalf("gzip.Write() error = %v", err)
+	}
+
+	if err := zw.Close(); err != nil {
+		t.Fatalf("gzip.Close() error = %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func newReader(t *testing.T, opts ...sitemap.Option) *sitemap.Reader {
+	t.Helper()
+
+	guard, err := netguard.New("127.0.0.0/8", "::1")
+	if err != nil {
+		t.Fatalf("netguard.New() error = %v", err)
+	}
+
+	return sitemap.New(append([]sitemap.Option{sitemap.WithGuard(guard)}, opts...)...)
+}
+
+func TestReaderRead(t *testing.T) {
+	t.Parallel()
+
+	large := urlSet(strings.Repeat("https://example.com/page/", 100))
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/sitemap.xml", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(urlSet(
+			"https://example.com/",
+			" https://example.com/about ",
+			"https://example.com/",
+			"ftp://example.com/file",
+			"/relative",
+			"https://example.com/contact",
+		)))
+	})
+	mux.HandleFunc("/index.xml", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(sitemapIndex(
+			server.URL+"/pages.xml",
+			"mailto:webmaster@example.com",
+			server.URL+"/posts.xml.gz",
+		)))
+	})
+	mux.HandleFunc("/pages.xml", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(urlSet("https://example.com/", "https://example.com/about")))
+	})
+	mux.HandleFunc("/posts.xml.gz", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/gzip")
+		_, _ = w.Write(gzipped(t, urlSet("https://example.com/posts/1", "https://example.com/about")))
+	})
+	mux.HandleFunc("/encoded.xml", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		_, _ = w.Write(gzipped(t, urlSet("https://example.com/encoded")))
+	})
+	mux.HandleFunc("/nested.xml", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(sitemapIndex(server.URL + "/index.xml")))
+	})
+	mux.HandleFunc("/feed.xml", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`<rss version="2.0"><channel></channel></rss>`))
+	})
+	mux.HandleFunc("/large.xml", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(large))
+	})
+	mux.HandleFunc("/large.xml.gz", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(gzipped(t, large))
+	})
+
+	tests := []struct {
+		name        string
+		path        string
+		limit       int
+		maxSize     int64
+		want        []string
+		wantErr     error
+		wantErrText string
+	}{
+		{
+			name:  "URL set",
+			path:  "/sitemap.xml",
+			limit: 10,
+			want:  []string{"https://example.com/", "https://example.com/about", "https://example.com/contact"},
+		},
+		{
+			name:  "URL set at the limit",
+			path:  "/sitemap.xml",
+			limit: 3,
+			want:  []string{"https://example.com/", "https://example.com/about", "https://example.com/contact"},
+		},
+		{
+			name:    "URL set past the limit",
+			path:    "/sitemap.xml",
+			limit:   2,
+			wantErr: sitemap.ErrTooManyPages,
+		},
+		{
+			name:  "sitemap index",
+			path:  "/index.xml",
+			limit: 10,
+			want:  []string{"https://example.com/", "https://example.com/about", "https://example.com/posts/1"},
+		},
+		{
+			name:    "sitemap index past the limit",
+			path:    "/index.xml",
+			limit:   2,
+			wantErr: sitemap.ErrTooManyPages,
+		},
+		{
+			name:  "gzip content encoding",
+			path:  "/encoded.xml",
+			limit: 10,
+			want:  []string{"https://example.com/encoded"},
+		},
+		{
+			name:        "nested sitemap index",
+			path:        "/nested.xml",
+			limit:       10,
+			wantErrText: "is not a URL set",
+		},
+		{
+			name:        "not a sitemap",
+			path:        "/feed.xml",
+			limit:       10,
+			wantErrText: "unexpected <rss> root element",
+		},
+		{
+			name:        "not found",
+			path:        "/missing.xml",
+			limit:       10,
+			wantErrText: "404 Not Found",
+		},
+		{
+			name:        "larger than the size limit",
+			path:        "/large.xml",
+			limit:       1000,
+			maxSize:     1024,
+			wantErrText: "size limit exceeded",
+		},
+		{
+			name:        "larger than the size limit once decompressed",
+			path:        "/large.xml.gz",
+			limit:       1000,
+			maxSize:     1024,
+			wantErrText: "size limit exceeded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := newReader(t, sitemap.WithMaxSize(tt.maxSize))
+
+			got, err := r.Read(context.Background(), server.URL+tt.path, tt.limit)
+
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Read(%s) error = %v, want %v", tt.path, err, tt.wantErr)
+				}
+			case tt.wantErrText != "":
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrText) {
+					t.Errorf("Read(%s) error = %v, want one containing %q", tt.path, err, tt.wantErrText)
+				}
+			case err != nil:
+				t.Errorf("Read(%s) error = %v", tt.path, err)
+			case !reflect.DeepEqual(got, tt.want):
+				t.Errorf("Read(%s) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReaderReadBlocked(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(urlSet("https://example.com/")))
+	}))
+	t.Cleanup(server.Close)
+
+	if _, err := sitemap.New().Read(context.Background(), server.URL, 10); !errors.Is(err, netguard.ErrBlocked) {
+		t.Errorf("Read(%s) error = %v, want %v", server.URL, err, netguard.ErrBlocked)
+	}
+}